@@ -0,0 +1,136 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/auditevent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/predicate"
+)
+
+const exportBatchSize = 500
+
+type Handler struct {
+	client *entv1.Client
+	ctx    context.Context
+}
+
+func NewHandler(c *entv1.Client, ctx context.Context) Handler {
+	return Handler{
+		client: c,
+		ctx:    ctx,
+	}
+}
+
+// EventFilter narrows the listed events, empty fields match all events.
+type EventFilter struct {
+	// id or email of the actor
+	Actor string
+	// an action, or an action prefix ending with ".", e.g., "user." for all user changes
+	Action     string
+	TargetType string
+	Target     string
+	From       *time.Time
+	To         *time.Time
+}
+
+func (f EventFilter) predicates() []predicate.AuditEvent {
+	var ps []predicate.AuditEvent
+	if f.Actor != "" {
+		if id, err := uuid.Parse(f.Actor); err == nil {
+			ps = append(ps, auditevent.Or(auditevent.ActorId(id), auditevent.ImpersonatorId(id)))
+		} else {
+			ps = append(ps, auditevent.ActorEmailEqualFold(f.Actor))
+		}
+	}
+	if strings.HasSuffix(f.Action, ".") {
+		ps = append(ps, auditevent.ActionHasPrefix(f.Action))
+	} else if f.Action != "" {
+		ps = append(ps, auditevent.Action(f.Action))
+	}
+	if f.TargetType != "" {
+		ps = append(ps, auditevent.TargetType(f.TargetType))
+	}
+	if f.Target != "" {
+		ps = append(ps, auditevent.Target(f.Target))
+	}
+	if f.From != nil {
+		ps = append(ps, auditevent.CreatedAtGTE(*f.From))
+	}
+	if f.To != nil {
+		ps = append(ps, auditevent.CreatedAtLT(*f.To))
+	}
+	return ps
+}
+
+// ListEvents returns a page of the matching events, newest first, and the number of all matching events.
+func (h *Handler) ListEvents(ctx context.Context, filter EventFilter, page, limit int) (entv1.AuditEvents, int, error) {
+	query := h.client.AuditEvent.Query().Where(filter.predicates()...)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed counting audit events: %w", err)
+	}
+
+	events, err := query.
+		Order(entv1.Desc(auditevent.FieldCreatedAt), entv1.Desc(auditevent.FieldID)).
+		Offset((page - 1) * limit).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed querying audit events: %w", err)
+	}
+	return events, total, nil
+}
+
+// ExportEvents passes all matching events, oldest first, to fn in batches so that large
+// exports are not loaded into memory at once.
+func (h *Handler) ExportEvents(ctx context.Context, filter EventFilter, fn func(*entv1.AuditEvent) error) error {
+	for offset := 0; ; offset += exportBatchSize {
+		events, err := h.client.AuditEvent.Query().
+			Where(filter.predicates()...).
+			Order(entv1.Asc(auditevent.FieldCreatedAt), entv1.Asc(auditevent.FieldID)).
+			Offset(offset).
+			Limit(exportBatchSize).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed querying audit events: %w", err)
+		}
+		for _, e := range events {
+			if err := fn(e); err != nil {
+				return err
+			}
+		}
+		if len(events) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+// Record writes an event of the actor in the context, events of requests without an actor are dropped.
+func (h *Handler) Record(ctx context.Context, action, targetType, target string, diff map[string]interface{}) error {
+	actor, ok := ActorFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	return record(ctx, h.client, actor, action, targetType, target, diff)
+}
+
+func record(ctx context.Context, client *entv1.Client, actor *Actor, action, targetType, target string,
+	diff map[string]interface{}) error {
+	return client.AuditEvent.Create().
+		SetNillableActorId(actor.UserID).
+		SetActorEmail(actor.Email).
+		SetNillableImpersonatorId(actor.ImpersonatorID).
+		SetAction(action).
+		SetTargetType(targetType).
+		SetTarget(target).
+		SetIP(actor.IP).
+		SetUserAgent(actor.UserAgent).
+		SetDiff(diff).
+		Exec(ctx)
+}
@@ -0,0 +1,48 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
+)
+
+// Actor is who made a request, recorded with the events of the request.
+type Actor struct {
+	UserID         *uuid.UUID
+	Email          string
+	ImpersonatorID *uuid.UUID
+	IP             string
+	UserAgent      string
+}
+
+type actorKey struct{}
+
+func NewContext(ctx context.Context, actor *Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor of a request context, database changes made
+// in other contexts, e.g., seeding on boot, are not recorded.
+func ActorFromContext(ctx context.Context) (*Actor, bool) {
+	actor, ok := ctx.Value(actorKey{}).(*Actor)
+	return actor, ok
+}
+
+// Context puts the actor of the request into the request context, it must run after the auth middleware.
+func Context(c *gin.Context) {
+	actor := &Actor{
+		ImpersonatorID: utils.GetImpersonator(c),
+		IP:             c.ClientIP(),
+		UserAgent:      c.Request.UserAgent(),
+	}
+	if user, err := utils.GetSessionUser(c); err == nil {
+		actor.UserID = &user.ID
+		actor.Email = user.Email
+	}
+
+	c.Request = c.Request.WithContext(NewContext(c.Request.Context(), actor))
+	c.Next()
+}
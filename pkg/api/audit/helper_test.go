@@ -0,0 +1,80 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/gin-gonic/gin"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/auditevent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/enttest"
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+)
+
+const testUserAgent = "audit-test/1.0"
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+// newTestHandler returns a handler backed by a fresh in-memory database with the audit hooks registered.
+func newTestHandler(t *testing.T) *Handler {
+	t.Helper()
+	client := enttest.Open(t, dialect.SQLite, "file:"+url.PathEscape(t.Name())+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	RegisterHooks(client)
+	h := NewHandler(client, context.Background())
+	return &h
+}
+
+func createTestUser(t *testing.T, h *Handler, name string, role entv1User.Role) *entv1.User {
+	t.Helper()
+	return h.client.User.Create().
+		SetName(name).
+		SetEmail(name + "@example.com").
+		SetPassword("not a hash").
+		SetRole(role).
+		SaveX(h.ctx)
+}
+
+// serve sends a request as the user through the audit context to the handler registered at the route.
+func serve(t *testing.T, user *entv1.User, method, route, path string, body any, handler gin.HandlerFunc) *httptest.ResponseRecorder {
+	t.Helper()
+	var buf bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&buf).Encode(body); err != nil {
+			t.Fatal(err)
+		}
+	}
+	r := gin.New()
+	r.Handle(method, route, func(c *gin.Context) {
+		c.Set("user", user)
+	}, Context, handler)
+	req := httptest.NewRequest(method, path, &buf)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", testUserAgent)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+// eventsOf returns the recorded events of the action, oldest first.
+func eventsOf(t *testing.T, h *Handler, action string) entv1.AuditEvents {
+	t.Helper()
+	events, err := h.client.AuditEvent.Query().
+		Where(auditevent.Action(action)).
+		Order(entv1.Asc(auditevent.FieldCreatedAt)).
+		All(h.ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return events
+}
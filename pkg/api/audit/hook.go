@@ -0,0 +1,206 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"entgo.io/ent"
+	"github.com/google/uuid"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/apikey"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/chat"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/hook"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/invitation"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/session"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/setting"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/signingkey"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+)
+
+const redacted = "[redacted]"
+
+// auditedTypes are the entities whose changes are recorded, with the fields whose values are redacted.
+var auditedTypes = map[string][]string{
+	entv1.TypeUser:       {user.FieldPassword, user.FieldTotpSecret, user.FieldRecoveryCodes, user.FieldMfaChallenge},
+	entv1.TypeSetting:    {},
+	entv1.TypeModelfile:  {},
+	entv1.TypeRole:       {},
+	entv1.TypeGroup:      {},
+	entv1.TypeQuota:      {},
+	entv1.TypeApiKey:     {apikey.FieldKey},
+	entv1.TypeInvitation: {invitation.FieldToken},
+	entv1.TypeSigningKey: {signingkey.FieldPrivateKey},
+	entv1.TypeSession:    {session.FieldRefreshToken},
+	entv1.TypeChat:       {chat.FieldHistory, chat.FieldMessages}, // the conversation is not copied into the audit log
+	entv1.TypeTag:        {},
+}
+
+// RegisterHooks records the changes of the audited entities and makes the audit log append-only.
+func RegisterHooks(client *entv1.Client) {
+	client.Use(auditHook)
+	client.AuditEvent.Use(hook.Reject(ent.OpUpdate | ent.OpUpdateOne | ent.OpDelete | ent.OpDeleteOne))
+}
+
+// auditHook records the changes made in a request context as one event per changed entity.
+func auditHook(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		actor, ok := ActorFromContext(ctx)
+		redactedFields, audited := auditedTypes[m.Type()]
+		if !ok || !audited {
+			return next.Mutate(ctx, m)
+		}
+
+		// old values and the ids of updated or deleted entities are gone after the mutation
+		diff := mutationDiff(ctx, m, redactedFields)
+		var ids []string
+		if !m.Op().Is(ent.OpCreate) {
+			var err error
+			if ids, err = mutationIDs(ctx, m); err != nil {
+				return nil, err
+			}
+		}
+		target := settingName(ctx, m)
+
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return v, err
+		}
+
+		if m.Op().Is(ent.OpCreate) {
+			ids, _ = mutationIDs(ctx, m)
+		}
+		client := m.(interface{ Client() *entv1.Client }).Client()
+		targetType := strings.ToLower(m.Type())
+		for _, id := range ids {
+			if target == "" || len(ids) > 1 {
+				target = id
+			}
+			if err := record(ctx, client, actor, targetType+"."+opName(m.Op()), targetType, target, diff); err != nil {
+				slog.Error("failed to record audit event", "error", err)
+			}
+		}
+		return v, nil
+	})
+}
+
+func opName(op ent.Op) string {
+	switch {
+	case op.Is(ent.OpCreate):
+		return "create"
+	case op.Is(ent.OpDelete | ent.OpDeleteOne):
+		return "delete"
+	}
+	return "update"
+}
+
+// mutationIDs returns the ids of the entities the mutation changes, or has created.
+func mutationIDs(ctx context.Context, m ent.Mutation) ([]string, error) {
+	if m.Op().Is(ent.OpCreate) {
+		switch m := m.(type) {
+		case interface{ ID() (uuid.UUID, bool) }:
+			id, _ := m.ID()
+			return []string{id.String()}, nil
+		case interface{ ID() (int, bool) }:
+			id, _ := m.ID()
+			return []string{fmt.Sprint(id)}, nil
+		case interface{ ID() (string, bool) }:
+			id, _ := m.ID()
+			return []string{id}, nil
+		}
+		return nil, fmt.Errorf("unexpected id type of %s", m.Type())
+	}
+
+	switch m := m.(type) {
+	case interface {
+		IDs(context.Context) ([]uuid.UUID, error)
+	}:
+		return toStrings(m.IDs(ctx))
+	case interface {
+		IDs(context.Context) ([]int, error)
+	}:
+		return toStrings(m.IDs(ctx))
+	case interface {
+		IDs(context.Context) ([]string, error)
+	}:
+		return m.IDs(ctx)
+	}
+	return nil, fmt.Errorf("unexpected id type of %s", m.Type())
+}
+
+func toStrings[T any](ids []T, err error) ([]string, error) {
+	if err != nil {
+		return nil, err
+	}
+	s := make([]string, 0, len(ids))
+	for _, id := range ids {
+		s = append(s, fmt.Sprint(id))
+	}
+	return s, nil
+}
+
+// settingName returns the name of a changed setting, settings are identified by name rather than id.
+func settingName(ctx context.Context, m ent.Mutation) string {
+	if m.Type() != entv1.TypeSetting {
+		return ""
+	}
+	if v, ok := m.Field(setting.FieldName); ok {
+		return fmt.Sprint(v)
+	}
+	if m.Op().Is(ent.OpUpdateOne) {
+		if v, err := m.OldField(ctx, setting.FieldName); err == nil {
+			return fmt.Sprint(v)
+		}
+	}
+	return ""
+}
+
+// mutationDiff returns the old and new values of the changed fields and the ids added to or removed from edges.
+func mutationDiff(ctx context.Context, m ent.Mutation, redactedFields []string) map[string]interface{} {
+	if name := settingName(ctx, m); settings.IsSecret(name) {
+		redactedFields = []string{setting.FieldValue, setting.FieldDefault}
+	}
+
+	diff := map[string]interface{}{}
+	for _, name := range m.Fields() {
+		change := map[string]interface{}{}
+		change["new"], _ = m.Field(name)
+		if m.Op().Is(ent.OpUpdateOne) {
+			if old, err := m.OldField(ctx, name); err == nil {
+				change["old"] = old
+			}
+		}
+		if slices.Contains(redactedFields, name) {
+			change = map[string]interface{}{"new": redacted}
+		}
+		diff[name] = change
+	}
+	for _, name := range m.ClearedFields() {
+		diff[name] = map[string]interface{}{"cleared": true}
+	}
+
+	edges := map[string]map[string]interface{}{}
+	edge := func(name string) map[string]interface{} {
+		if edges[name] == nil {
+			edges[name] = map[string]interface{}{}
+		}
+		return edges[name]
+	}
+	for _, name := range m.ClearedEdges() {
+		edge(name)["cleared"] = true
+	}
+	for _, name := range m.AddedEdges() {
+		edge(name)["added"] = m.AddedIDs(name)
+	}
+	for _, name := range m.RemovedEdges() {
+		edge(name)["removed"] = m.RemovedIDs(name)
+	}
+	for name, change := range edges {
+		diff[name] = change
+	}
+	return diff
+}
@@ -0,0 +1,45 @@
+package audit
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Action records an event for a request that changes nothing in the database, e.g., pulling
+// a model, once the request has succeeded. The target is read before the request is handled
+// as handlers may consume the request body.
+func (h *Handler) Action(action, targetType string, target func(*gin.Context) string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var t string
+		if target != nil {
+			t = target(c)
+		}
+
+		c.Next()
+
+		if c.Writer.Status() >= http.StatusBadRequest {
+			return
+		}
+		// the request context may be canceled once the response is written
+		ctx := c.Request.Context()
+		if ctx.Err() != nil {
+			actor, ok := ActorFromContext(ctx)
+			if !ok {
+				return
+			}
+			ctx = NewContext(h.ctx, actor)
+		}
+		if err := h.Record(ctx, action, targetType, t, nil); err != nil {
+			slog.Error("failed to record audit event", "error", err)
+		}
+	}
+}
+
+// Param returns the target of an Action from a path parameter.
+func Param(name string) func(*gin.Context) string {
+	return func(c *gin.Context) string {
+		return c.Param(name)
+	}
+}
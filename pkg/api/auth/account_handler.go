@@ -0,0 +1,102 @@
+package auth
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/llmos-ai/llmos-dashboard/pkg/constant"
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
+)
+
+type UpdatePasswordRequest struct {
+	Password    string `json:"password" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required"`
+}
+
+type UpdateProfileRequest struct {
+	Name            string `json:"name" binding:"required"`
+	ProfileImageUrl string `json:"profileImageUrl"`
+}
+
+// UpdatePassword changes the password of the session user, who must prove knowledge of the current one.
+func (h *Handler) UpdatePassword(c *gin.Context) {
+	user, err := utils.GetSessionUser(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	var req UpdatePasswordRequest
+	if err = c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if !utils.CheckPasswordHash(req.Password, user.Password) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": constant.MessageErrorPassword})
+		return
+	}
+
+	if err = utils.ValidatePasswordPolicy(req.NewPassword); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	hash, err := utils.HashPassword(req.NewPassword)
+	if err != nil {
+		slog.Error("failed to hash password", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": constant.MessageErrorHashPassword})
+		return
+	}
+
+	if err = h.client.User.UpdateOneID(user.ID).SetPassword(hash).Exec(c.Request.Context()); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	// sign out the other devices, the current session stays signed in
+	sessionID, err := utils.GetSessionID(c)
+	if err != nil {
+		sessionID = uuid.Nil
+	}
+	if err = h.RevokeOtherUserSessions(c.Request.Context(), user.ID, sessionID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, true)
+}
+
+// UpdateProfile changes the name and profile image of the session user.
+func (h *Handler) UpdateProfile(c *gin.Context) {
+	user, err := utils.GetSessionUser(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	var req UpdateProfileRequest
+	if err = c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	updated, err := h.client.User.UpdateOneID(user.ID).
+		SetName(req.Name).
+		SetProfileImageUrl(req.ProfileImageUrl).
+		Save(c.Request.Context())
+	if entv1.IsConstraintError(err) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "the name is already taken"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, authorizedUser(updated))
+}
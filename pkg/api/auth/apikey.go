@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/apikey"
+	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
+)
+
+const apiKeyDisplayLength = 8
+
+// CreateAPIKey stores a new api key of the user and returns it together with the raw key,
+// which is not recoverable afterward.
+func (h *Handler) CreateAPIKey(ctx context.Context, user *entv1.User, req CreateAPIKeyRequest) (*entv1.ApiKey, string, error) {
+	key, err := utils.GenerateAPIKey()
+	if err != nil {
+		return nil, "", err
+	}
+
+	apiKey, err := h.client.ApiKey.Create().
+		SetOwner(user).
+		SetName(req.Name).
+		SetKey(utils.HashToken(key)).
+		SetPrefix(key[:len(utils.APIKeyPrefix)+apiKeyDisplayLength]).
+		SetNillableExpiresAt(req.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	return apiKey, key, nil
+}
+
+func (h *Handler) ListAPIKeysByUser(ctx context.Context, userID uuid.UUID) (entv1.ApiKeys, error) {
+	keys, err := h.client.ApiKey.Query().
+		Where(apikey.UserId(userID)).
+		Order(entv1.Desc(apikey.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying api keys: %w", err)
+	}
+	return keys, nil
+}
+
+func (h *Handler) DeleteAPIKeyByUser(ctx context.Context, id, userID uuid.UUID) error {
+	return h.client.ApiKey.
+		DeleteOneID(id).
+		Where(apikey.UserId(userID)).
+		Exec(ctx)
+}
+
+// GetUserByAPIKey returns the owner of a valid, unexpired api key and records its usage.
+func (h *Handler) GetUserByAPIKey(ctx context.Context, key string) (*entv1.User, error) {
+	apiKey, err := h.client.ApiKey.Query().
+		Where(apikey.Key(utils.HashToken(key))).
+		WithOwner().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying api key: %w", err)
+	}
+
+	if apiKey.ExpiresAt != nil && apiKey.ExpiresAt.Before(time.Now()) {
+		return nil, fmt.Errorf("api key %s is expired", apiKey.ID)
+	}
+
+	if err = h.client.ApiKey.UpdateOne(apiKey).SetLastUsedAt(time.Now()).Exec(ctx); err != nil {
+		slog.Error("failed to update api key last used time", "error", err)
+	}
+	return apiKey.Edges.Owner, nil
+}
@@ -0,0 +1,92 @@
+package auth
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
+)
+
+type CreateAPIKeyRequest struct {
+	Name      string     `json:"name" binding:"required"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+func (h *Handler) CreateUserAPIKey(c *gin.Context) {
+	user, err := utils.GetSessionUser(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	var req CreateAPIKeyRequest
+	if err = c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if req.ExpiresAt != nil && req.ExpiresAt.Before(time.Now()) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "expiresAt must be in the future"})
+		return
+	}
+
+	apiKey, key, err := h.CreateAPIKey(c.Request.Context(), user, req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	// the raw key is only shown once
+	c.JSON(http.StatusOK, gin.H{
+		"id":        apiKey.ID,
+		"name":      apiKey.Name,
+		"key":       key,
+		"prefix":    apiKey.Prefix,
+		"expiresAt": apiKey.ExpiresAt,
+		"createdAt": apiKey.CreatedAt,
+	})
+}
+
+func (h *Handler) ListUserAPIKeys(c *gin.Context) {
+	user, err := utils.GetSessionUser(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	keys, err := h.ListAPIKeysByUser(c.Request.Context(), user.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, keys)
+}
+
+func (h *Handler) DeleteUserAPIKey(c *gin.Context) {
+	user, err := utils.GetSessionUser(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid api key id"})
+		return
+	}
+
+	if err = h.DeleteAPIKeyByUser(c.Request.Context(), id, user.ID); err != nil {
+		if entv1.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "api key not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": true})
+}
@@ -0,0 +1,150 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
+)
+
+// createTestAPIKey creates an api key of the user through the handler and returns its raw key.
+func createTestAPIKey(t *testing.T, h *Handler, user *entv1.User, expiresAt *time.Time) (*entv1.ApiKey, string) {
+	t.Helper()
+	apiKey, key, err := h.CreateAPIKey(h.ctx, user, CreateAPIKeyRequest{Name: "ci", ExpiresAt: expiresAt})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return apiKey, key
+}
+
+// serveAPIKey sends a request with the api key through the auth middleware and the handlers.
+func serveAPIKey(t *testing.T, h *Handler, key string, handlers ...gin.HandlerFunc) *httptest.ResponseRecorder {
+	t.Helper()
+	r := gin.New()
+	r.GET("/api/v1/test", append([]gin.HandlerFunc{h.AuthMiddleware}, handlers...)...)
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/test", nil)
+	req.Header.Set("Authorization", tokenType+" "+key)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestAPIKeyStoresHash(t *testing.T) {
+	h := newTestHandler(t)
+	alice := createTestUser(t, h, "alice", entv1User.RoleUser, "Passw0rd")
+
+	w := serve(t, func(c *gin.Context) {
+		c.Set("user", alice)
+		h.CreateUserAPIKey(c)
+	}, http.MethodPost, CreateAPIKeyRequest{Name: "ci"}, nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("create api key: status = %d: %s", w.Code, w.Body)
+	}
+	var res struct {
+		Key    string `json:"key"`
+		Prefix string `json:"prefix"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(res.Key, utils.APIKeyPrefix) || !strings.HasPrefix(res.Key, res.Prefix) || len(res.Prefix) >= len(res.Key) {
+		t.Fatalf("key %q, prefix %q", res.Key, res.Prefix)
+	}
+
+	stored := h.client.ApiKey.Query().OnlyX(h.ctx)
+	if stored.Key == res.Key || stored.Key != utils.HashToken(res.Key) {
+		t.Errorf("stored key %q is not the hash of the key", stored.Key)
+	}
+
+	// the listed keys carry neither the key nor its hash
+	w = serve(t, func(c *gin.Context) {
+		c.Set("user", alice)
+		h.ListUserAPIKeys(c)
+	}, http.MethodGet, nil, nil)
+	if body := w.Body.String(); w.Code != http.StatusOK || strings.Contains(body, res.Key) || strings.Contains(body, stored.Key) {
+		t.Errorf("list api keys: status = %d: %s", w.Code, body)
+	}
+
+	if w = serveAPIKey(t, h, res.Key, okHandler); w.Code != http.StatusOK {
+		t.Errorf("request with the key: status = %d: %s", w.Code, w.Body)
+	}
+	if stored = h.client.ApiKey.Query().OnlyX(h.ctx); stored.LastUsedAt == nil {
+		t.Error("usage of the key not recorded")
+	}
+}
+
+func TestAPIKeyRejected(t *testing.T) {
+	h := newTestHandler(t)
+	alice := createTestUser(t, h, "alice", entv1User.RoleUser, "Passw0rd")
+	expired := time.Now().Add(-time.Minute)
+	valid := time.Now().Add(time.Hour)
+
+	_, expiredKey := createTestAPIKey(t, h, alice, &expired)
+	_, validKey := createTestAPIKey(t, h, alice, &valid)
+	revoked, revokedKey := createTestAPIKey(t, h, alice, nil)
+	if err := h.DeleteAPIKeyByUser(h.ctx, revoked.ID, alice.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		key  string
+		want int
+	}{
+		{"valid", validKey, http.StatusOK},
+		{"expired", expiredKey, http.StatusUnauthorized},
+		{"revoked", revokedKey, http.StatusUnauthorized},
+		{"unknown", utils.APIKeyPrefix + "0123456789abcdef", http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if w := serveAPIKey(t, h, tt.key, okHandler); w.Code != tt.want {
+				t.Errorf("status = %d, want %d: %s", w.Code, tt.want, w.Body)
+			}
+		})
+	}
+}
+
+func TestAPIKeyLimitedToOwnerRole(t *testing.T) {
+	h := newTestHandler(t)
+	if err := h.EnsureRoles(); err != nil {
+		t.Fatal(err)
+	}
+	admin := createTestUser(t, h, "admin", entv1User.RoleAdmin, "Passw0rd")
+	alice := createTestUser(t, h, "alice", entv1User.RoleUser, "Passw0rd")
+	_, adminKey := createTestAPIKey(t, h, admin, nil)
+	_, aliceKey := createTestAPIKey(t, h, alice, nil)
+
+	tests := []struct {
+		name    string
+		key     string
+		handler gin.HandlerFunc
+		want    int
+	}{
+		{"admin key on an admin route", adminKey, h.AdminMiddleware, http.StatusOK},
+		{"user key on an admin route", aliceKey, h.AdminMiddleware, http.StatusForbidden},
+		{"user key without the permission", aliceKey, h.RequirePermission(PermissionSettingsWrite), http.StatusForbidden},
+		{"user key with the permission", aliceKey, h.RequirePermission(PermissionChatDelete), http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if w := serveAPIKey(t, h, tt.key, tt.handler, okHandler); w.Code != tt.want {
+				t.Errorf("status = %d, want %d: %s", w.Code, tt.want, w.Body)
+			}
+		})
+	}
+
+	// the key follows the current role of its owner
+	h.client.User.UpdateOne(admin).SetRole(entv1User.RoleUser).ExecX(h.ctx)
+	if w := serveAPIKey(t, h, adminKey, h.AdminMiddleware, okHandler); w.Code != http.StatusForbidden {
+		t.Errorf("key of a demoted admin on an admin route: status = %d, want %d", w.Code, http.StatusForbidden)
+	}
+}
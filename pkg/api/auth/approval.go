@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/session"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+)
+
+// PendingUser is a user waiting for approval together with the metadata of the signup.
+type PendingUser struct {
+	ID              uuid.UUID `json:"id"`
+	Name            string    `json:"name"`
+	Email           string    `json:"email"`
+	ProfileImageUrl string    `json:"profileImageUrl"`
+	CreatedAt       time.Time `json:"createdAt"`
+	// taken from the first login session, empty if it has been cleaned up
+	IP        string `json:"ip"`
+	UserAgent string `json:"userAgent"`
+}
+
+// ListPendingUsers returns the users waiting for approval, the oldest signup first.
+func (h *Handler) ListPendingUsers(ctx context.Context) ([]PendingUser, error) {
+	users, err := h.client.User.Query().
+		Where(user.RoleEQ(user.RolePending), user.RejectedAtIsNil()).
+		WithSessions(func(q *entv1.SessionQuery) {
+			q.Order(entv1.Asc(session.FieldCreatedAt))
+		}).
+		Order(entv1.Asc(user.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying pending users: %w", err)
+	}
+
+	pending := make([]PendingUser, 0, len(users))
+	for _, u := range users {
+		p := PendingUser{
+			ID:              u.ID,
+			Name:            u.Name,
+			Email:           u.Email,
+			ProfileImageUrl: u.ProfileImageUrl,
+			CreatedAt:       u.CreatedAt,
+		}
+		if len(u.Edges.Sessions) > 0 {
+			p.IP = u.Edges.Sessions[0].IP
+			p.UserAgent = u.Edges.Sessions[0].UserAgent
+		}
+		pending = append(pending, p)
+	}
+	return pending, nil
+}
+
+// ApproveUser grants the role to a pending user, the sessions of the user are revoked to pick it up.
+func (h *Handler) ApproveUser(ctx context.Context, id uuid.UUID, role user.Role) (*entv1.User, error) {
+	if role == user.RolePending {
+		return nil, fmt.Errorf("a pending user must be approved as user or admin")
+	}
+	if err := user.RoleValidator(role); err != nil {
+		return nil, err
+	}
+
+	u, err := h.client.User.UpdateOneID(id).
+		Where(user.RoleEQ(user.RolePending)).
+		SetRole(role).
+		ClearRejectedAt().
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to approve user %s: %w", id, err)
+	}
+
+	if err = h.RevokeUserSessions(ctx, u.ID); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// RejectUser removes a pending user from the approval queue, the account stays pending unless it is deleted.
+func (h *Handler) RejectUser(ctx context.Context, id uuid.UUID, deleteAccount bool) error {
+	if deleteAccount {
+		return h.inTx(ctx, func(tx *entv1.Tx) error {
+			// only pending users can be rejected, the query fails with not found otherwise
+			if _, err := tx.User.Query().Where(user.ID(id), user.RoleEQ(user.RolePending)).Only(ctx); err != nil {
+				return err
+			}
+			// the chats a pending user may have created block deleting the bare user
+			return h.deleteUserWithData(ctx, tx, id, nil)
+		})
+	}
+
+	return h.client.User.UpdateOneID(id).
+		Where(user.RoleEQ(user.RolePending)).
+		SetRejectedAt(time.Now()).
+		Exec(ctx)
+}
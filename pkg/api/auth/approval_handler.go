@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+)
+
+type ApproveUserRequest struct {
+	Role entv1User.Role `json:"role"`
+}
+
+type RejectUserRequest struct {
+	Delete bool `json:"delete"`
+}
+
+func (h *Handler) ListPendingUser(c *gin.Context) {
+	users, err := h.ListPendingUsers(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, users)
+}
+
+func (h *Handler) ApprovePendingUser(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	// the body is optional, users are approved with the user role by default
+	req := ApproveUserRequest{Role: entv1User.RoleUser}
+	if c.Request.ContentLength > 0 {
+		if err = c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	if denyAdminChange(c, req.Role) {
+		return
+	}
+
+	user, err := h.ApproveUser(c.Request.Context(), id, req.Role)
+	if entv1.IsNotFound(err) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "pending user not found"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, user)
+}
+
+func (h *Handler) RejectPendingUser(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req RejectUserRequest
+	if c.Request.ContentLength > 0 {
+		if err = c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	err = h.RejectUser(c.Request.Context(), id, req.Delete)
+	if entv1.IsNotFound(err) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "pending user not found"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, true)
+}
@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"testing"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/chat"
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+)
+
+func TestRejectUser(t *testing.T) {
+	h := newTestHandler(t)
+	pending := createTestUser(t, h, "pending", entv1User.RolePending, "Passw0rd")
+	member := createTestUser(t, h, "member", entv1User.RoleUser, "Passw0rd")
+
+	if err := h.RejectUser(h.ctx, pending.ID, false); err != nil {
+		t.Fatalf("failed to reject: %v", err)
+	}
+	if u := h.client.User.GetX(h.ctx, pending.ID); u.RejectedAt == nil || u.Role != entv1User.RolePending {
+		t.Errorf("rejected user has role %s and rejected time %v", u.Role, u.RejectedAt)
+	}
+
+	if err := h.RejectUser(h.ctx, member.ID, false); !entv1.IsNotFound(err) {
+		t.Errorf("rejecting an approved user: err = %v, want not found", err)
+	}
+}
+
+func TestRejectUserDeletesAccountWithChats(t *testing.T) {
+	h := newTestHandler(t)
+	pending := createTestUser(t, h, "pending", entv1User.RolePending, "Passw0rd")
+	member := createTestUser(t, h, "member", entv1User.RoleUser, "Passw0rd")
+	createTestChat(t, h, pending, "pending chat")
+	createTestChat(t, h, member, "member chat")
+
+	if err := h.RejectUser(h.ctx, member.ID, true); !entv1.IsNotFound(err) {
+		t.Fatalf("deleting an approved user: err = %v, want not found", err)
+	}
+	if !h.client.User.Query().Where(entv1User.ID(member.ID)).ExistX(h.ctx) {
+		t.Fatal("approved user is deleted")
+	}
+
+	if err := h.RejectUser(h.ctx, pending.ID, true); err != nil {
+		t.Fatalf("failed to delete the pending user: %v", err)
+	}
+	if h.client.User.Query().Where(entv1User.ID(pending.ID)).ExistX(h.ctx) {
+		t.Error("pending user is not deleted")
+	}
+	if n := h.client.Chat.Query().Where(chat.UserId(pending.ID)).CountX(h.ctx); n != 0 {
+		t.Errorf("%d chats of the deleted user are left", n)
+	}
+	if n := h.client.Chat.Query().Where(chat.UserId(member.ID)).CountX(h.ctx); n != 1 {
+		t.Errorf("%d chats of the other user are left, want 1", n)
+	}
+}
@@ -2,8 +2,13 @@ package auth
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -11,11 +16,15 @@ import (
 	"github.com/llmos-ai/llmos-dashboard/pkg/constant"
 	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
 	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
-	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
 	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
 )
 
-const tokenType = "Bearer"
+const (
+	tokenType = "Bearer"
+
+	defaultUsersLimit = 50
+	maxUsersLimit     = 200
+)
 
 type LoginRequest struct {
 	Email    string `json:"email" binding:"required"`
@@ -27,6 +36,10 @@ type SingUp struct {
 	LoginRequest
 }
 
+type RefreshRequest struct {
+	RefreshToken string `json:"refreshToken" binding:"required"`
+}
+
 type UpdateRole struct {
 	ID   uuid.UUID      `json:"id" binding:"required"`
 	Role entv1User.Role `json:"role" binding:"required"`
@@ -39,6 +52,13 @@ type UpdateUser struct {
 	ProfileImageUrl string  `json:"profileImageUrl"`
 }
 
+// UserSummary is what users without the user.read permission see of other users.
+type UserSummary struct {
+	ID              uuid.UUID `json:"id"`
+	Name            string    `json:"name"`
+	ProfileImageUrl string    `json:"profileImageUrl"`
+}
+
 type Handler struct {
 	client *entv1.Client
 	ctx    context.Context
@@ -57,31 +77,93 @@ func (h *Handler) SignIn(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
-	slog.Debug("login info", l.Email)
+	slog.Debug("login info", "email", l.Email)
+
+	// reject before checking any password if the attempt is throttled or the account is locked
+	if h.loginBlocked(c, l.Email) {
+		return
+	}
 
-	user, err := h.GetUserByEmail(l.Email)
-	if err != nil || !utils.CheckPasswordHash(l.Password, user.Password) {
-		slog.Error("failed to get user", err)
+	var user *entv1.User
+	for _, authenticator := range h.authenticators() {
+		u, err := authenticator.Authenticate(c.Request.Context(), l.Email, l.Password)
+		if err == nil {
+			user = u
+			break
+		}
+		if !errors.Is(err, ErrInvalidCredentials) {
+			slog.Error("failed to authenticate user", "authenticator", authenticator.Name(), "error", err)
+		}
+	}
+
+	if user == nil {
+		h.loginFailed(c, l.Email, loginFailureInvalidCredentials)
 		c.JSON(http.StatusUnauthorized, gin.H{"error": constant.MessageErrorLogin})
 		return
 	}
 
-	tokenStr, err := utils.GenerateToken(user.ID)
+	result, err := h.completeSignIn(c, l.Email, user)
+	if errors.Is(err, errAccountLocked) {
+		abortWithRetryAfter(c, http.StatusLocked, constant.MessageErrorLoginLocked, time.Until(*user.LockedUntil))
+		return
+	}
 	if err != nil {
-		slog.Error("failed to generate token", err)
+		slog.Error("failed to generate token", "error", err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": constant.MessageErrorGenerateToken})
 		return
 	}
+	if result.MFAToken != "" {
+		c.JSON(http.StatusOK, gin.H{
+			"mfaRequired": true,
+			"mfaToken":    result.MFAToken,
+		})
+		return
+	}
+	h.respondSession(c, user, result.Tokens)
+}
 
-	c.JSON(http.StatusOK, gin.H{
-		"token":           tokenStr,
-		"tokenType":       tokenType,
-		"id":              user.ID,
-		"email":           user.Email,
-		"name":            user.Name,
-		"role":            user.Role,
-		"profileImageUrl": user.ProfileImageUrl,
-	})
+// signInResult is either the MFA challenge of a user with two-factor authentication, or the new session.
+type signInResult struct {
+	MFAToken string
+	Tokens   *TokenPair
+}
+
+// errAccountLocked is returned when a user is authenticated while the account is locked.
+var errAccountLocked = errors.New("account is locked")
+
+// completeSignIn is the step shared by the sign-in methods once a user is authenticated by a first factor.
+// Locked accounts are rejected, and users with two-factor authentication get an MFA challenge instead of a session.
+func (h *Handler) completeSignIn(c *gin.Context, login string, user *entv1.User) (*signInResult, error) {
+	if user.LockedUntil != nil && user.LockedUntil.After(time.Now()) {
+		return nil, errAccountLocked
+	}
+
+	// the first factor alone is not enough once two-factor authentication is enabled
+	if user.TotpEnabled {
+		mfaToken, err := h.newMFAChallenge(c.Request.Context(), user)
+		if err != nil {
+			return nil, err
+		}
+		return &signInResult{MFAToken: mfaToken}, nil
+	}
+
+	h.loginSucceeded(c.Request.Context(), login, user)
+	tokens, err := h.CreateSession(c, user)
+	if err != nil {
+		return nil, err
+	}
+	return &signInResult{Tokens: tokens}, nil
+}
+
+// SignInProxy exchanges the headers of a trusted reverse proxy for a login session, so users skip the sign-in form.
+func (h *Handler) SignInProxy(c *gin.Context) {
+	user, err := utils.GetSessionUser(c)
+	if err != nil || !c.GetBool("proxyAuth") {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "request is not authenticated by a trusted proxy"})
+		return
+	}
+
+	h.issueSession(c, user)
 }
 
 func (h *Handler) SignUp(c *gin.Context) {
@@ -90,27 +172,25 @@ func (h *Handler) SignUp(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
+	if err := utils.ValidatePasswordPolicy(s.Password); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
 
 	hashPw, err := utils.HashPassword(s.Password)
 	if err != nil {
-		slog.Error("failed to hash password", err)
+		slog.Error("failed to hash password", "error", err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": constant.MessageErrorHashPassword})
 		return
 	}
 
-	role := GetUserRole(settings.DefaultUserRole.Get())
-	// always set the first user as the admin user
-	users, err := h.GetAllUser()
+	role, err := h.NewUserRole(c.Request.Context())
 	if err != nil {
-		slog.Error("failed to list users", err)
+		slog.Error("failed to list users", "error", err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	if len(users) == 0 {
-		role = entv1User.RoleAdmin
-	}
-
 	user := &entv1.User{
 		Name:            s.Name,
 		Email:           s.Email,
@@ -119,24 +199,33 @@ func (h *Handler) SignUp(c *gin.Context) {
 		ProfileImageUrl: "/user.png",
 	}
 
-	slog.Debug("signup info", s)
-	user, err = h.CreateUser(user)
+	slog.Debug("signup info", "name", s.Name, "email", s.Email)
+	user, err = h.CreateUser(c.Request.Context(), user)
 	if err != nil {
-		slog.Error("failed to create user", err)
+		slog.Error("failed to create user", "error", err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	tokenStr, err := utils.GenerateToken(user.ID)
+	c.Set("user", user)
+	h.issueSession(c, user)
+}
+
+// issueSession starts a login session of the authenticated user and responds with its tokens.
+func (h *Handler) issueSession(c *gin.Context, user *entv1.User) {
+	tokens, err := h.CreateSession(c, user)
 	if err != nil {
-		slog.Error("failed to generate token", err)
+		slog.Error("failed to generate token", "error", err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": constant.MessageErrorGenerateToken})
 		return
 	}
+	h.respondSession(c, user, tokens)
+}
 
-	c.Set("user", user)
+func (h *Handler) respondSession(c *gin.Context, user *entv1.User, tokens *TokenPair) {
 	c.JSON(http.StatusOK, gin.H{
-		"token":           tokenStr,
+		"token":           tokens.Token,
+		"refreshToken":    tokens.RefreshToken,
 		"tokenType":       tokenType,
 		"id":              user.ID,
 		"email":           user.Email,
@@ -146,6 +235,43 @@ func (h *Handler) SignUp(c *gin.Context) {
 	})
 }
 
+func (h *Handler) RefreshToken(c *gin.Context) {
+	var r RefreshRequest
+	if err := c.ShouldBindJSON(&r); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, tokens, err := h.RotateSession(c.Request.Context(), r.RefreshToken)
+	if err != nil {
+		slog.Debug("failed to refresh session", "error", err)
+		c.JSON(http.StatusUnauthorized, gin.H{"error": constant.MessageErrorRefreshToken})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"token":        tokens.Token,
+		"refreshToken": tokens.RefreshToken,
+		"tokenType":    tokenType,
+		"id":           user.ID,
+	})
+}
+
+func (h *Handler) SignOut(c *gin.Context) {
+	sessionID, err := utils.GetSessionID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err = h.RevokeSession(c.Request.Context(), sessionID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": true})
+}
+
 func (h *Handler) GetAuthorizedUser(c *gin.Context) {
 	user, err := utils.GetSessionUser(c)
 	if err != nil {
@@ -153,23 +279,108 @@ func (h *Handler) GetAuthorizedUser(c *gin.Context) {
 		return
 	}
 
-	c.JSON(200, gin.H{
+	res := authorizedUser(user)
+	// lets the UI show that an admin is acting as the user
+	if impersonator := utils.GetImpersonator(c); impersonator != nil {
+		res["impersonator"] = impersonator
+	}
+	c.JSON(200, res)
+}
+
+// authorizedUser is what the session user sees of its own account.
+func authorizedUser(user *entv1.User) gin.H {
+	return gin.H{
 		"id":              user.ID,
 		"email":           user.Email,
 		"name":            user.Name,
 		"role":            user.Role,
 		"profileImageUrl": user.ProfileImageUrl,
-	})
+		"totpEnabled":     user.TotpEnabled,
+	}
 }
 
+// ListAllUser returns a page of the users filtered by the q and role query, sorted by the sort query, e.g., -createdAt.
+// Users without the user.read permission only see names and profile images, pending users are denied.
 func (h *Handler) ListAllUser(c *gin.Context) {
-	users, err := h.GetAllUser()
+	sessionUser, err := utils.GetSessionUser(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	if sessionUser.Role == entv1User.RolePending {
+		c.JSON(http.StatusForbidden, gin.H{"error": "forbidden"})
+		return
+	}
+
+	canRead, err := h.HasPermissions(c.Request.Context(), sessionUser, PermissionUserRead)
 	if err != nil {
-		slog.Error("failed to list users", err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
-	c.JSONP(http.StatusOK, users)
+
+	opts := UserListOptions{
+		Page:        1,
+		Limit:       defaultUsersLimit,
+		Query:       c.Query("q"),
+		SearchEmail: canRead,
+		Sort:        c.DefaultQuery("sort", "createdAt"),
+	}
+	if v := c.Query("page"); v != "" {
+		if opts.Page, err = strconv.Atoi(v); err != nil || opts.Page <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid page"})
+			return
+		}
+	}
+	if v := c.Query("limit"); v != "" {
+		if opts.Limit, err = strconv.Atoi(v); err != nil || opts.Limit <= 0 || opts.Limit > maxUsersLimit {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid limit, must be between 1 and %d", maxUsersLimit)})
+			return
+		}
+	}
+
+	// filtering or sorting by private fields would reveal them to users that can not read them
+	sortField := strings.TrimPrefix(opts.Sort, "-")
+	if _, ok := userSortFields[sortField]; !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid sort field %s", sortField)})
+		return
+	}
+	if !canRead && sortField != "name" && sortField != "createdAt" {
+		c.JSON(http.StatusForbidden, gin.H{"error": fmt.Sprintf("sorting by %s is not allowed", sortField)})
+		return
+	}
+	if v := c.Query("role"); v != "" {
+		if !canRead {
+			c.JSON(http.StatusForbidden, gin.H{"error": "filtering by role is not allowed"})
+			return
+		}
+		opts.Role = entv1User.Role(v)
+		if err = entv1User.RoleValidator(opts.Role); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	users, total, err := h.ListUsers(c.Request.Context(), opts)
+	if err != nil {
+		slog.Error("failed to list users", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	res := gin.H{
+		"total": total,
+		"page":  opts.Page,
+		"limit": opts.Limit,
+		"users": users,
+	}
+	if !canRead {
+		summaries := make([]UserSummary, 0, len(users))
+		for _, u := range users {
+			summaries = append(summaries, UserSummary{ID: u.ID, Name: u.Name, ProfileImageUrl: u.ProfileImageUrl})
+		}
+		res["users"] = summaries
+	}
+	c.JSON(http.StatusOK, res)
 }
 
 func (h *Handler) UpdateUserRole(c *gin.Context) {
@@ -180,12 +391,29 @@ func (h *Handler) UpdateUserRole(c *gin.Context) {
 		return
 	}
 
-	user, err := h.UpdateUserRoleByID(update.ID, update.Role)
+	current, err := h.GetUserByID(c.Request.Context(), update.ID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if denyAdminChange(c, current.Role) || denyAdminChange(c, update.Role) {
+		return
+	}
+
+	user, err := h.UpdateUserRoleByID(c.Request.Context(), update.ID, update.Role)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
+	// existing sessions must not keep the privileges of the previous role
+	if current.Role != user.Role {
+		if err = h.RevokeUserSessions(c.Request.Context(), user.ID); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
 	c.JSON(http.StatusOK, user)
 }
 
@@ -202,6 +430,15 @@ func (h *Handler) UpdateUser(c *gin.Context) {
 		return
 	}
 
+	current, err := h.GetUserByID(c.Request.Context(), uuid)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if denyAdminChange(c, current.Role) {
+		return
+	}
+
 	update := UpdateUser{}
 	// using BindJson method to serialize body with struct
 	if err = c.BindJSON(&update); err != nil {
@@ -218,11 +455,19 @@ func (h *Handler) UpdateUser(c *gin.Context) {
 		update.Password = &passwd
 	}
 
-	user, err := h.UpdateUserByID(uuid, update)
+	user, err := h.UpdateUserByID(c.Request.Context(), uuid, update)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
+	// a password change signs the user out everywhere
+	if update.Password != nil {
+		if err = h.RevokeUserSessions(c.Request.Context(), user.ID); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
 	c.JSON(http.StatusOK, user)
 }
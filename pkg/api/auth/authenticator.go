@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
+)
+
+// ErrInvalidCredentials is returned by an Authenticator if the login or password is wrong,
+// any other error means the backend itself failed.
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// Authenticator verifies the credentials of a sign-in request against an identity backend
+// and returns the matching local user.
+type Authenticator interface {
+	Name() string
+	Authenticate(ctx context.Context, login, password string) (*entv1.User, error)
+}
+
+// authenticators returns the enabled backends in the order they are tried.
+func (h *Handler) authenticators() []Authenticator {
+	authenticators := []Authenticator{&localAuthenticator{h: h}}
+	if LDAPEnabled() {
+		authenticators = append(authenticators, &ldapAuthenticator{h: h})
+	}
+	return authenticators
+}
+
+// localAuthenticator checks the bcrypt password hash of the user table.
+type localAuthenticator struct {
+	h *Handler
+}
+
+func (a *localAuthenticator) Name() string {
+	return "local"
+}
+
+func (a *localAuthenticator) Authenticate(ctx context.Context, login, password string) (*entv1.User, error) {
+	user, err := a.h.GetUserByEmail(ctx, login)
+	if err != nil {
+		if entv1.IsNotFound(err) {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, err
+	}
+
+	if !utils.CheckPasswordHash(password, user.Password) {
+		return nil, ErrInvalidCredentials
+	}
+	return user, nil
+}
@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/group"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+)
+
+func (h *Handler) ListGroups(ctx context.Context) (entv1.Groups, error) {
+	groups, err := h.client.Group.Query().
+		WithUsers(func(q *entv1.UserQuery) {
+			q.Select(user.FieldID, user.FieldName, user.FieldEmail)
+		}).
+		Order(entv1.Asc(group.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying groups: %w", err)
+	}
+	return groups, nil
+}
+
+func (h *Handler) CreateGroup(ctx context.Context, name, description string, models []string, userIDs []uuid.UUID) (*entv1.Group, error) {
+	if err := validateModelPatterns(models); err != nil {
+		return nil, err
+	}
+
+	g, err := h.client.Group.Create().
+		SetName(name).
+		SetDescription(description).
+		SetModels(models).
+		AddUserIDs(userIDs...).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// UpdateGroup replaces the name, description, model patterns and members of a group.
+func (h *Handler) UpdateGroup(ctx context.Context, id uuid.UUID, name, description string, models []string, userIDs []uuid.UUID) (*entv1.Group, error) {
+	if err := validateModelPatterns(models); err != nil {
+		return nil, err
+	}
+
+	g, err := h.client.Group.UpdateOneID(id).
+		SetName(name).
+		SetDescription(description).
+		SetModels(models).
+		ClearUsers().
+		AddUserIDs(userIDs...).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func (h *Handler) DeleteGroup(ctx context.Context, id uuid.UUID) error {
+	return h.client.Group.DeleteOneID(id).Exec(ctx)
+}
@@ -0,0 +1,104 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/gin-gonic/gin"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/enttest"
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+	v1 "github.com/llmos-ai/llmos-dashboard/pkg/types/v1"
+	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+// newTestHandler returns a handler backed by a fresh in-memory database.
+func newTestHandler(t *testing.T) *Handler {
+	t.Helper()
+	client := enttest.Open(t, dialect.SQLite, "file:"+url.PathEscape(t.Name())+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	h := NewAuthHandler(client, context.Background())
+	utils.SetKeyProvider(&h)
+	return &h
+}
+
+// createTestUser creates a user with the role and password, the email is derived from the name.
+func createTestUser(t *testing.T, h *Handler, name string, role entv1User.Role, password string) *entv1.User {
+	t.Helper()
+	hash, err := utils.HashPassword(password)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := h.client.User.Create().
+		SetName(name).
+		SetEmail(name + "@example.com").
+		SetPassword(hash).
+		SetRole(role).
+		Save(h.ctx)
+	if err != nil {
+		t.Fatalf("failed to create user %s: %v", name, err)
+	}
+	return u
+}
+
+// setSetting changes a setting for the duration of the test.
+func setSetting(t *testing.T, s settings.Setting, value string) {
+	t.Helper()
+	prev := s.Get()
+	if err := s.Set(value); err != nil {
+		t.Fatalf("failed to set %s: %v", s.Name, err)
+	}
+	t.Cleanup(func() {
+		_ = s.Set(prev)
+	})
+}
+
+// serve sends a JSON request to the handler and returns the recorded response.
+func serve(t *testing.T, handler gin.HandlerFunc, method string, body any, header http.Header) *httptest.ResponseRecorder {
+	t.Helper()
+	var buf bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&buf).Encode(body); err != nil {
+			t.Fatal(err)
+		}
+	}
+	r := gin.New()
+	r.Handle(method, "/", handler)
+	req := httptest.NewRequest(method, "/", &buf)
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	if host := header.Get("Host"); host != "" {
+		req.Host = host
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+// createTestChat creates a chat of the user with the title.
+func createTestChat(t *testing.T, h *Handler, owner *entv1.User, title string) *entv1.Chat {
+	t.Helper()
+	return h.client.Chat.Create().
+		SetTitle(title).
+		SetOwner(owner).
+		SetModels([]string{"llama3:latest"}).
+		SetHistory(v1.Histroy{}).
+		SetMessages([]v1.Message{}).
+		SaveX(h.ctx)
+}
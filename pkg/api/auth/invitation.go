@@ -0,0 +1,139 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/invitation"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+	"github.com/llmos-ai/llmos-dashboard/pkg/mailer"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
+)
+
+const (
+	invitationPath    = "/auth#invite="
+	invitationSubject = "You are invited to %s"
+)
+
+type CreateInvitationRequest struct {
+	Email string    `json:"email" binding:"required"`
+	Role  user.Role `json:"role"`
+}
+
+// CreateInvitation stores a new invitation and returns it together with its link, which is not recoverable afterward.
+// The link is emailed as well if sending emails and the public URL are configured, it is relative to the
+// dashboard URL otherwise.
+func (h *Handler) CreateInvitation(ctx context.Context, inviter *entv1.User, req CreateInvitationRequest) (*entv1.Invitation, string, error) {
+	if req.Role == "" {
+		req.Role = user.RoleUser
+	}
+	if err := user.RoleValidator(req.Role); err != nil {
+		return nil, "", err
+	}
+
+	exist, err := h.client.User.Query().Where(user.Email(req.Email)).Exist(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed querying user: %w", err)
+	}
+	if exist {
+		return nil, "", fmt.Errorf("user with email %s already exists", req.Email)
+	}
+
+	token, err := utils.GenerateRefreshToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	duration := settings.InvitationExpireTime.GetDuration()
+	inv, err := h.client.Invitation.Create().
+		SetEmail(req.Email).
+		SetRole(invitation.Role(req.Role)).
+		SetToken(utils.HashToken(token)).
+		SetInviter(inviter).
+		SetExpiresAt(time.Now().Add(duration)).
+		Save(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	publicURL, err := getPublicURL()
+	link := publicURL + invitationPath + token
+	if mailer.Enabled() && err != nil {
+		slog.Error("not sending the invitation email", "invitation", inv.ID, "error", err)
+	} else if mailer.Enabled() {
+		body := fmt.Sprintf("Hi,\n\n"+
+			"%s invited you to %s. Open the following link to create your account, "+
+			"it expires in %s and can only be used once:\n\n%s\n",
+			inviter.Name, settings.UIPl.Get(), duration, link)
+		go func() {
+			if err := mailer.Send(req.Email, fmt.Sprintf(invitationSubject, settings.UIPl.Get()), body); err != nil {
+				slog.Error("failed to send invitation email", "invitation", inv.ID, "error", err)
+			}
+		}()
+	}
+	return inv, link, nil
+}
+
+func (h *Handler) ListInvitations(ctx context.Context) (entv1.Invitations, error) {
+	invitations, err := h.client.Invitation.Query().
+		Order(entv1.Desc(invitation.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying invitations: %w", err)
+	}
+	return invitations, nil
+}
+
+// RevokeInvitation voids an unused invitation.
+func (h *Handler) RevokeInvitation(ctx context.Context, id uuid.UUID) error {
+	n, err := h.client.Invitation.Update().
+		Where(invitation.ID(id), invitation.UsedAtIsNil(), invitation.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("invitation %s does not exist or is not pending", id)
+	}
+	return nil
+}
+
+// ClaimInvitation marks a valid invitation as used, so no other signup can use it.
+func (h *Handler) ClaimInvitation(ctx context.Context, token string) (*entv1.Invitation, error) {
+	now := time.Now()
+	inv, err := h.client.Invitation.Query().
+		Where(invitation.Token(utils.HashToken(token))).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying invitation: %w", err)
+	}
+
+	n, err := h.client.Invitation.Update().
+		Where(invitation.ID(inv.ID),
+			invitation.UsedAtIsNil(),
+			invitation.RevokedAtIsNil(),
+			invitation.ExpiresAtGT(now)).
+		SetUsedAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, fmt.Errorf("invitation %s is expired, revoked or used", inv.ID)
+	}
+	return inv, nil
+}
+
+// ReleaseInvitation makes a claimed invitation usable again, e.g., if the signup failed.
+func (h *Handler) ReleaseInvitation(ctx context.Context, id uuid.UUID) error {
+	return h.client.Invitation.UpdateOneID(id).
+		ClearUsedAt().
+		Exec(ctx)
+}
@@ -0,0 +1,120 @@
+package auth
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/llmos-ai/llmos-dashboard/pkg/constant"
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
+)
+
+type SignUpWithInviteRequest struct {
+	Name        string `json:"name" binding:"required"`
+	Password    string `json:"password" binding:"required"`
+	InviteToken string `json:"inviteToken" binding:"required"`
+}
+
+func (h *Handler) CreateUserInvitation(c *gin.Context) {
+	user, err := utils.GetSessionUser(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	var req CreateInvitationRequest
+	if err = c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if denyAdminChange(c, req.Role) {
+		return
+	}
+
+	inv, link, err := h.CreateInvitation(c.Request.Context(), user, req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"invitation": inv,
+		// the link is only returned once
+		"link": link,
+	})
+}
+
+func (h *Handler) ListUserInvitations(c *gin.Context) {
+	invitations, err := h.ListInvitations(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, invitations)
+}
+
+func (h *Handler) RevokeUserInvitation(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	if err = h.RevokeInvitation(c.Request.Context(), id); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, true)
+}
+
+// SignUpWithInvite creates the account of an invited user, even if public signup is disabled.
+func (h *Handler) SignUpWithInvite(c *gin.Context) {
+	var req SignUpWithInviteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := utils.ValidatePasswordPolicy(req.Password); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	hashPw, err := utils.HashPassword(req.Password)
+	if err != nil {
+		slog.Error("failed to hash password", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": constant.MessageErrorHashPassword})
+		return
+	}
+
+	inv, err := h.ClaimInvitation(c.Request.Context(), req.InviteToken)
+	if err != nil {
+		slog.Debug("rejecting invitation", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": constant.MessageErrorInvitation})
+		return
+	}
+
+	user, err := h.CreateUser(c.Request.Context(), &entv1.User{
+		Name:            req.Name,
+		Email:           inv.Email,
+		Password:        hashPw,
+		Role:            entv1User.Role(inv.Role),
+		ProfileImageUrl: "/user.png",
+	})
+	if err != nil {
+		// keep the invitation usable, e.g., to retry with another name
+		if err := h.ReleaseInvitation(c.Request.Context(), inv.ID); err != nil {
+			slog.Error("failed to release invitation", "error", err)
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Set("user", user)
+	h.issueSession(c, user)
+}
@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+	"github.com/llmos-ai/llmos-dashboard/pkg/mailer/mailertest"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+)
+
+var inviteLinkRe = regexp.MustCompile(`(\S+)` + regexp.QuoteMeta(invitationPath) + `(\S+)`)
+
+func TestCreateInvitationWithoutPublicURL(t *testing.T) {
+	h := newTestHandler(t)
+	srv := mailertest.NewServer(t)
+	srv.Configure(t)
+	setSetting(t, settings.PublicURL, "")
+	admin := createTestUser(t, h, "admin", entv1User.RoleAdmin, "Passw0rd")
+
+	_, link, err := h.CreateInvitation(h.ctx, admin, CreateInvitationRequest{Email: "dave@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(link, invitationPath) {
+		t.Errorf("link = %q, want it relative to the dashboard", link)
+	}
+	select {
+	case msg := <-srv.Messages:
+		t.Fatalf("invitation emailed without a public url:\n%s", msg.Data)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestInvitationFlow(t *testing.T) {
+	h := newTestHandler(t)
+	srv := mailertest.NewServer(t)
+	srv.Configure(t)
+	setSetting(t, settings.PublicURL, "https://dashboard.example.com")
+	setSetting(t, settings.PasswordMinLength, "10")
+	admin := createTestUser(t, h, "admin", entv1User.RoleAdmin, "Passw0rd")
+
+	inv, link, err := h.CreateInvitation(h.ctx, admin, CreateInvitationRequest{Email: "dave@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var msg mailertest.Message
+	select {
+	case msg = <-srv.Messages:
+	case <-time.After(5 * time.Second):
+		t.Fatal("no invitation email received")
+	}
+	m := inviteLinkRe.FindStringSubmatch(msg.Data)
+	if m == nil {
+		t.Fatalf("no invitation link in the email:\n%s", msg.Data)
+	}
+	if m[1] != "https://dashboard.example.com" || m[1]+invitationPath+m[2] != link {
+		t.Errorf("emailed link %s, returned link %s", m[0], link)
+	}
+	token := m[2]
+
+	// a password against the policy is rejected and keeps the invitation usable
+	w := serve(t, h.SignUpWithInvite, http.MethodPost,
+		SignUpWithInviteRequest{Name: "dave", Password: "short", InviteToken: token}, nil)
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("weak password: status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+	if h.client.Invitation.GetX(h.ctx, inv.ID).UsedAt != nil {
+		t.Fatal("invitation is used by the rejected signup")
+	}
+
+	w = serve(t, h.SignUpWithInvite, http.MethodPost,
+		SignUpWithInviteRequest{Name: "dave", Password: "long enough password", InviteToken: token}, nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("signup: status = %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+	}
+	user, err := h.GetUserByEmail(h.ctx, "dave@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.Role != entv1User.RoleUser {
+		t.Errorf("role = %s, want %s", user.Role, entv1User.RoleUser)
+	}
+
+	w = serve(t, h.SignUpWithInvite, http.MethodPost,
+		SignUpWithInviteRequest{Name: "eve", Password: "long enough password", InviteToken: token}, nil)
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("reused invitation: status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,170 @@
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	entuser "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+)
+
+const ldapTimeout = 10 * time.Second
+
+// rolePriority is used to pick the most privileged role if a user is in several mapped groups.
+var rolePriority = map[entuser.Role]int{
+	entuser.RolePending: 0,
+	entuser.RoleUser:    1,
+	entuser.RoleAdmin:   2,
+}
+
+// LDAPEnabled returns whether users can sign in with their LDAP or Active Directory credentials.
+func LDAPEnabled() bool {
+	return settings.LDAPServerURL.Get() != ""
+}
+
+// ParseLDAPGroupRoleMapping parses the JSON object of group DN to role, keys are lower-cased
+// since DNs are compared case-insensitively.
+func ParseLDAPGroupRoleMapping(value string) (map[string]entuser.Role, error) {
+	mapping := map[string]entuser.Role{}
+	if value == "" {
+		return mapping, nil
+	}
+
+	raw := map[string]string{}
+	if err := json.Unmarshal([]byte(value), &raw); err != nil {
+		return nil, fmt.Errorf("invalid group role mapping: %w", err)
+	}
+
+	for group, role := range raw {
+		if err := entuser.RoleValidator(entuser.Role(role)); err != nil {
+			return nil, fmt.Errorf("invalid role %q of group %s", role, group)
+		}
+		mapping[strings.ToLower(group)] = entuser.Role(role)
+	}
+	return mapping, nil
+}
+
+// ldapAuthenticator searches the user with a service account, binds as the user to verify the
+// password and mirrors the entry into the user table.
+type ldapAuthenticator struct {
+	h *Handler
+}
+
+func (a *ldapAuthenticator) Name() string {
+	return "ldap"
+}
+
+func (a *ldapAuthenticator) Authenticate(ctx context.Context, login, password string) (*entv1.User, error) {
+	// an empty password would result in an unauthenticated bind that always succeeds
+	if login == "" || password == "" {
+		return nil, ErrInvalidCredentials
+	}
+
+	conn, err := dialLDAP()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if bindDN := settings.LDAPBindDN.Get(); bindDN != "" {
+		if err = conn.Bind(bindDN, settings.LDAPBindPassword.Get()); err != nil {
+			return nil, fmt.Errorf("failed to bind ldap service account: %w", err)
+		}
+	}
+
+	emailAttr := settings.LDAPEmailAttribute.Get()
+	nameAttr := settings.LDAPNameAttribute.Get()
+	groupAttr := settings.LDAPGroupAttribute.Get()
+
+	filter := strings.ReplaceAll(settings.LDAPUserSearchFilter.Get(), "%s", ldap.EscapeFilter(login))
+	result, err := conn.Search(ldap.NewSearchRequest(
+		settings.LDAPUserSearchBase.Get(),
+		ldap.ScopeWholeSubtree, ldap.NeverDerefAliases,
+		2, int(ldapTimeout.Seconds()), false,
+		filter,
+		[]string{emailAttr, nameAttr, groupAttr},
+		nil,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to search ldap user: %w", err)
+	}
+	if len(result.Entries) != 1 {
+		return nil, ErrInvalidCredentials
+	}
+	entry := result.Entries[0]
+
+	if err = conn.Bind(entry.DN, password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, fmt.Errorf("failed to bind ldap user: %w", err)
+	}
+
+	email := entry.GetAttributeValue(emailAttr)
+	if email == "" {
+		email = login
+	}
+
+	user, err := a.h.GetOrCreateExternalUser(ctx, email, entry.GetAttributeValue(nameAttr), "")
+	if err != nil {
+		return nil, err
+	}
+
+	return a.syncRole(ctx, user, entry.GetAttributeValues(groupAttr))
+}
+
+// syncRole sets the role of the user from its group membership if one of its groups is mapped to a role,
+// users without a mapped group keep the role given in the dashboard.
+func (a *ldapAuthenticator) syncRole(ctx context.Context, user *entv1.User, groups []string) (*entv1.User, error) {
+	mapping, err := ParseLDAPGroupRoleMapping(settings.LDAPGroupRoleMapping.Get())
+	if err != nil {
+		return nil, err
+	}
+
+	var role entuser.Role
+	for _, group := range groups {
+		if r, ok := mapping[strings.ToLower(group)]; ok && (role == "" || rolePriority[r] > rolePriority[role]) {
+			role = r
+		}
+	}
+
+	if role == "" || user.Role == role {
+		return user, nil
+	}
+
+	updated, err := a.h.UpdateUserRoleByID(ctx, user.ID, role)
+	if err != nil {
+		return nil, err
+	}
+	if err = a.h.RevokeUserSessions(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func dialLDAP() (*ldap.Conn, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: settings.LDAPInsecureSkipVerify.Get() == "true",
+	}
+
+	conn, err := ldap.DialURL(settings.LDAPServerURL.Get(), ldap.DialWithTLSConfig(tlsConfig))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect ldap server: %w", err)
+	}
+	conn.SetTimeout(ldapTimeout)
+
+	if settings.LDAPStartTLS.Get() == "true" {
+		if err = conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("failed to start tls: %w", err)
+		}
+	}
+	return conn, nil
+}
@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"testing"
+
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+)
+
+func TestLDAPSyncRole(t *testing.T) {
+	const (
+		admins = "cn=admins,dc=example,dc=com"
+		staff  = "cn=staff,dc=example,dc=com"
+		other  = "cn=other,dc=example,dc=com"
+	)
+
+	tests := []struct {
+		name    string
+		mapping string
+		role    entv1User.Role
+		groups  []string
+		want    entv1User.Role
+	}{
+		{"no mapping", "", entv1User.RoleAdmin, []string{admins}, entv1User.RoleAdmin},
+		{"mapped group", `{"cn=staff,dc=example,dc=com":"user"}`, entv1User.RolePending, []string{other, staff}, entv1User.RoleUser},
+		{"group dn is case insensitive", `{"CN=Staff,DC=example,DC=com":"user"}`, entv1User.RolePending, []string{staff}, entv1User.RoleUser},
+		{"most privileged group", `{"cn=admins,dc=example,dc=com":"admin","cn=staff,dc=example,dc=com":"user"}`, entv1User.RolePending, []string{staff, admins}, entv1User.RoleAdmin},
+		{"demoted by a mapped group", `{"cn=staff,dc=example,dc=com":"user"}`, entv1User.RoleAdmin, []string{staff}, entv1User.RoleUser},
+		{"local admin without a mapped group", `{"cn=staff,dc=example,dc=com":"user"}`, entv1User.RoleAdmin, []string{other}, entv1User.RoleAdmin},
+		{"approved user without groups", `{"cn=staff,dc=example,dc=com":"user"}`, entv1User.RoleUser, nil, entv1User.RoleUser},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newTestHandler(t)
+			setSetting(t, settings.LDAPGroupRoleMapping, tt.mapping)
+			user := createTestUser(t, h, "alice", tt.role, "Passw0rd")
+
+			a := &ldapAuthenticator{h: h}
+			synced, err := a.syncRole(h.ctx, user, tt.groups)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if synced.Role != tt.want {
+				t.Errorf("role = %s, want %s", synced.Role, tt.want)
+			}
+			if stored := h.client.User.GetX(h.ctx, user.ID).Role; stored != tt.want {
+				t.Errorf("stored role = %s, want %s", stored, tt.want)
+			}
+		})
+	}
+}
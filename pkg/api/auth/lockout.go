@@ -0,0 +1,191 @@
+package auth
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/llmos-ai/llmos-dashboard/pkg/constant"
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/loginfailure"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+)
+
+const (
+	loginFailureInvalidCredentials = "invalid_credentials"
+	loginFailureInvalidMFACode     = "invalid_mfa_code"
+
+	loginLimiterPruneInterval = time.Minute
+)
+
+var limiter = &loginLimiter{entries: map[string]*loginFailures{}}
+
+// loginLimiter tracks the recent failed sign-ins per IP and per account in memory
+// to delay further attempts with an exponential backoff.
+type loginLimiter struct {
+	lock      sync.Mutex
+	entries   map[string]*loginFailures
+	lastPrune time.Time
+}
+
+type loginFailures struct {
+	count        int
+	last         time.Time
+	blockedUntil time.Time
+}
+
+// retryAfter returns how long the key has to wait until its next attempt is evaluated.
+func (l *loginLimiter) retryAfter(key string) time.Duration {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if f, ok := l.entries[key]; ok {
+		return time.Until(f.blockedUntil)
+	}
+	return 0
+}
+
+// fail records a failed attempt, every failure beyond the free ones doubles the delay.
+func (l *loginLimiter) fail(key string, free int) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := time.Now()
+	window := settings.LoginFailureWindow.GetDuration()
+	l.prune(now, window)
+
+	f, ok := l.entries[key]
+	if !ok || now.Sub(f.last) > window {
+		f = &loginFailures{}
+		l.entries[key] = f
+	}
+	f.count++
+	f.last = now
+
+	if f.count <= free {
+		return
+	}
+	backoff := settings.LoginBackoffBase.GetDuration() * time.Duration(math.Pow(2, float64(min(f.count-free-1, 30))))
+	f.blockedUntil = now.Add(min(backoff, settings.LoginBackoffMax.GetDuration()))
+}
+
+func (l *loginLimiter) reset(key string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	delete(l.entries, key)
+}
+
+func (l *loginLimiter) prune(now time.Time, window time.Duration) {
+	if now.Sub(l.lastPrune) < loginLimiterPruneInterval {
+		return
+	}
+	l.lastPrune = now
+	for key, f := range l.entries {
+		if now.Sub(f.last) > window && now.After(f.blockedUntil) {
+			delete(l.entries, key)
+		}
+	}
+}
+
+func ipLimiterKey(c *gin.Context) string {
+	return "ip:" + c.ClientIP()
+}
+
+func accountLimiterKey(login string) string {
+	return "account:" + strings.ToLower(login)
+}
+
+// loginBlocked rejects a sign-in attempt before any password is checked if the IP or account
+// is in backoff, or the account is locked.
+func (h *Handler) loginBlocked(c *gin.Context, login string) bool {
+	wait := max(limiter.retryAfter(ipLimiterKey(c)), limiter.retryAfter(accountLimiterKey(login)))
+	if wait > 0 {
+		abortWithRetryAfter(c, 429, constant.MessageErrorLoginLimited, wait)
+		return true
+	}
+
+	u, err := h.GetUserByEmail(c.Request.Context(), login)
+	if err == nil && u.LockedUntil != nil && u.LockedUntil.After(time.Now()) {
+		abortWithRetryAfter(c, 423, constant.MessageErrorLoginLocked, time.Until(*u.LockedUntil))
+		return true
+	}
+	return false
+}
+
+// loginFailed records the failure for admins, applies the backoff and locks the account once it
+// reaches the configured number of failures within the window.
+func (h *Handler) loginFailed(c *gin.Context, login, reason string) {
+	if err := h.client.LoginFailure.Create().
+		SetEmail(login).
+		SetIP(c.ClientIP()).
+		SetUserAgent(c.Request.UserAgent()).
+		SetReason(reason).
+		Exec(c.Request.Context()); err != nil {
+		slog.Error("failed to record login failure", "error", err)
+	}
+
+	limiter.fail(ipLimiterKey(c), settings.LoginIPMaxFailures.GetInt())
+	limiter.fail(accountLimiterKey(login), 0)
+
+	u, err := h.GetUserByEmail(c.Request.Context(), login)
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+	count := 1
+	if u.LastFailedLoginAt != nil && now.Sub(*u.LastFailedLoginAt) <= settings.LoginFailureWindow.GetDuration() {
+		count = u.FailedLoginCount + 1
+	}
+
+	update := h.client.User.UpdateOne(u).
+		SetFailedLoginCount(count).
+		SetLastFailedLoginAt(now)
+	if maxFailures := settings.LoginMaxFailures.GetInt(); maxFailures > 0 && count >= maxFailures {
+		slog.Info("locking account after failed sign-ins", "user", u.ID, "failures", count)
+		update.SetLockedUntil(now.Add(settings.LoginLockoutDuration.GetDuration()))
+	}
+	if err = update.Exec(c.Request.Context()); err != nil {
+		slog.Error("failed to update login failures", "error", err)
+	}
+}
+
+// loginSucceeded clears the failures of the account after a successful sign-in.
+func (h *Handler) loginSucceeded(ctx context.Context, login string, u *entv1.User) {
+	limiter.reset(accountLimiterKey(login))
+	if u.FailedLoginCount == 0 && u.LockedUntil == nil {
+		return
+	}
+	if err := h.UnlockUser(ctx, u.ID); err != nil {
+		slog.Error("failed to reset login failures", "error", err)
+	}
+}
+
+func (h *Handler) UnlockUser(ctx context.Context, id uuid.UUID) error {
+	return h.client.User.UpdateOneID(id).
+		SetFailedLoginCount(0).
+		ClearLastFailedLoginAt().
+		ClearLockedUntil().
+		Exec(ctx)
+}
+
+func abortWithRetryAfter(c *gin.Context, code int, msg string, wait time.Duration) {
+	c.Header("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+	c.AbortWithStatusJSON(code, gin.H{"error": msg})
+}
+
+// GetLoginFailures returns the most recent failed sign-ins, optionally of a single email.
+func (h *Handler) GetLoginFailures(ctx context.Context, email string, limit int) (entv1.LoginFailures, error) {
+	query := h.client.LoginFailure.Query()
+	if email != "" {
+		query.Where(loginfailure.Email(email))
+	}
+	return query.Order(entv1.Desc(loginfailure.FieldCreatedAt)).Limit(limit).All(ctx)
+}
@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const defaultLoginFailuresLimit = 100
+
+func (h *Handler) ListLoginFailures(c *gin.Context) {
+	limit := defaultLoginFailuresLimit
+	if v := c.Query("limit"); v != "" {
+		l, err := strconv.Atoi(v)
+		if err != nil || l <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit"})
+			return
+		}
+		limit = l
+	}
+
+	failures, err := h.GetLoginFailures(c.Request.Context(), c.Query("email"), limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, failures)
+}
+
+func (h *Handler) UnlockUserByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	user, err := h.GetUserByID(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err = h.UnlockUser(c.Request.Context(), user.ID); err != nil {
+		slog.Error("failed to unlock user", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	limiter.reset(accountLimiterKey(user.Email))
+
+	c.JSON(http.StatusOK, true)
+}
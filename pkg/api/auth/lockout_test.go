@@ -0,0 +1,210 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+)
+
+// setLoginLimits configures the lockout for the test and starts with a fresh backoff limiter,
+// the backoff is negligible unless the test sets LoginBackoffBase.
+func setLoginLimits(t *testing.T, maxFailures string) {
+	t.Helper()
+	prev := limiter
+	limiter = &loginLimiter{entries: map[string]*loginFailures{}}
+	t.Cleanup(func() {
+		limiter = prev
+	})
+	setSetting(t, settings.LoginMaxFailures, maxFailures)
+	setSetting(t, settings.LoginIPMaxFailures, "100")
+	setSetting(t, settings.LoginFailureWindow, "15m")
+	setSetting(t, settings.LoginLockoutDuration, "15m")
+	setSetting(t, settings.LoginBackoffBase, "1ns")
+}
+
+func signIn(t *testing.T, h *Handler, email, password string) *httptest.ResponseRecorder {
+	t.Helper()
+	return serve(t, h.SignIn, http.MethodPost, LoginRequest{Email: email, Password: password}, nil)
+}
+
+func TestLoginLockout(t *testing.T) {
+	h := newTestHandler(t)
+	setLoginLimits(t, "3")
+	alice := createTestUser(t, h, "alice", entv1User.RoleUser, "Passw0rd")
+
+	for i := 0; i < 3; i++ {
+		if w := signIn(t, h, alice.Email, "wrong"); w.Code != http.StatusUnauthorized {
+			t.Fatalf("failure %d: status = %d, want %d", i+1, w.Code, http.StatusUnauthorized)
+		}
+	}
+	locked := h.client.User.GetX(h.ctx, alice.ID)
+	if locked.FailedLoginCount != 3 || locked.LockedUntil == nil {
+		t.Fatalf("failed logins = %d, locked until %v, want 3 and locked", locked.FailedLoginCount, locked.LockedUntil)
+	}
+	if n := h.client.LoginFailure.Query().CountX(h.ctx); n != 3 {
+		t.Errorf("%d login failures recorded, want 3", n)
+	}
+
+	// the correct password is not checked while the account is locked
+	w := signIn(t, h, alice.Email, "Passw0rd")
+	if w.Code != http.StatusLocked {
+		t.Fatalf("sign in to the locked account: status = %d, want %d", w.Code, http.StatusLocked)
+	}
+	if got := w.Header().Get("Retry-After"); got != "900" {
+		t.Errorf("Retry-After = %q, want 900", got)
+	}
+
+	// the lock ends after the lockout duration, and a successful sign-in clears the failures
+	h.client.User.UpdateOne(locked).SetLockedUntil(time.Now().Add(-time.Second)).ExecX(h.ctx)
+	if w = signIn(t, h, alice.Email, "Passw0rd"); w.Code != http.StatusOK {
+		t.Fatalf("sign in after the lockout: status = %d: %s", w.Code, w.Body)
+	}
+	if u := h.client.User.GetX(h.ctx, alice.ID); u.FailedLoginCount != 0 || u.LockedUntil != nil || u.LastFailedLoginAt != nil {
+		t.Errorf("failures not cleared: %d, locked until %v", u.FailedLoginCount, u.LockedUntil)
+	}
+}
+
+func TestLoginLockoutWindow(t *testing.T) {
+	tests := []struct {
+		name        string
+		lastFailure time.Duration
+		want        int
+		wantLocked  bool
+	}{
+		{"within the window", -time.Minute, 3, true},
+		{"window passed", -time.Hour, 1, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newTestHandler(t)
+			setLoginLimits(t, "3")
+			alice := createTestUser(t, h, "alice", entv1User.RoleUser, "Passw0rd")
+			h.client.User.UpdateOne(alice).
+				SetFailedLoginCount(2).
+				SetLastFailedLoginAt(time.Now().Add(tt.lastFailure)).
+				ExecX(h.ctx)
+
+			if w := signIn(t, h, alice.Email, "wrong"); w.Code != http.StatusUnauthorized {
+				t.Fatalf("status = %d, want %d", w.Code, http.StatusUnauthorized)
+			}
+			u := h.client.User.GetX(h.ctx, alice.ID)
+			if u.FailedLoginCount != tt.want || (u.LockedUntil != nil) != tt.wantLocked {
+				t.Errorf("failed logins = %d, locked until %v, want %d, locked %v",
+					u.FailedLoginCount, u.LockedUntil, tt.want, tt.wantLocked)
+			}
+		})
+	}
+}
+
+func TestLoginLockoutDisabled(t *testing.T) {
+	h := newTestHandler(t)
+	setLoginLimits(t, "0")
+	alice := createTestUser(t, h, "alice", entv1User.RoleUser, "Passw0rd")
+
+	for i := 0; i < 10; i++ {
+		signIn(t, h, alice.Email, "wrong")
+	}
+	if u := h.client.User.GetX(h.ctx, alice.ID); u.LockedUntil != nil {
+		t.Errorf("account locked until %v with the lockout disabled", u.LockedUntil)
+	}
+}
+
+func TestLoginBackoff(t *testing.T) {
+	h := newTestHandler(t)
+	setLoginLimits(t, "0")
+	setSetting(t, settings.LoginBackoffBase, "1m")
+	alice := createTestUser(t, h, "alice", entv1User.RoleUser, "Passw0rd")
+
+	if w := signIn(t, h, alice.Email, "wrong"); w.Code != http.StatusUnauthorized {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+	w := signIn(t, h, alice.Email, "Passw0rd")
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("sign in during the backoff: status = %d, want %d", w.Code, http.StatusTooManyRequests)
+	}
+	if got := w.Header().Get("Retry-After"); got != "60" {
+		t.Errorf("Retry-After = %q, want 60", got)
+	}
+	// the throttled attempt is not another failure
+	if n := h.client.LoginFailure.Query().CountX(h.ctx); n != 1 {
+		t.Errorf("%d login failures recorded, want 1", n)
+	}
+}
+
+func TestUnlockUser(t *testing.T) {
+	h := newTestHandler(t)
+	setLoginLimits(t, "3")
+	setSetting(t, settings.LoginBackoffBase, "1m")
+	alice := createTestUser(t, h, "alice", entv1User.RoleUser, "Passw0rd")
+	h.client.User.UpdateOne(alice).
+		SetFailedLoginCount(3).
+		SetLastFailedLoginAt(time.Now()).
+		SetLockedUntil(time.Now().Add(time.Hour)).
+		ExecX(h.ctx)
+	limiter.fail(accountLimiterKey(alice.Email), 0)
+
+	if w := signIn(t, h, alice.Email, "Passw0rd"); w.Code != http.StatusTooManyRequests {
+		t.Fatalf("sign in before the unlock: status = %d, want %d", w.Code, http.StatusTooManyRequests)
+	}
+
+	r := gin.New()
+	r.POST("/users/:id/unlock", h.UnlockUserByID)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/users/"+alice.ID.String()+"/unlock", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("unlock: status = %d: %s", w.Code, w.Body)
+	}
+	if u := h.client.User.GetX(h.ctx, alice.ID); u.FailedLoginCount != 0 || u.LockedUntil != nil {
+		t.Errorf("failed logins = %d, locked until %v after the unlock", u.FailedLoginCount, u.LockedUntil)
+	}
+
+	// the unlock also ends the backoff of the account
+	if w = signIn(t, h, alice.Email, "Passw0rd"); w.Code != http.StatusOK {
+		t.Errorf("sign in after the unlock: status = %d: %s", w.Code, w.Body)
+	}
+}
+
+// TestCompleteSignInLocked covers the sign-in methods that authenticate without the password
+// check of SignIn, e.g., OIDC and LDAP, where the login may not be the email of the account.
+func TestCompleteSignInLocked(t *testing.T) {
+	tests := []struct {
+		name  string
+		login string
+		totp  bool
+	}{
+		{"ldap uid", "alice", false},
+		{"oidc email", "alice@example.com", false},
+		{"two-factor user", "alice", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newTestHandler(t)
+			setLoginLimits(t, "3")
+			alice := createTestUser(t, h, "alice", entv1User.RoleUser, "Passw0rd")
+			alice = h.client.User.UpdateOne(alice).
+				SetLockedUntil(time.Now().Add(time.Hour)).
+				SetTotpEnabled(tt.totp).
+				SaveX(h.ctx)
+
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest(http.MethodPost, "/", nil)
+			result, err := h.completeSignIn(c, tt.login, alice)
+			if !errors.Is(err, errAccountLocked) {
+				t.Fatalf("result = %+v, err = %v, want the account locked", result, err)
+			}
+			if n := h.client.Session.Query().CountX(h.ctx); n != 0 {
+				t.Errorf("%d sessions created for a locked account", n)
+			}
+			if u := h.client.User.GetX(h.ctx, alice.ID); u.LockedUntil == nil {
+				t.Error("lock cleared by the rejected sign-in")
+			}
+		})
+	}
+}
@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/llmos-ai/llmos-dashboard/pkg/constant"
 	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
 	entuser "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
 	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
@@ -17,13 +19,20 @@ import (
 var authWhiteListPaths = []string{
 	"/api/v1/auths/signin",
 	"/api/v1/auths/signup",
+	"/api/v1/auths/signup/invite",
+	"/api/v1/auths/refresh",
+	"/api/v1/auths/signin/mfa",
+	"/api/v1/auths/oidc/login",
+	"/api/v1/auths/oidc/callback",
+	"/api/v1/auths/password/forgot",
+	"/api/v1/auths/password/reset",
 }
 
 func (h *Handler) AuthMiddleware(c *gin.Context) {
-	slog.Debug("path", c.Request.URL.Path)
+	slog.Debug("auth middleware", "path", c.Request.URL.Path)
 	for _, path := range authWhiteListPaths {
 		if strings.TrimSuffix(strings.ToLower(c.Request.URL.Path), "/") == path {
-			slog.Debug("skipping auth middleware", c.Request.URL.Path)
+			slog.Debug("skipping auth middleware", "path", c.Request.URL.Path)
 			c.Next()
 			return
 		}
@@ -31,24 +40,103 @@ func (h *Handler) AuthMiddleware(c *gin.Context) {
 
 	tokenString := c.GetHeader("Authorization")
 	if tokenString == "" {
+		// users signed in by a trusted reverse proxy carry its headers instead of a token
+		user, err := h.proxyAuthUser(c)
+		if err != nil {
+			slog.Error("failed to get proxy user", "error", err)
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("failed to get user: %s", err.Error())})
+			return
+		}
+		if user != nil {
+			h.proxyAuth(c, user)
+			return
+		}
 		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "authorization header is required"})
 		return
 	}
 
-	tokenString = tokenString[len("Bearer "):]
+	tokenString = strings.TrimPrefix(tokenString, tokenType+" ")
+	if strings.HasPrefix(tokenString, utils.APIKeyPrefix) {
+		h.apiKeyAuth(c, tokenString)
+		return
+	}
+
 	claims, err := utils.VerifyToken(tokenString)
 	if err != nil {
 		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid token"})
 		return
 	}
 
+	// reject tokens whose login session has been revoked, e.g., by sign out or a password change
+	if _, err = h.GetActiveSession(c.Request.Context(), claims.SessionID, claims.UUID); err != nil {
+		slog.Debug("rejecting token of inactive session", "error", err)
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "session has been revoked"})
+		return
+	}
+
 	// if auth exist, find user by token
-	user, err := h.GetUserByID(claims.UUID)
+	user, err := h.GetUserByID(c.Request.Context(), claims.UUID)
 	if err != nil {
-		slog.Error("failed to get user", err)
+		slog.Error("failed to get user", "error", err)
 		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("failed to get user: %s", err.Error())})
 		return
 	}
+	if !h.checkTwoFactorEnrollment(c, user) {
+		return
+	}
+
+	c.Set("user", user)
+	c.Set("sessionId", claims.SessionID)
+	if claims.Impersonator != nil {
+		c.Set("impersonator", claims.Impersonator)
+	}
+	c.Next()
+}
+
+// twoFactorEnrollmentPaths are still reachable by admins that must but have not yet enabled 2FA.
+var twoFactorEnrollmentPaths = []string{
+	"/api/v1/auths",
+	"/api/v1/auths/signout",
+	"/api/v1/auths/2fa/setup",
+	"/api/v1/auths/2fa/enable",
+}
+
+func (h *Handler) checkTwoFactorEnrollment(c *gin.Context, user *entv1.User) bool {
+	if user.TotpEnabled || !TwoFactorRequired(user) {
+		return true
+	}
+
+	path := strings.TrimSuffix(strings.ToLower(c.Request.URL.Path), "/")
+	if slices.Contains(twoFactorEnrollmentPaths, path) {
+		return true
+	}
+
+	c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": constant.MessageErrorMFARequired, "mfaSetupRequired": true})
+	return false
+}
+
+func (h *Handler) proxyAuth(c *gin.Context, user *entv1.User) {
+	if !h.checkTwoFactorEnrollment(c, user) {
+		return
+	}
+
+	c.Set("user", user)
+	c.Set("proxyAuth", true)
+	c.Next()
+}
+
+// apiKeyAuth authenticates requests that carry a personal api key instead of a JWT.
+func (h *Handler) apiKeyAuth(c *gin.Context, key string) {
+	user, err := h.GetUserByAPIKey(c.Request.Context(), key)
+	if err != nil {
+		slog.Debug("rejecting api key", "error", err)
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid api key"})
+		return
+	}
+	if !h.checkTwoFactorEnrollment(c, user) {
+		return
+	}
+
 	c.Set("user", user)
 	c.Next()
 }
@@ -72,6 +160,38 @@ func (h *Handler) AdminMiddleware(c *gin.Context) {
 	c.Next()
 }
 
+// RequirePermission only lets requests through whose session user has all the given permissions.
+func (h *Handler) RequirePermission(permissions ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, err := utils.GetSessionUser(c)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+
+		ok, err := h.HasPermissions(c.Request.Context(), user, permissions...)
+		if err != nil {
+			slog.Error("failed to check permissions", "error", err)
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "forbidden"})
+			return
+		}
+		c.Next()
+	}
+}
+
+// DenyImpersonation rejects requests of admins impersonating a user, e.g., to change the user's credentials.
+func (h *Handler) DenyImpersonation(c *gin.Context) {
+	if utils.GetImpersonator(c) != nil {
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": constant.MessageErrorImpersonation})
+		return
+	}
+	c.Next()
+}
+
 func (h *Handler) SingUpMiddleware(c *gin.Context) {
 	if settings.Signup.Get() != "true" {
 		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Signup is disabled, please contact admin to enable it."})
@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/permission"
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+)
+
+func TestRequirePermission(t *testing.T) {
+	h := newTestHandler(t)
+	if err := h.EnsureRoles(); err != nil {
+		t.Fatal(err)
+	}
+	admin := createTestUser(t, h, "admin", entv1User.RoleAdmin, "Passw0rd")
+	member := createTestUser(t, h, "member", entv1User.RoleUser, "Passw0rd")
+	pending := createTestUser(t, h, "pending", entv1User.RolePending, "Passw0rd")
+	auditor := createTestUser(t, h, "auditor", entv1User.RoleUser, "Passw0rd")
+
+	settingsRead := h.client.Permission.Query().Where(permission.Name(PermissionSettingsRead)).OnlyX(h.ctx)
+	h.client.Role.Create().
+		SetName("auditor").
+		AddPermissions(settingsRead).
+		AddUsers(auditor).
+		ExecX(h.ctx)
+
+	tests := []struct {
+		name        string
+		user        *entv1.User
+		permissions []string
+		want        int
+	}{
+		{"no session user", nil, []string{PermissionChatDelete}, http.StatusUnauthorized},
+		{"admin has all permissions", admin, []string{PermissionSettingsWrite, PermissionDBDownload}, http.StatusOK},
+		{"built-in role permission", member, []string{PermissionChatDelete}, http.StatusOK},
+		{"missing permission", member, []string{PermissionSettingsRead}, http.StatusForbidden},
+		{"pending has no permissions", pending, []string{PermissionChatDelete}, http.StatusForbidden},
+		{"custom role permission", auditor, []string{PermissionSettingsRead}, http.StatusOK},
+		{"custom and built-in role permissions", auditor, []string{PermissionChatDelete, PermissionSettingsRead}, http.StatusOK},
+		{"all permissions are required", auditor, []string{PermissionSettingsRead, PermissionSettingsWrite}, http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			r.GET("/", func(c *gin.Context) {
+				if tt.user != nil {
+					c.Set("user", tt.user)
+				}
+			}, h.RequirePermission(tt.permissions...), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+			if w.Code != tt.want {
+				t.Errorf("status = %d, want %d", w.Code, tt.want)
+			}
+		})
+	}
+}
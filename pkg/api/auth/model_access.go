@@ -0,0 +1,113 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/group"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+)
+
+// ModelAccess restricts the models a user can access to the model whitelist and to the union
+// of the model patterns of the user's groups, a nil pattern list does not restrict.
+type ModelAccess struct {
+	whitelist []string
+	groups    []string
+}
+
+// Check returns an error naming the restriction that does not allow the model.
+func (a *ModelAccess) Check(model string) error {
+	if a.whitelist != nil && !matchModel(a.whitelist, model) {
+		return fmt.Errorf("model %s is not in the model whitelist", model)
+	}
+	if a.groups != nil && !matchModel(a.groups, model) {
+		return fmt.Errorf("model %s is not allowed for your groups", model)
+	}
+	return nil
+}
+
+func (a *ModelAccess) Allowed(model string) bool {
+	return a.Check(model) == nil
+}
+
+// GetModelAccess returns the model access of the user, or nil if the user is not restricted.
+// Groups do not restrict admins, and the whitelist neither if the admin bypass is enabled.
+func (h *Handler) GetModelAccess(ctx context.Context, u *entv1.User) (*ModelAccess, error) {
+	access := &ModelAccess{}
+
+	if u.Role != user.RoleAdmin || settings.ModelWhitelistAdminBypass.Get() != "true" {
+		whitelist, err := ParseModelWhitelist(settings.ModelWhiteList.Get())
+		if err != nil {
+			return nil, err
+		}
+		access.whitelist = whitelist
+	}
+
+	if u.Role != user.RoleAdmin {
+		groups, err := h.client.Group.Query().
+			Where(group.HasUsersWith(user.ID(u.ID))).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed querying groups: %w", err)
+		}
+		for _, g := range groups {
+			access.groups = append(access.groups, g.Models...)
+		}
+		// members of groups without any model pattern can not access any model
+		if len(groups) > 0 && access.groups == nil {
+			access.groups = []string{}
+		}
+	}
+
+	if access.whitelist == nil && access.groups == nil {
+		return nil, nil
+	}
+	return access, nil
+}
+
+// ParseModelWhitelist parses the comma separated model whitelist, nil means all models are allowed.
+func ParseModelWhitelist(value string) ([]string, error) {
+	var patterns []string
+	for _, p := range strings.Split(value, ",") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		patterns = append(patterns, p)
+	}
+	if err := validateModelPatterns(patterns); err != nil {
+		return nil, err
+	}
+	return patterns, nil
+}
+
+// matchModel returns whether the model name matches one of the patterns, a pattern
+// without a tag also matches all tags of the model, e.g., llama3 matches llama3:8b.
+func matchModel(patterns []string, model string) bool {
+	name, _, _ := strings.Cut(model, ":")
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, model); ok {
+			return true
+		}
+		if strings.Contains(p, ":") {
+			continue
+		}
+		if ok, _ := path.Match(p, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func validateModelPatterns(models []string) error {
+	for _, m := range models {
+		if _, err := path.Match(m, ""); err != nil {
+			return fmt.Errorf("invalid model pattern %s: %w", m, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package auth
+
+import (
+	"testing"
+
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+)
+
+func TestMatchModel(t *testing.T) {
+	tests := []struct {
+		patterns []string
+		model    string
+		want     bool
+	}{
+		// a pattern without a tag matches all tags of the model
+		{[]string{"llama3"}, "llama3", true},
+		{[]string{"llama3"}, "llama3:latest", true},
+		{[]string{"llama3"}, "llama3:70b-instruct", true},
+		{[]string{"llama3"}, "llama3.1:8b", false},
+		// a pattern with a tag matches only that tag
+		{[]string{"mistral:7b"}, "mistral:7b", true},
+		{[]string{"mistral:7b"}, "mistral:latest", false},
+		{[]string{"mistral:7b"}, "mistral", false},
+		// globs
+		{[]string{"llama*"}, "llama3.1:8b", true},
+		{[]string{"llama3:*"}, "llama3:8b", true},
+		{[]string{"llama3:*"}, "llama2:7b", false},
+		{[]string{"*:7b"}, "qwen:7b", true},
+		{[]string{"*:7b"}, "qwen:14b", false},
+		{[]string{"phi?"}, "phi3:mini", true},
+		{[]string{"library/*"}, "library/llama3:latest", true},
+		// any of the patterns
+		{[]string{"gemma", "mistral:7b"}, "mistral:7b", true},
+		{[]string{"gemma", "mistral:7b"}, "llama3", false},
+		{[]string{}, "llama3", false},
+	}
+	for _, tt := range tests {
+		if got := matchModel(tt.patterns, tt.model); got != tt.want {
+			t.Errorf("matchModel(%q, %s) = %v, want %v", tt.patterns, tt.model, got, tt.want)
+		}
+	}
+}
+
+func TestParseModelWhitelist(t *testing.T) {
+	got, err := ParseModelWhitelist(" llama3 , ,mistral:7b,")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0] != "llama3" || got[1] != "mistral:7b" {
+		t.Errorf("whitelist = %q, want [llama3 mistral:7b]", got)
+	}
+	if got, _ = ParseModelWhitelist(""); got != nil {
+		t.Errorf("empty whitelist = %q, want nil", got)
+	}
+	if _, err = ParseModelWhitelist("llama3,[a-"); err == nil {
+		t.Error("invalid pattern accepted")
+	}
+}
+
+func TestGetModelAccess(t *testing.T) {
+	tests := []struct {
+		name      string
+		whitelist string
+		bypass    string
+		role      entv1User.Role
+		// model patterns of the user's groups, nil means the user is in no group
+		groups  [][]string
+		allowed []string
+		denied  []string
+	}{
+		{
+			name:    "unrestricted",
+			role:    entv1User.RoleUser,
+			allowed: []string{"llama3:latest", "mistral:7b"},
+		},
+		{
+			name:      "whitelist",
+			whitelist: "llama3",
+			role:      entv1User.RoleUser,
+			allowed:   []string{"llama3:latest"},
+			denied:    []string{"mistral:7b"},
+		},
+		{
+			name:    "union of the groups",
+			role:    entv1User.RoleUser,
+			groups:  [][]string{{"llama3"}, {"mistral:*"}},
+			allowed: []string{"llama3:8b", "mistral:7b"},
+			denied:  []string{"gemma:2b"},
+		},
+		{
+			name:      "whitelist and groups",
+			whitelist: "llama3,mistral",
+			role:      entv1User.RoleUser,
+			groups:    [][]string{{"mistral", "gemma"}},
+			allowed:   []string{"mistral:7b"},
+			denied:    []string{"llama3:latest", "gemma:2b"},
+		},
+		{
+			name:   "groups without models",
+			role:   entv1User.RoleUser,
+			groups: [][]string{{}},
+			denied: []string{"llama3:latest"},
+		},
+		{
+			name:      "admin ignores the groups",
+			whitelist: "llama3",
+			role:      entv1User.RoleAdmin,
+			groups:    [][]string{{"mistral"}},
+			allowed:   []string{"llama3:latest"},
+			denied:    []string{"mistral:7b"},
+		},
+		{
+			name:      "admin bypasses the whitelist",
+			whitelist: "llama3",
+			bypass:    "true",
+			role:      entv1User.RoleAdmin,
+			allowed:   []string{"llama3:latest", "mistral:7b"},
+		},
+		{
+			name:      "bypass is for admins only",
+			whitelist: "llama3",
+			bypass:    "true",
+			role:      entv1User.RoleUser,
+			allowed:   []string{"llama3:latest"},
+			denied:    []string{"mistral:7b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newTestHandler(t)
+			setSetting(t, settings.ModelWhiteList, tt.whitelist)
+			setSetting(t, settings.ModelWhitelistAdminBypass, tt.bypass)
+			alice := createTestUser(t, h, "alice", tt.role, "password")
+			for i, models := range tt.groups {
+				h.client.Group.Create().
+					SetName("group-" + string(rune('a'+i))).
+					SetModels(models).
+					AddUsers(alice).
+					SaveX(h.ctx)
+			}
+
+			access, err := h.GetModelAccess(h.ctx, alice)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, m := range tt.allowed {
+				if access != nil && !access.Allowed(m) {
+					t.Errorf("%s is denied: %v", m, access.Check(m))
+				}
+			}
+			for _, m := range tt.denied {
+				if access == nil || access.Allowed(m) {
+					t.Errorf("%s is allowed", m)
+				}
+			}
+		})
+	}
+}
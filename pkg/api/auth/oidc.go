@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+)
+
+const (
+	oidcCookieName   = "llmos_oidc"
+	oidcCookiePath   = "/api/v1/auths/oidc"
+	oidcCookieMaxAge = 600 // seconds to complete the login at the identity provider
+	oidcCallbackPath = "/api/v1/auths/oidc/callback"
+)
+
+var (
+	oidcProviderLock sync.Mutex
+	oidcProviders    = map[string]*oidc.Provider{}
+
+	errOIDCRedirectURLNotSet = fmt.Errorf("the %s setting is required for OIDC login", settings.OIDCRedirectURLSettingName)
+)
+
+type oidcClaims struct {
+	Email             string `json:"email"`
+	EmailVerified     *bool  `json:"email_verified"`
+	Name              string `json:"name"`
+	PreferredUsername string `json:"preferred_username"`
+	Picture           string `json:"picture"`
+}
+
+// emailVerified returns whether the provider verified the email, so that it can be linked to a user account.
+// Tokens without the email_verified claim are only trusted if the setting allows it.
+func (c oidcClaims) emailVerified() bool {
+	if c.EmailVerified == nil {
+		return settings.OIDCTrustMissingEmailVerified.Get() == "true"
+	}
+	return *c.EmailVerified
+}
+
+// OIDCEnabled returns whether the single sign-on login through an OpenID Connect provider is configured.
+func OIDCEnabled() bool {
+	return settings.OIDCIssuerURL.Get() != "" && settings.OIDCClientID.Get() != ""
+}
+
+// getOIDCProvider returns the provider of the configured issuer, the discovery document
+// is only fetched once per issuer.
+func (h *Handler) getOIDCProvider() (*oidc.Provider, error) {
+	issuer := settings.OIDCIssuerURL.Get()
+
+	oidcProviderLock.Lock()
+	defer oidcProviderLock.Unlock()
+
+	if p, ok := oidcProviders[issuer]; ok {
+		return p, nil
+	}
+
+	p, err := oidc.NewProvider(h.ctx, issuer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover oidc issuer %s: %w", issuer, err)
+	}
+	oidcProviders[issuer] = p
+	return p, nil
+}
+
+// getOIDCConfig returns the client config of the provider, the redirect URL is never derived from the request
+// since the Host and X-Forwarded-Proto headers are controlled by the client.
+func getOIDCConfig(p *oidc.Provider) (*oauth2.Config, error) {
+	redirectURL := settings.OIDCRedirectURL.Get()
+	if redirectURL == "" {
+		return nil, errOIDCRedirectURLNotSet
+	}
+
+	scopes := []string{oidc.ScopeOpenID}
+	for _, scope := range strings.Split(settings.OIDCScopes.Get(), ",") {
+		scope = strings.TrimSpace(scope)
+		if scope != "" && scope != oidc.ScopeOpenID {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	return &oauth2.Config{
+		ClientID:     settings.OIDCClientID.Get(),
+		ClientSecret: settings.OIDCClientSecret.Get(),
+		Endpoint:     p.Endpoint(),
+		RedirectURL:  redirectURL,
+		Scopes:       scopes,
+	}, nil
+}
+
+// oidcCookieSecure returns whether the login session cookie is restricted to https, like the redirect URL.
+func oidcCookieSecure() bool {
+	return strings.HasPrefix(settings.OIDCRedirectURL.Get(), "https://")
+}
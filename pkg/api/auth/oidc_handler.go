@@ -0,0 +1,172 @@
+package auth
+
+import (
+	"crypto/subtle"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/oauth2"
+
+	"github.com/llmos-ai/llmos-dashboard/pkg/constant"
+)
+
+// OIDCLogin starts the authorization code flow with PKCE by redirecting to the identity provider.
+func (h *Handler) OIDCLogin(c *gin.Context) {
+	if !OIDCEnabled() {
+		c.JSON(http.StatusNotFound, gin.H{"error": "OIDC login is not enabled"})
+		return
+	}
+
+	provider, err := h.getOIDCProvider()
+	if err != nil {
+		slog.Error("failed to get oidc provider", "error", err)
+		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		return
+	}
+
+	config, err := getOIDCConfig(provider)
+	if err != nil {
+		slog.Error("refusing oidc login", "error", err)
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+		return
+	}
+
+	state := oauth2.GenerateVerifier()
+	nonce := oauth2.GenerateVerifier()
+	verifier := oauth2.GenerateVerifier()
+
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(oidcCookieName, strings.Join([]string{state, nonce, verifier}, "."), oidcCookieMaxAge,
+		oidcCookiePath, "", oidcCookieSecure(), true)
+
+	c.Redirect(http.StatusFound, config.AuthCodeURL(state, oidc.Nonce(nonce), oauth2.S256ChallengeOption(verifier)))
+}
+
+// OIDCCallback completes the login, the issued tokens are handed to the UI in the URL fragment.
+func (h *Handler) OIDCCallback(c *gin.Context) {
+	cookie, err := c.Cookie(oidcCookieName)
+	c.SetCookie(oidcCookieName, "", -1, oidcCookiePath, "", oidcCookieSecure(), true)
+	if err != nil {
+		oidcLoginFailed(c, "login session not found, please try again")
+		return
+	}
+
+	parts := strings.Split(cookie, ".")
+	if len(parts) != 3 {
+		oidcLoginFailed(c, "invalid login session, please try again")
+		return
+	}
+	state, nonce, verifier := parts[0], parts[1], parts[2]
+
+	if errMsg := c.Query("error"); errMsg != "" {
+		if desc := c.Query("error_description"); desc != "" {
+			errMsg = desc
+		}
+		oidcLoginFailed(c, errMsg)
+		return
+	}
+
+	if subtle.ConstantTimeCompare([]byte(c.Query("state")), []byte(state)) != 1 {
+		oidcLoginFailed(c, "invalid login state, please try again")
+		return
+	}
+
+	provider, err := h.getOIDCProvider()
+	if err != nil {
+		slog.Error("failed to get oidc provider", "error", err)
+		oidcLoginFailed(c, "identity provider is not available")
+		return
+	}
+
+	config, err := getOIDCConfig(provider)
+	if err != nil {
+		slog.Error("refusing oidc login", "error", err)
+		oidcLoginFailed(c, "OIDC login is not configured")
+		return
+	}
+
+	token, err := config.Exchange(c.Request.Context(), c.Query("code"), oauth2.VerifierOption(verifier))
+	if err != nil {
+		slog.Error("failed to exchange oidc code", "error", err)
+		oidcLoginFailed(c, "failed to exchange authorization code")
+		return
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		oidcLoginFailed(c, "id_token is missing in the token response")
+		return
+	}
+
+	idToken, err := provider.Verifier(&oidc.Config{ClientID: config.ClientID}).Verify(c.Request.Context(), rawIDToken)
+	if err != nil {
+		slog.Error("failed to verify oidc id token", "error", err)
+		oidcLoginFailed(c, "invalid id_token")
+		return
+	}
+
+	if subtle.ConstantTimeCompare([]byte(idToken.Nonce), []byte(nonce)) != 1 {
+		oidcLoginFailed(c, "invalid id_token nonce")
+		return
+	}
+
+	var claims oidcClaims
+	if err = idToken.Claims(&claims); err != nil {
+		oidcLoginFailed(c, "invalid id_token claims")
+		return
+	}
+
+	if claims.Email == "" {
+		oidcLoginFailed(c, "email claim is missing, please check the requested scopes")
+		return
+	}
+	// an unverified email must not sign in to the local account with the same email
+	if !claims.emailVerified() {
+		oidcLoginFailed(c, "email is not verified by the identity provider")
+		return
+	}
+
+	name := claims.Name
+	if name == "" {
+		name = claims.PreferredUsername
+	}
+
+	user, err := h.GetOrCreateExternalUser(c.Request.Context(), claims.Email, name, claims.Picture)
+	if err != nil {
+		slog.Error("failed to provision oidc user", "error", err)
+		oidcLoginFailed(c, "failed to provision user")
+		return
+	}
+
+	result, err := h.completeSignIn(c, user.Email, user)
+	if errors.Is(err, errAccountLocked) {
+		oidcLoginFailed(c, constant.MessageErrorLoginLocked)
+		return
+	}
+	if err != nil {
+		slog.Error("failed to generate token", "error", err)
+		oidcLoginFailed(c, "failed to generate token")
+		return
+	}
+
+	fragment := url.Values{}
+	if result.MFAToken != "" {
+		// the UI asks for the second factor and completes the sign-in like a password login
+		fragment.Set("mfaToken", result.MFAToken)
+	} else {
+		fragment.Set("token", result.Tokens.Token)
+		fragment.Set("refreshToken", result.Tokens.RefreshToken)
+	}
+	c.Redirect(http.StatusFound, "/auth#"+fragment.Encode())
+}
+
+func oidcLoginFailed(c *gin.Context, msg string) {
+	fragment := url.Values{}
+	fragment.Set("error", msg)
+	c.Redirect(http.StatusFound, "/auth#"+fragment.Encode())
+}
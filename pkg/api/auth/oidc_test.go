@@ -0,0 +1,442 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/llmos-ai/llmos-dashboard/pkg/constant"
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
+)
+
+const (
+	testOIDCClientID    = "dashboard"
+	testOIDCRedirectURL = "https://dashboard.example.com" + oidcCallbackPath
+)
+
+// mockIssuer is an OpenID Connect provider serving the discovery, JWKS and token endpoints.
+// Authorization codes are issued by authorize, as if the user had signed in at the provider.
+type mockIssuer struct {
+	*httptest.Server
+	key *rsa.PrivateKey
+	// emailVerified is the email_verified claim of the issued ID tokens, nil omits the claim
+	emailVerified any
+
+	mu    sync.Mutex
+	codes map[string]mockAuthorization
+}
+
+type mockAuthorization struct {
+	challenge string
+	nonce     string
+	email     string
+}
+
+func newMockIssuer(t *testing.T) *mockIssuer {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &mockIssuer{key: key, emailVerified: true, codes: map[string]mockAuthorization{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]any{
+			"issuer":                                m.URL,
+			"authorization_endpoint":                m.URL + "/authorize",
+			"token_endpoint":                        m.URL + "/token",
+			"jwks_uri":                              m.URL + "/keys",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]any{"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "test",
+			"alg": "RS256",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", m.token)
+	m.Server = httptest.NewServer(mux)
+	t.Cleanup(m.Close)
+	return m
+}
+
+// authorize returns the code of a sign-in of the email for the authorization URL the dashboard redirected to.
+func (m *mockIssuer) authorize(t *testing.T, authURL *url.URL, email string) string {
+	t.Helper()
+	q := authURL.Query()
+	if q.Get("client_id") != testOIDCClientID || q.Get("redirect_uri") != testOIDCRedirectURL {
+		t.Fatalf("unexpected client in the authorization request: %s", authURL)
+	}
+	if q.Get("code_challenge_method") != "S256" || q.Get("code_challenge") == "" {
+		t.Fatalf("authorization request without PKCE: %s", authURL)
+	}
+	if q.Get("state") == "" || q.Get("nonce") == "" {
+		t.Fatalf("authorization request without state or nonce: %s", authURL)
+	}
+
+	code := oauthRandomString()
+	m.mu.Lock()
+	m.codes[code] = mockAuthorization{challenge: q.Get("code_challenge"), nonce: q.Get("nonce"), email: email}
+	m.mu.Unlock()
+	return code
+}
+
+func (m *mockIssuer) token(w http.ResponseWriter, r *http.Request) {
+	clientID, _, _ := r.BasicAuth()
+	if err := r.ParseForm(); err != nil || r.Form.Get("grant_type") != "authorization_code" {
+		http.Error(w, `{"error":"invalid_request"}`, http.StatusBadRequest)
+		return
+	}
+	if clientID == "" {
+		clientID = r.Form.Get("client_id")
+	}
+
+	m.mu.Lock()
+	auth, ok := m.codes[r.Form.Get("code")]
+	delete(m.codes, r.Form.Get("code"))
+	m.mu.Unlock()
+
+	sum := sha256.Sum256([]byte(r.Form.Get("code_verifier")))
+	if !ok || clientID != testOIDCClientID ||
+		base64.RawURLEncoding.EncodeToString(sum[:]) != auth.challenge {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+		return
+	}
+
+	claims := jwt.MapClaims{
+		"iss":   m.URL,
+		"sub":   auth.email,
+		"aud":   testOIDCClientID,
+		"exp":   time.Now().Add(time.Minute).Unix(),
+		"iat":   time.Now().Unix(),
+		"nonce": auth.nonce,
+		"email": auth.email,
+		"name":  strings.Split(auth.email, "@")[0],
+	}
+	if m.emailVerified != nil {
+		claims["email_verified"] = m.emailVerified
+	}
+	idToken := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	idToken.Header["kid"] = "test"
+	signed, err := idToken.SignedString(m.key)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, map[string]any{
+		"access_token": oauthRandomString(),
+		"token_type":   "Bearer",
+		"expires_in":   60,
+		"id_token":     signed,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func oauthRandomString() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// oidcLogin starts the login and returns the authorization URL and the login session cookie.
+func oidcLogin(t *testing.T, h *Handler) (*url.URL, *http.Cookie) {
+	t.Helper()
+	r := gin.New()
+	r.GET("/login", h.OIDCLogin)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/login", nil))
+	if w.Code != http.StatusFound {
+		t.Fatalf("login: status = %d, want %d: %s", w.Code, http.StatusFound, w.Body)
+	}
+
+	authURL, err := url.Parse(w.Header().Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, cookie := range w.Result().Cookies() {
+		if cookie.Name == oidcCookieName {
+			return authURL, cookie
+		}
+	}
+	t.Fatal("login did not set the session cookie")
+	return nil, nil
+}
+
+// oidcCallback completes the login and returns the fragment of the redirect to the UI.
+func oidcCallback(t *testing.T, h *Handler, query url.Values, cookie *http.Cookie) url.Values {
+	t.Helper()
+	r := gin.New()
+	r.GET("/callback", h.OIDCCallback)
+	req := httptest.NewRequest(http.MethodGet, "/callback?"+query.Encode(), nil)
+	if cookie != nil {
+		req.AddCookie(cookie)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusFound {
+		t.Fatalf("callback: status = %d, want %d: %s", w.Code, http.StatusFound, w.Body)
+	}
+
+	location, err := url.Parse(w.Header().Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if location.Path != "/auth" {
+		t.Fatalf("callback redirected to %s", location)
+	}
+	fragment, err := url.ParseQuery(location.Fragment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fragment
+}
+
+func setupOIDC(t *testing.T) (*Handler, *mockIssuer) {
+	t.Helper()
+	h := newTestHandler(t)
+	issuer := newMockIssuer(t)
+	setSetting(t, settings.OIDCIssuerURL, issuer.URL)
+	setSetting(t, settings.OIDCClientID, testOIDCClientID)
+	setSetting(t, settings.OIDCClientSecret, "secret")
+	setSetting(t, settings.OIDCRedirectURL, testOIDCRedirectURL)
+	return h, issuer
+}
+
+func TestOIDCLogin(t *testing.T) {
+	h, issuer := setupOIDC(t)
+
+	authURL, cookie := oidcLogin(t, h)
+	if !strings.HasPrefix(authURL.String(), issuer.URL+"/authorize?") {
+		t.Fatalf("login redirected to %s", authURL)
+	}
+	code := issuer.authorize(t, authURL, "alice@example.com")
+
+	fragment := oidcCallback(t, h, url.Values{"code": {code}, "state": {authURL.Query().Get("state")}}, cookie)
+	if msg := fragment.Get("error"); msg != "" {
+		t.Fatalf("login failed: %s", msg)
+	}
+	claims, err := utils.VerifyToken(fragment.Get("token"))
+	if err != nil {
+		t.Fatalf("invalid token: %v", err)
+	}
+	user, err := h.GetUserByEmail(h.ctx, "alice@example.com")
+	if err != nil {
+		t.Fatalf("user is not provisioned: %v", err)
+	}
+	if claims.UUID != user.ID {
+		t.Errorf("token of user %s, want %s", claims.UUID, user.ID)
+	}
+	if fragment.Get("refreshToken") == "" {
+		t.Error("no refresh token")
+	}
+}
+
+func TestOIDCCallbackRejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		tamper func(query url.Values, cookie *http.Cookie) *http.Cookie
+		want   string
+	}{
+		{
+			name: "missing login session",
+			tamper: func(url.Values, *http.Cookie) *http.Cookie {
+				return nil
+			},
+			want: "login session not found, please try again",
+		},
+		{
+			name: "state mismatch",
+			tamper: func(query url.Values, cookie *http.Cookie) *http.Cookie {
+				query.Set("state", "forged")
+				return cookie
+			},
+			want: "invalid login state, please try again",
+		},
+		{
+			name: "code verifier mismatch",
+			tamper: func(query url.Values, cookie *http.Cookie) *http.Cookie {
+				parts := strings.Split(cookie.Value, ".")
+				parts[2] = oauthRandomString()
+				return &http.Cookie{Name: cookie.Name, Value: strings.Join(parts, ".")}
+			},
+			want: "failed to exchange authorization code",
+		},
+		{
+			name: "nonce mismatch",
+			tamper: func(query url.Values, cookie *http.Cookie) *http.Cookie {
+				parts := strings.Split(cookie.Value, ".")
+				parts[1] = oauthRandomString()
+				return &http.Cookie{Name: cookie.Name, Value: strings.Join(parts, ".")}
+			},
+			want: "invalid id_token nonce",
+		},
+		{
+			name: "provider error",
+			tamper: func(query url.Values, cookie *http.Cookie) *http.Cookie {
+				query.Set("error", "access_denied")
+				return cookie
+			},
+			want: "access_denied",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, issuer := setupOIDC(t)
+			authURL, cookie := oidcLogin(t, h)
+			code := issuer.authorize(t, authURL, "alice@example.com")
+
+			query := url.Values{"code": {code}, "state": {authURL.Query().Get("state")}}
+			cookie = tt.tamper(query, cookie)
+			fragment := oidcCallback(t, h, query, cookie)
+			if got := fragment.Get("error"); got != tt.want {
+				t.Errorf("error = %q, want %q", got, tt.want)
+			}
+			if fragment.Get("token") != "" {
+				t.Error("token issued")
+			}
+		})
+	}
+}
+
+func TestOIDCLoginWithTwoFactor(t *testing.T) {
+	h, issuer := setupOIDC(t)
+	user := createTestUser(t, h, "bob", entv1User.RoleUser, "Passw0rd")
+	h.client.User.UpdateOne(user).
+		SetTotpSecret("JBSWY3DPEHPK3PXP").
+		SetTotpEnabled(true).
+		ExecX(h.ctx)
+
+	authURL, cookie := oidcLogin(t, h)
+	code := issuer.authorize(t, authURL, user.Email)
+	fragment := oidcCallback(t, h, url.Values{"code": {code}, "state": {authURL.Query().Get("state")}}, cookie)
+
+	if fragment.Get("token") != "" || fragment.Get("refreshToken") != "" {
+		t.Fatal("session issued without the second factor")
+	}
+	claims, err := utils.VerifyMFAToken(fragment.Get("mfaToken"))
+	if err != nil {
+		t.Fatalf("invalid mfa token: %v", err)
+	}
+	if claims.UUID != user.ID {
+		t.Errorf("mfa token of user %s, want %s", claims.UUID, user.ID)
+	}
+}
+
+func TestOIDCLoginLockedAccount(t *testing.T) {
+	h, issuer := setupOIDC(t)
+	user := createTestUser(t, h, "carol", entv1User.RoleUser, "Passw0rd")
+	h.client.User.UpdateOne(user).
+		SetLockedUntil(time.Now().Add(time.Hour)).
+		ExecX(h.ctx)
+
+	authURL, cookie := oidcLogin(t, h)
+	code := issuer.authorize(t, authURL, user.Email)
+	fragment := oidcCallback(t, h, url.Values{"code": {code}, "state": {authURL.Query().Get("state")}}, cookie)
+
+	if got := fragment.Get("error"); got != constant.MessageErrorLoginLocked {
+		t.Errorf("error = %q, want %q", got, constant.MessageErrorLoginLocked)
+	}
+	if fragment.Get("token") != "" {
+		t.Error("session issued for a locked account")
+	}
+}
+
+func TestOIDCLoginEmailVerified(t *testing.T) {
+	tests := []struct {
+		name          string
+		emailVerified any
+		trustMissing  string
+		wantLogin     bool
+	}{
+		{name: "verified", emailVerified: true, wantLogin: true},
+		{name: "not verified", emailVerified: false},
+		{name: "missing claim", emailVerified: nil},
+		{name: "missing claim trusted by setting", emailVerified: nil, trustMissing: "true", wantLogin: true},
+		{name: "not verified trusted by setting", emailVerified: false, trustMissing: "true"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, issuer := setupOIDC(t)
+			admin := createTestUser(t, h, "admin", entv1User.RoleAdmin, "Passw0rd")
+			issuer.emailVerified = tt.emailVerified
+			if tt.trustMissing != "" {
+				setSetting(t, settings.OIDCTrustMissingEmailVerified, tt.trustMissing)
+			}
+
+			authURL, cookie := oidcLogin(t, h)
+			code := issuer.authorize(t, authURL, admin.Email)
+			fragment := oidcCallback(t, h, url.Values{"code": {code}, "state": {authURL.Query().Get("state")}}, cookie)
+
+			if !tt.wantLogin {
+				if got, want := fragment.Get("error"), "email is not verified by the identity provider"; got != want {
+					t.Errorf("error = %q, want %q", got, want)
+				}
+				if fragment.Get("token") != "" {
+					t.Error("session issued for the existing account")
+				}
+				return
+			}
+			claims, err := utils.VerifyToken(fragment.Get("token"))
+			if err != nil {
+				t.Fatalf("invalid token: %v (error %q)", err, fragment.Get("error"))
+			}
+			if claims.UUID != admin.ID {
+				t.Errorf("token of user %s, want %s", claims.UUID, admin.ID)
+			}
+		})
+	}
+}
+
+func TestOIDCLoginRequiresRedirectURL(t *testing.T) {
+	h, _ := setupOIDC(t)
+	setSetting(t, settings.OIDCRedirectURL, "")
+
+	r := gin.New()
+	r.GET("/login", h.OIDCLogin)
+	r.GET("/callback", h.OIDCCallback)
+
+	req := httptest.NewRequest(http.MethodGet, "/login", nil)
+	req.Host = "attacker.example.com"
+	req.Header.Set("X-Forwarded-Proto", "https")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusServiceUnavailable {
+		t.Fatalf("login: status = %d, want %d: %s", w.Code, http.StatusServiceUnavailable, w.Body)
+	}
+	if location := w.Header().Get("Location"); location != "" {
+		t.Errorf("login redirected to %s", location)
+	}
+
+	fragment := oidcCallback(t, h, url.Values{"code": {"code"}, "state": {"state"}},
+		&http.Cookie{Name: oidcCookieName, Value: "state.nonce.verifier"})
+	if got, want := fragment.Get("error"), "OIDC login is not configured"; got != want {
+		t.Errorf("callback error = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,144 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/passwordreset"
+	"github.com/llmos-ai/llmos-dashboard/pkg/mailer"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
+)
+
+const (
+	passwordResetPath     = "/auth#resetToken="
+	passwordResetInterval = time.Minute // at most one email per user within the interval
+	passwordResetSubject  = "Reset your password"
+)
+
+// RequestPasswordReset emails a single-use reset link to the user, a user that requested one
+// within the last interval does not get another.
+func (h *Handler) RequestPasswordReset(ctx context.Context, user *entv1.User) error {
+	publicURL, err := getPublicURL()
+	if err != nil {
+		return err
+	}
+
+	recent, err := h.client.PasswordReset.Query().
+		Where(passwordreset.UserId(user.ID), passwordreset.CreatedAtGT(time.Now().Add(-passwordResetInterval))).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if recent {
+		slog.Debug("skipping password reset, one has been requested recently", "user", user.ID)
+		return nil
+	}
+
+	// drop the expired or used tokens of the user while we are here
+	if _, err = h.client.PasswordReset.Delete().
+		Where(passwordreset.UserId(user.ID),
+			passwordreset.Or(passwordreset.ExpiresAtLT(time.Now()), passwordreset.UsedAtNotNil())).
+		Exec(ctx); err != nil {
+		slog.Error("failed to clean up password resets", "error", err)
+	}
+
+	token, err := utils.GenerateRefreshToken()
+	if err != nil {
+		return err
+	}
+
+	duration := settings.PasswordResetExpireTime.GetDuration()
+	if err = h.client.PasswordReset.Create().
+		SetOwner(user).
+		SetToken(utils.HashToken(token)).
+		SetExpiresAt(time.Now().Add(duration)).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	link := publicURL + passwordResetPath + token
+	body := fmt.Sprintf("Hi %s,\n\n"+
+		"A password reset was requested for your %s account. Open the following link to choose a new password, "+
+		"it expires in %s and can only be used once:\n\n%s\n\n"+
+		"If you did not request it, you can ignore this email.\n",
+		user.Name, settings.UIPl.Get(), duration, link)
+
+	// do not keep the request waiting on the mail server, nor tell apart known emails by the response time
+	go func() {
+		if err := mailer.Send(user.Email, passwordResetSubject, body); err != nil {
+			slog.Error("failed to send password reset email", "user", user.ID, "error", err)
+		}
+	}()
+	return nil
+}
+
+// ConfirmPasswordReset sets the new password of a valid reset token and signs the user out everywhere.
+func (h *Handler) ConfirmPasswordReset(ctx context.Context, token, password string) (*entv1.User, error) {
+	reset, err := h.client.PasswordReset.Query().
+		Where(passwordreset.Token(utils.HashToken(token))).
+		WithOwner().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying password reset: %w", err)
+	}
+	if reset.UsedAt != nil || reset.ExpiresAt.Before(time.Now()) {
+		return nil, fmt.Errorf("password reset %s is expired or used", reset.ID)
+	}
+
+	// only the request that marks the token as used may set the password
+	n, err := h.client.PasswordReset.Update().
+		Where(passwordreset.ID(reset.ID), passwordreset.UsedAtIsNil()).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, fmt.Errorf("password reset %s has been used", reset.ID)
+	}
+
+	hash, err := utils.HashPassword(password)
+	if err != nil {
+		return nil, err
+	}
+	user, err := h.client.User.UpdateOneID(reset.UserId).
+		SetPassword(hash).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the other links of the user are void once the password has changed
+	if err = h.client.PasswordReset.Update().
+		Where(passwordreset.UserId(user.ID), passwordreset.UsedAtIsNil()).
+		SetUsedAt(time.Now()).
+		Exec(ctx); err != nil {
+		slog.Error("failed to invalidate password resets", "error", err)
+	}
+	if err = h.RevokeUserSessions(ctx, user.ID); err != nil {
+		slog.Error("failed to revoke user sessions", "error", err)
+	}
+	if err = h.UnlockUser(ctx, user.ID); err != nil {
+		slog.Error("failed to reset login failures", "error", err)
+	}
+	limiter.reset(accountLimiterKey(user.Email))
+	return user, nil
+}
+
+// errPublicURLNotSet is returned when a link is needed but the public-url setting is empty.
+var errPublicURLNotSet = fmt.Errorf("the %s setting is required to send links", settings.PublicURLSettingName)
+
+// getPublicURL returns the configured external URL of the dashboard. Links are never derived from the
+// request host, it is controlled by the client and would let anyone send poisoned links to other users.
+func getPublicURL() (string, error) {
+	u := settings.PublicURL.Get()
+	if u == "" {
+		return "", errPublicURLNotSet
+	}
+	return strings.TrimSuffix(u, "/"), nil
+}
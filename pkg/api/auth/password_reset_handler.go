@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/llmos-ai/llmos-dashboard/pkg/constant"
+	"github.com/llmos-ai/llmos-dashboard/pkg/mailer"
+	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
+)
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email" binding:"required"`
+}
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+func (h *Handler) ForgotPassword(c *gin.Context) {
+	var req ForgotPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if !mailer.Enabled() {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": constant.MessageErrorMailDisabled})
+		return
+	}
+	if _, err := getPublicURL(); err != nil {
+		slog.Error("refusing to send password reset emails", "error", err)
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": constant.MessageErrorMailDisabled})
+		return
+	}
+
+	// the response is the same whether the email is known or not
+	user, err := h.GetUserByEmail(c.Request.Context(), req.Email)
+	if err == nil {
+		if err = h.RequestPasswordReset(c.Request.Context(), user); err != nil {
+			slog.Error("failed to request password reset", "error", err)
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": true})
+}
+
+func (h *Handler) ResetPassword(c *gin.Context) {
+	var req ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := utils.ValidatePasswordPolicy(req.Password); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if _, err := h.ConfirmPasswordReset(c.Request.Context(), req.Token, req.Password); err != nil {
+		slog.Debug("failed to reset password", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": constant.MessageErrorPasswordReset})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": true})
+}
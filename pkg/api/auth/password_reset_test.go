@@ -0,0 +1,110 @@
+package auth
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/passwordreset"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/session"
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+	"github.com/llmos-ai/llmos-dashboard/pkg/mailer/mailertest"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
+)
+
+var resetLinkRe = regexp.MustCompile(`(\S+)` + regexp.QuoteMeta(passwordResetPath) + `(\S+)`)
+
+func TestForgotPasswordRequiresPublicURL(t *testing.T) {
+	h := newTestHandler(t)
+	srv := mailertest.NewServer(t)
+	srv.Configure(t)
+	setSetting(t, settings.PublicURL, "")
+	createTestUser(t, h, "alice", entv1User.RoleUser, "Old-Passw0rd")
+
+	w := serve(t, h.ForgotPassword, http.MethodPost, ForgotPasswordRequest{Email: "alice@example.com"},
+		http.Header{"X-Forwarded-Proto": {"https"}, "X-Forwarded-Host": {"evil.example.com"}})
+	if w.Code != http.StatusServiceUnavailable {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusServiceUnavailable)
+	}
+	if n := h.client.PasswordReset.Query().CountX(h.ctx); n != 0 {
+		t.Errorf("%d reset tokens created without a public url", n)
+	}
+	select {
+	case msg := <-srv.Messages:
+		t.Fatalf("unexpected email sent:\n%s", msg.Data)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestPasswordResetFlow(t *testing.T) {
+	h := newTestHandler(t)
+	srv := mailertest.NewServer(t)
+	srv.Configure(t)
+	setSetting(t, settings.PublicURL, "https://dashboard.example.com/")
+	user := createTestUser(t, h, "bob", entv1User.RoleUser, "Old-Passw0rd")
+	h.client.Session.Create().
+		SetOwner(user).
+		SetRefreshToken("refresh").
+		SetExpiresAt(time.Now().Add(time.Hour)).
+		ExecX(h.ctx)
+
+	// an unknown email gets the same answer and no email
+	w := serve(t, h.ForgotPassword, http.MethodPost, ForgotPasswordRequest{Email: "nobody@example.com"}, nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("unknown email: status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	// the link must not follow the request host
+	w = serve(t, h.ForgotPassword, http.MethodPost, ForgotPasswordRequest{Email: "bob@example.com"},
+		http.Header{"X-Forwarded-Proto": {"http"}, "Host": {"evil.example.com"}})
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	var msg mailertest.Message
+	select {
+	case msg = <-srv.Messages:
+	case <-time.After(5 * time.Second):
+		t.Fatal("no email received")
+	}
+	if len(msg.To) != 1 || msg.To[0] != "bob@example.com" {
+		t.Fatalf("email sent to %v", msg.To)
+	}
+	m := resetLinkRe.FindStringSubmatch(msg.Data)
+	if m == nil {
+		t.Fatalf("no reset link in the email:\n%s", msg.Data)
+	}
+	if m[1] != "https://dashboard.example.com" {
+		t.Errorf("reset link points to %s", m[1])
+	}
+	token := m[2]
+
+	// a weak password is rejected without using the token
+	w = serve(t, h.ResetPassword, http.MethodPost, ResetPasswordRequest{Token: token, Password: "weak"}, nil)
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("weak password: status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+
+	w = serve(t, h.ResetPassword, http.MethodPost, ResetPasswordRequest{Token: token, Password: "New-Passw0rd"}, nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("reset: status = %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+	}
+	updated := h.client.User.GetX(h.ctx, user.ID)
+	if !utils.CheckPasswordHash("New-Passw0rd", updated.Password) {
+		t.Error("password is not changed")
+	}
+	if n := h.client.Session.Query().Where(session.UserId(user.ID), session.RevokedAtIsNil()).CountX(h.ctx); n != 0 {
+		t.Errorf("%d sessions are still active after the reset", n)
+	}
+	if n := h.client.PasswordReset.Query().Where(passwordreset.UsedAtIsNil()).CountX(h.ctx); n != 0 {
+		t.Errorf("%d reset tokens are still usable", n)
+	}
+
+	// the token is single-use
+	w = serve(t, h.ResetPassword, http.MethodPost, ResetPasswordRequest{Token: token, Password: "Other-Passw0rd"}, nil)
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("reused token: status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
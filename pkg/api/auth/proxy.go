@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+)
+
+// ProxyAuthEnabled returns whether users authenticated by a trusted reverse proxy, e.g., oauth2-proxy, are signed in by its headers.
+func ProxyAuthEnabled() bool {
+	return settings.ProxyAuthEnabled.Get() == "true"
+}
+
+// ParseProxyCIDRs parses the comma separated CIDRs of the trusted proxies.
+func ParseProxyCIDRs(value string) ([]*net.IPNet, error) {
+	var cidrs []*net.IPNet
+	for _, v := range strings.Split(value, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		_, cidr, err := net.ParseCIDR(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cidr: %s", v)
+		}
+		cidrs = append(cidrs, cidr)
+	}
+	return cidrs, nil
+}
+
+// proxyAuthUser returns the user identified by the proxy headers, or nil if the request does not come
+// through a trusted proxy. The user is created on first sight.
+func (h *Handler) proxyAuthUser(c *gin.Context) (*entv1.User, error) {
+	if !ProxyAuthEnabled() {
+		return nil, nil
+	}
+
+	email := strings.TrimSpace(c.GetHeader(settings.ProxyAuthEmailHeader.Get()))
+	if email == "" || !isTrustedProxy(c.RemoteIP()) {
+		return nil, nil
+	}
+
+	name := strings.TrimSpace(c.GetHeader(settings.ProxyAuthNameHeader.Get()))
+	user, err := h.GetOrCreateExternalUser(c.Request.Context(), email, name, "")
+	// lost the race against a concurrent first request of the same user
+	if entv1.IsConstraintError(err) {
+		return h.GetUserByEmail(c.Request.Context(), email)
+	}
+	return user, err
+}
+
+// isTrustedProxy checks the direct peer, the forwarded client addresses can be set by anyone.
+func isTrustedProxy(remoteIP string) bool {
+	ip := net.ParseIP(remoteIP)
+	if ip == nil {
+		return false
+	}
+
+	cidrs, err := ParseProxyCIDRs(settings.ProxyAuthTrustedCIDRs.Get())
+	if err != nil {
+		return false
+	}
+	for _, cidr := range cidrs {
+		if cidr.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,150 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+)
+
+// serveProxied sends a request from the remote address with the headers through the auth middleware
+// and returns the status and the session user.
+func serveProxied(t *testing.T, h *Handler, remoteAddr string, header http.Header) (int, *entv1.User, bool) {
+	t.Helper()
+	var user *entv1.User
+	var proxyAuth bool
+	r := gin.New()
+	r.GET("/api/v1/test", h.AuthMiddleware, func(c *gin.Context) {
+		user, _ = c.MustGet("user").(*entv1.User)
+		proxyAuth = c.GetBool("proxyAuth")
+		c.Status(http.StatusOK)
+	})
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/test", nil)
+	req.RemoteAddr = remoteAddr
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w.Code, user, proxyAuth
+}
+
+func TestProxyAuth(t *testing.T) {
+	tests := []struct {
+		name       string
+		enabled    string
+		cidrs      string
+		remoteAddr string
+		header     http.Header
+		want       int
+	}{
+		{
+			name:       "trusted proxy",
+			enabled:    "true",
+			cidrs:      "10.0.0.0/8, 192.168.1.0/24",
+			remoteAddr: "192.168.1.10:40000",
+			header:     http.Header{"X-Forwarded-Email": {"carol@example.com"}, "X-Forwarded-User": {"Carol"}},
+			want:       http.StatusOK,
+		},
+		{
+			name:       "peer outside the trusted cidrs",
+			enabled:    "true",
+			cidrs:      "10.0.0.0/8",
+			remoteAddr: "192.0.2.1:40000",
+			header:     http.Header{"X-Forwarded-Email": {"carol@example.com"}},
+			want:       http.StatusUnauthorized,
+		},
+		{
+			name:       "forwarded for a trusted address",
+			enabled:    "true",
+			cidrs:      "10.0.0.0/8",
+			remoteAddr: "192.0.2.1:40000",
+			header: http.Header{"X-Forwarded-Email": {"carol@example.com"},
+				"X-Forwarded-For": {"10.0.0.1"}, "X-Real-Ip": {"10.0.0.1"}},
+			want: http.StatusUnauthorized,
+		},
+		{
+			name:       "no trusted cidrs",
+			enabled:    "true",
+			remoteAddr: "10.0.0.1:40000",
+			header:     http.Header{"X-Forwarded-Email": {"carol@example.com"}},
+			want:       http.StatusUnauthorized,
+		},
+		{
+			name:       "invalid trusted cidrs",
+			enabled:    "true",
+			cidrs:      "10.0.0.0/8,not-a-cidr",
+			remoteAddr: "10.0.0.1:40000",
+			header:     http.Header{"X-Forwarded-Email": {"carol@example.com"}},
+			want:       http.StatusUnauthorized,
+		},
+		{
+			name:       "disabled",
+			enabled:    "false",
+			cidrs:      "10.0.0.0/8",
+			remoteAddr: "10.0.0.1:40000",
+			header:     http.Header{"X-Forwarded-Email": {"carol@example.com"}},
+			want:       http.StatusUnauthorized,
+		},
+		{
+			name:       "no user header",
+			enabled:    "true",
+			cidrs:      "10.0.0.0/8",
+			remoteAddr: "10.0.0.1:40000",
+			want:       http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newTestHandler(t)
+			setSetting(t, settings.ProxyAuthEnabled, tt.enabled)
+			setSetting(t, settings.ProxyAuthTrustedCIDRs, tt.cidrs)
+
+			code, user, proxyAuth := serveProxied(t, h, tt.remoteAddr, tt.header)
+			if code != tt.want {
+				t.Fatalf("status = %d, want %d", code, tt.want)
+			}
+			if tt.want != http.StatusOK {
+				if n := h.client.User.Query().CountX(h.ctx); n != 0 {
+					t.Errorf("%d users created by an untrusted request", n)
+				}
+				return
+			}
+			if user == nil || user.Email != "carol@example.com" || user.Name != "Carol" || !proxyAuth {
+				t.Errorf("user = %+v, proxy auth = %v, want carol by proxy auth", user, proxyAuth)
+			}
+		})
+	}
+}
+
+func TestProxyAuthSkippedWithAuthorization(t *testing.T) {
+	h := newTestHandler(t)
+	setSetting(t, settings.ProxyAuthEnabled, "true")
+	setSetting(t, settings.ProxyAuthTrustedCIDRs, "10.0.0.0/8")
+	alice := createTestUser(t, h, "alice", entv1User.RoleUser, "Passw0rd")
+	carol := createTestUser(t, h, "carol", entv1User.RoleAdmin, "Passw0rd")
+	token := createTestSession(t, h, alice).Token
+
+	// the token decides the user, not the proxy header
+	code, user, proxyAuth := serveProxied(t, h, "10.0.0.1:40000", http.Header{
+		"Authorization":     {tokenType + " " + token},
+		"X-Forwarded-Email": {carol.Email},
+	})
+	if code != http.StatusOK || user == nil || user.ID != alice.ID || proxyAuth {
+		t.Errorf("status = %d, user = %v, proxy auth = %v, want alice by token", code, user, proxyAuth)
+	}
+
+	// an invalid token does not fall back to the proxy header
+	code, _, _ = serveProxied(t, h, "10.0.0.1:40000", http.Header{
+		"Authorization":     {tokenType + " invalid"},
+		"X-Forwarded-Email": {carol.Email},
+	})
+	if code != http.StatusUnauthorized {
+		t.Errorf("invalid token: status = %d, want %d", code, http.StatusUnauthorized)
+	}
+}
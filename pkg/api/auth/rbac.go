@@ -0,0 +1,238 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/google/uuid"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/permission"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/role"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+)
+
+const (
+	PermissionChatDelete     = "chat.delete"
+	PermissionModelPull      = "model.pull"
+	PermissionModelCreate    = "model.create"
+	PermissionModelDelete    = "model.delete"
+	PermissionModelfileWrite = "modelfile.write"
+	PermissionSettingsRead   = "settings.read"
+	PermissionSettingsWrite  = "settings.write"
+	PermissionUserRead       = "user.read"
+	PermissionUserWrite      = "user.write"
+	PermissionDBDownload     = "db.download"
+)
+
+// Permissions are all known permissions with their description.
+var Permissions = map[string]string{
+	PermissionChatDelete:     "Delete own chats",
+	PermissionModelPull:      "Pull models to the local LLM server",
+	PermissionModelCreate:    "Create models on the local LLM server",
+	PermissionModelDelete:    "Delete models from the local LLM server",
+	PermissionModelfileWrite: "Create, update and delete modelfiles",
+	PermissionSettingsRead:   "Read the dashboard settings",
+	PermissionSettingsWrite:  "Update the dashboard settings",
+	PermissionUserRead:       "List users, pending users, invitations and login failures",
+	PermissionUserWrite:      "Update, approve, unlock and invite users",
+	PermissionDBDownload:     "Download the database file",
+}
+
+// defaultRolePermissions are granted to the built-in roles when they are created on first boot,
+// admins are granted all permissions regardless.
+var defaultRolePermissions = map[user.Role][]string{
+	user.RoleUser:    {PermissionChatDelete},
+	user.RolePending: {},
+}
+
+// EnsureRoles creates the known permissions and the built-in roles if they do not exist yet.
+func (h *Handler) EnsureRoles() error {
+	for name, description := range Permissions {
+		exist, err := h.client.Permission.Query().Where(permission.Name(name)).Exist(h.ctx)
+		if err != nil {
+			return err
+		}
+		if exist {
+			continue
+		}
+		if err = h.client.Permission.Create().
+			SetName(name).
+			SetDescription(description).
+			Exec(h.ctx); err != nil {
+			return err
+		}
+	}
+
+	for _, r := range []user.Role{user.RoleAdmin, user.RoleUser, user.RolePending} {
+		exist, err := h.client.Role.Query().Where(role.Name(r.String())).Exist(h.ctx)
+		if err != nil {
+			return err
+		}
+		if exist {
+			continue
+		}
+
+		names, ok := defaultRolePermissions[r]
+		if !ok {
+			names = AllPermissions()
+		}
+		permissions, err := h.client.Permission.Query().Where(permission.NameIn(names...)).All(h.ctx)
+		if err != nil {
+			return err
+		}
+		if err = h.client.Role.Create().
+			SetName(r.String()).
+			SetDescription(fmt.Sprintf("Built-in role of %s users", r)).
+			SetBuiltin(true).
+			AddPermissions(permissions...).
+			Exec(h.ctx); err != nil {
+			return err
+		}
+		slog.Debug("created built-in role", "role", r)
+	}
+	return nil
+}
+
+// AllPermissions returns the names of all known permissions, sorted.
+func AllPermissions() []string {
+	names := make([]string, 0, len(Permissions))
+	for name := range Permissions {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// GetUserPermissions returns the sorted permissions of the user's built-in role and custom roles,
+// admins have all permissions.
+func (h *Handler) GetUserPermissions(ctx context.Context, u *entv1.User) ([]string, error) {
+	if u.Role == user.RoleAdmin {
+		return AllPermissions(), nil
+	}
+
+	names, err := h.client.Permission.Query().
+		Where(permission.HasRolesWith(role.Or(
+			role.And(role.Builtin(true), role.Name(u.Role.String())),
+			role.HasUsersWith(user.ID(u.ID)),
+		))).
+		Select(permission.FieldName).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying permissions: %w", err)
+	}
+	slices.Sort(names)
+	return slices.Compact(names), nil
+}
+
+// HasPermissions returns whether the user has all the given permissions.
+func (h *Handler) HasPermissions(ctx context.Context, u *entv1.User, permissions ...string) (bool, error) {
+	granted, err := h.GetUserPermissions(ctx, u)
+	if err != nil {
+		return false, err
+	}
+	for _, p := range permissions {
+		if _, ok := slices.BinarySearch(granted, p); !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (h *Handler) ListRoles(ctx context.Context) (entv1.Roles, error) {
+	roles, err := h.client.Role.Query().
+		WithPermissions().
+		Order(entv1.Asc(role.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying roles: %w", err)
+	}
+	return roles, nil
+}
+
+func (h *Handler) CreateRole(ctx context.Context, name, description string, permissions []string) (*entv1.Role, error) {
+	perms, err := h.getPermissions(ctx, permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := h.client.Role.Create().
+		SetName(name).
+		SetDescription(description).
+		AddPermissions(perms...).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return h.client.Role.Query().Where(role.ID(r.ID)).WithPermissions().Only(ctx)
+}
+
+// UpdateRole replaces the description and permissions of a role, the admin role always has all permissions.
+func (h *Handler) UpdateRole(ctx context.Context, id uuid.UUID, description string, permissions []string) (*entv1.Role, error) {
+	r, err := h.client.Role.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if r.Builtin && r.Name == user.RoleAdmin.String() {
+		return nil, fmt.Errorf("the permissions of the admin role can not be changed")
+	}
+
+	perms, err := h.getPermissions(ctx, permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.client.Role.UpdateOne(r).
+		SetDescription(description).
+		ClearPermissions().
+		AddPermissions(perms...).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+	return h.client.Role.Query().Where(role.ID(id)).WithPermissions().Only(ctx)
+}
+
+func (h *Handler) DeleteRole(ctx context.Context, id uuid.UUID) error {
+	n, err := h.client.Role.Delete().
+		Where(role.ID(id), role.Builtin(false)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("role %s does not exist or is built-in", id)
+	}
+	return nil
+}
+
+// SetUserRoles replaces the custom roles of the user, built-in roles are granted by the user role instead.
+func (h *Handler) SetUserRoles(ctx context.Context, userID uuid.UUID, roleIDs []uuid.UUID) (entv1.Roles, error) {
+	roles, err := h.client.Role.Query().
+		Where(role.IDIn(roleIDs...), role.Builtin(false)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(roles) != len(roleIDs) {
+		return nil, fmt.Errorf("roles must exist and not be built-in")
+	}
+
+	if err = h.client.User.UpdateOneID(userID).
+		ClearRoles().
+		AddRoles(roles...).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+func (h *Handler) getPermissions(ctx context.Context, names []string) (entv1.Permissions, error) {
+	for _, name := range names {
+		if _, ok := Permissions[name]; !ok {
+			return nil, fmt.Errorf("unknown permission %s", name)
+		}
+	}
+	return h.client.Permission.Query().Where(permission.NameIn(names...)).All(ctx)
+}
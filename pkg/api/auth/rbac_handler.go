@@ -0,0 +1,181 @@
+package auth
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	entv1Role "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/role"
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
+)
+
+type RoleRequest struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+type UserRolesRequest struct {
+	Roles []uuid.UUID `json:"roles"`
+}
+
+type PermissionsRequest struct {
+	Permissions []string `json:"permissions"`
+}
+
+// GetSessionUserPermissions returns the permissions of the session user, e.g., for the UI to hide actions.
+func (h *Handler) GetSessionUserPermissions(c *gin.Context) {
+	user, err := utils.GetSessionUser(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	permissions, err := h.GetUserPermissions(c.Request.Context(), user)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"role":        user.Role,
+		"permissions": permissions,
+	})
+}
+
+// UpdateDefaultUserPermissions replaces the permissions of the built-in user role.
+func (h *Handler) UpdateDefaultUserPermissions(c *gin.Context) {
+	var req PermissionsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	r, err := h.client.Role.Query().
+		Where(entv1Role.Builtin(true), entv1Role.Name(entv1User.RoleUser.String())).
+		Only(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	r, err = h.UpdateRole(c.Request.Context(), r.ID, r.Description, req.Permissions)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, r)
+}
+
+func (h *Handler) ListAllRoles(c *gin.Context) {
+	roles, err := h.ListRoles(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, roles)
+}
+
+func (h *Handler) ListAllPermissions(c *gin.Context) {
+	permissions, err := h.client.Permission.Query().All(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, permissions)
+}
+
+func (h *Handler) CreateCustomRole(c *gin.Context) {
+	var req RoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	r, err := h.CreateRole(c.Request.Context(), req.Name, req.Description, req.Permissions)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, r)
+}
+
+func (h *Handler) UpdateRoleByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req RoleRequest
+	if err = c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	r, err := h.UpdateRole(c.Request.Context(), id, req.Description, req.Permissions)
+	if entv1.IsNotFound(err) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, r)
+}
+
+func (h *Handler) DeleteRoleByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	if err = h.DeleteRole(c.Request.Context(), id); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, true)
+}
+
+func (h *Handler) UpdateUserRoles(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req UserRolesRequest
+	if err = c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	roles, err := h.SetUserRoles(c.Request.Context(), id, req.Roles)
+	if entv1.IsNotFound(err) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, roles)
+}
+
+// denyAdminChange rejects granting the admin role, or changing an account that has it, by users that
+// are not admins themselves, so that user.write does not allow to escalate to admin.
+func denyAdminChange(c *gin.Context, role entv1User.Role) bool {
+	if role != entv1User.RoleAdmin {
+		return false
+	}
+	user, err := utils.GetSessionUser(c)
+	if err == nil && user.Role == entv1User.RoleAdmin {
+		return false
+	}
+	c.JSON(http.StatusForbidden, gin.H{"error": "only admins can grant the admin role or change admin accounts"})
+	return true
+}
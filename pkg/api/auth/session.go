@@ -0,0 +1,154 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/session"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
+)
+
+type TokenPair struct {
+	Token        string
+	RefreshToken string
+}
+
+// CreateSession starts a new login session for the user and issues its access and refresh tokens.
+func (h *Handler) CreateSession(c *gin.Context, user *entv1.User) (*TokenPair, error) {
+	duration, err := time.ParseDuration(settings.RefreshTokenExpireTime.Get())
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := utils.GenerateRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	// drop the expired sessions of the user while we are here
+	if _, err = h.client.Session.Delete().
+		Where(session.UserId(user.ID), session.ExpiresAtLT(time.Now())).
+		Exec(c.Request.Context()); err != nil {
+		slog.Error("failed to clean up expired sessions", "error", err)
+	}
+
+	s, err := h.client.Session.Create().
+		SetOwner(user).
+		SetRefreshToken(utils.HashToken(refreshToken)).
+		SetUserAgent(c.Request.UserAgent()).
+		SetIP(c.ClientIP()).
+		SetExpiresAt(time.Now().Add(duration)).
+		Save(c.Request.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := utils.GenerateToken(user.ID, s.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TokenPair{Token: token, RefreshToken: refreshToken}, nil
+}
+
+// RotateSession exchanges a valid refresh token for a new token pair,
+// the presented refresh token can not be used again afterward.
+func (h *Handler) RotateSession(ctx context.Context, refreshToken string) (*entv1.User, *TokenPair, error) {
+	duration, err := time.ParseDuration(settings.RefreshTokenExpireTime.Get())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s, err := h.client.Session.Query().
+		Where(session.RefreshToken(utils.HashToken(refreshToken))).
+		WithOwner().
+		Only(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed querying session: %w", err)
+	}
+
+	if !isSessionActive(s) {
+		return nil, nil, fmt.Errorf("session %s is expired or revoked", s.ID)
+	}
+
+	newRefreshToken, err := utils.GenerateRefreshToken()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// only rotate if the stored hash is still the presented one, so two concurrent
+	// refresh calls with the same token can not both succeed.
+	n, err := h.client.Session.Update().
+		Where(session.ID(s.ID), session.RefreshToken(s.RefreshToken)).
+		SetRefreshToken(utils.HashToken(newRefreshToken)).
+		SetExpiresAt(time.Now().Add(duration)).
+		Save(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if n == 0 {
+		return nil, nil, fmt.Errorf("session %s has been rotated", s.ID)
+	}
+
+	token, err := utils.GenerateToken(s.UserId, s.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.Edges.Owner, &TokenPair{Token: token, RefreshToken: newRefreshToken}, nil
+}
+
+// GetActiveSession returns the session of the given user, or an error if it has been revoked or expired.
+func (h *Handler) GetActiveSession(ctx context.Context, id, userID uuid.UUID) (*entv1.Session, error) {
+	s, err := h.client.Session.Query().
+		Where(session.ID(id), session.UserId(userID)).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying session: %w", err)
+	}
+
+	if !isSessionActive(s) {
+		return nil, fmt.Errorf("session %s is expired or revoked", s.ID)
+	}
+	return s, nil
+}
+
+func (h *Handler) RevokeSession(ctx context.Context, id uuid.UUID) error {
+	return h.client.Session.Update().
+		Where(session.ID(id), session.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		Exec(ctx)
+}
+
+// RevokeUserSessions ends all active sessions of the user, e.g., after a password or role change.
+func (h *Handler) RevokeUserSessions(ctx context.Context, userID uuid.UUID) error {
+	n, err := h.client.Session.Update().
+		Where(session.UserId(userID), session.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	slog.Debug("revoked user sessions", "user", userID, "count", n)
+	return nil
+}
+
+// RevokeOtherUserSessions ends all active sessions of the user except the given one,
+// e.g., after the user changed the password.
+func (h *Handler) RevokeOtherUserSessions(ctx context.Context, userID, sessionID uuid.UUID) error {
+	return h.client.Session.Update().
+		Where(session.UserId(userID), session.IDNEQ(sessionID), session.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		Exec(ctx)
+}
+
+func isSessionActive(s *entv1.Session) bool {
+	return s.RevokedAt == nil && s.ExpiresAt.After(time.Now())
+}
@@ -0,0 +1,118 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+)
+
+func createTestSession(t *testing.T, h *Handler, user *entv1.User) *TokenPair {
+	t.Helper()
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/auths/signin", nil)
+	tokens, err := h.CreateSession(c, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tokens
+}
+
+// serveAuthenticated sends a request with the access token through the auth middleware to the handler.
+func serveAuthenticated(t *testing.T, h *Handler, token string, handler gin.HandlerFunc) *httptest.ResponseRecorder {
+	t.Helper()
+	r := gin.New()
+	r.POST("/api/v1/test", h.AuthMiddleware, handler)
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/test", nil)
+	req.Header.Set("Authorization", tokenType+" "+token)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func okHandler(c *gin.Context) {
+	c.Status(http.StatusOK)
+}
+
+func refresh(t *testing.T, h *Handler, refreshToken string) (int, *TokenPair) {
+	t.Helper()
+	w := serve(t, h.RefreshToken, http.MethodPost, RefreshRequest{RefreshToken: refreshToken}, nil)
+	var res struct {
+		Token        string `json:"token"`
+		RefreshToken string `json:"refreshToken"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+		t.Fatal(err)
+	}
+	return w.Code, &TokenPair{Token: res.Token, RefreshToken: res.RefreshToken}
+}
+
+func TestSignOutRevokesSession(t *testing.T) {
+	h := newTestHandler(t)
+	user := createTestUser(t, h, "alice", entv1User.RoleUser, "Passw0rd")
+	laptop := createTestSession(t, h, user)
+	phone := createTestSession(t, h, user)
+
+	if w := serveAuthenticated(t, h, laptop.Token, h.SignOut); w.Code != http.StatusOK {
+		t.Fatalf("sign out: status = %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+	}
+
+	if w := serveAuthenticated(t, h, laptop.Token, okHandler); w.Code != http.StatusUnauthorized {
+		t.Errorf("token of the signed out session: status = %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+	if status, _ := refresh(t, h, laptop.RefreshToken); status != http.StatusUnauthorized {
+		t.Errorf("refresh of the signed out session: status = %d, want %d", status, http.StatusUnauthorized)
+	}
+	if w := serveAuthenticated(t, h, phone.Token, okHandler); w.Code != http.StatusOK {
+		t.Errorf("token of the other session: status = %d, want %d", w.Code, http.StatusOK)
+	}
+}
+
+func TestRefreshTokenIsSingleUse(t *testing.T) {
+	h := newTestHandler(t)
+	user := createTestUser(t, h, "bob", entv1User.RoleUser, "Passw0rd")
+	tokens := createTestSession(t, h, user)
+
+	status, rotated := refresh(t, h, tokens.RefreshToken)
+	if status != http.StatusOK {
+		t.Fatalf("refresh: status = %d, want %d", status, http.StatusOK)
+	}
+	if status, _ = refresh(t, h, tokens.RefreshToken); status != http.StatusUnauthorized {
+		t.Errorf("reused refresh token: status = %d, want %d", status, http.StatusUnauthorized)
+	}
+	if w := serveAuthenticated(t, h, rotated.Token, okHandler); w.Code != http.StatusOK {
+		t.Errorf("rotated token: status = %d, want %d", w.Code, http.StatusOK)
+	}
+	if status, _ = refresh(t, h, rotated.RefreshToken); status != http.StatusOK {
+		t.Errorf("rotated refresh token: status = %d, want %d", status, http.StatusOK)
+	}
+}
+
+func TestRevokeUserSessions(t *testing.T) {
+	h := newTestHandler(t)
+	user := createTestUser(t, h, "carol", entv1User.RoleUser, "Passw0rd")
+	other := createTestUser(t, h, "dave", entv1User.RoleUser, "Passw0rd")
+	sessions := []*TokenPair{createTestSession(t, h, user), createTestSession(t, h, user)}
+	otherSession := createTestSession(t, h, other)
+
+	if err := h.RevokeUserSessions(h.ctx, user.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, s := range sessions {
+		if w := serveAuthenticated(t, h, s.Token, okHandler); w.Code != http.StatusUnauthorized {
+			t.Errorf("session %d: status = %d, want %d", i, w.Code, http.StatusUnauthorized)
+		}
+		if status, _ := refresh(t, h, s.RefreshToken); status != http.StatusUnauthorized {
+			t.Errorf("session %d refresh: status = %d, want %d", i, status, http.StatusUnauthorized)
+		}
+	}
+	if w := serveAuthenticated(t, h, otherSession.Token, okHandler); w.Code != http.StatusOK {
+		t.Errorf("session of another user: status = %d, want %d", w.Code, http.StatusOK)
+	}
+}
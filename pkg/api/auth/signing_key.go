@@ -0,0 +1,145 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/signingkey"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
+)
+
+// the parsed keys are cached since every request verifies a token
+var (
+	signingKeyLock   sync.Mutex
+	signingKeys      = map[string]*cachedSigningKey{}
+	activeSigningKey *utils.SigningKey
+)
+
+type cachedSigningKey struct {
+	key       *utils.SigningKey
+	expiresAt *time.Time
+}
+
+// ActiveSigningKey returns the key new tokens are signed with, the first key is generated on first boot.
+func (h *Handler) ActiveSigningKey() (*utils.SigningKey, error) {
+	signingKeyLock.Lock()
+	defer signingKeyLock.Unlock()
+
+	if activeSigningKey != nil {
+		return activeSigningKey, nil
+	}
+
+	k, err := h.client.SigningKey.Query().
+		Where(signingkey.ExpiresAtIsNil()).
+		Order(entv1.Desc(signingkey.FieldCreatedAt)).
+		First(h.ctx)
+	if entv1.IsNotFound(err) {
+		k, err = h.createFirstSigningKey(h.ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active signing key: %w", err)
+	}
+
+	key, err := utils.ParseSigningKey(k.ID, k.Algorithm.String(), k.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	signingKeys[k.ID] = &cachedSigningKey{key: key}
+	activeSigningKey = key
+	return key, nil
+}
+
+// GetSigningKey returns the key with the given id, rotated keys are only returned within their grace period.
+func (h *Handler) GetSigningKey(kid string) (*utils.SigningKey, error) {
+	signingKeyLock.Lock()
+	defer signingKeyLock.Unlock()
+
+	cached, ok := signingKeys[kid]
+	if !ok {
+		k, err := h.client.SigningKey.Get(h.ctx, kid)
+		if err != nil {
+			return nil, fmt.Errorf("unknown signing key %s", kid)
+		}
+		key, err := utils.ParseSigningKey(k.ID, k.Algorithm.String(), k.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		cached = &cachedSigningKey{key: key, expiresAt: k.ExpiresAt}
+		signingKeys[kid] = cached
+	}
+
+	if cached.expiresAt != nil && cached.expiresAt.Before(time.Now()) {
+		return nil, fmt.Errorf("signing key %s is expired", kid)
+	}
+	return cached.key, nil
+}
+
+// GetVerificationKeys returns all keys that still verify tokens, newest first.
+func (h *Handler) GetVerificationKeys(ctx context.Context) (entv1.SigningKeys, error) {
+	return h.client.SigningKey.Query().
+		Where(signingkey.Or(signingkey.ExpiresAtIsNil(), signingkey.ExpiresAtGT(time.Now()))).
+		Order(entv1.Desc(signingkey.FieldCreatedAt)).
+		All(ctx)
+}
+
+// RotateSigningKey signs new tokens with a new key of the configured algorithm, the previous keys keep
+// verifying the issued tokens during the grace period.
+func (h *Handler) RotateSigningKey(ctx context.Context) (*entv1.SigningKey, error) {
+	signingKeyLock.Lock()
+	defer signingKeyLock.Unlock()
+
+	now := time.Now()
+	if _, err := h.client.SigningKey.Delete().
+		Where(signingkey.ExpiresAtLT(now)).
+		Exec(ctx); err != nil {
+		slog.Error("failed to clean up expired signing keys", "error", err)
+	}
+
+	k, err := h.createSigningKey(ctx, settings.JWTSigningAlgorithm.Get(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.client.SigningKey.Update().
+		Where(signingkey.ExpiresAtIsNil(), signingkey.IDNEQ(k.ID)).
+		SetExpiresAt(now.Add(settings.JWTKeyGracePeriod.GetDuration())).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	// reload the keys with their new expiry on the next use
+	signingKeys = map[string]*cachedSigningKey{}
+	activeSigningKey = nil
+	slog.Info("rotated jwt signing key", "kid", k.ID, "algorithm", k.Algorithm)
+	return k, nil
+}
+
+// createFirstSigningKey generates the first key, a configured jwt secret is kept as HS256 key.
+func (h *Handler) createFirstSigningKey(ctx context.Context) (*entv1.SigningKey, error) {
+	if secret := settings.JWTSecret.Get(); secret != "" {
+		return h.createSigningKey(ctx, utils.SigningAlgorithmHS256, secret)
+	}
+	return h.createSigningKey(ctx, settings.JWTSigningAlgorithm.Get(), "")
+}
+
+func (h *Handler) createSigningKey(ctx context.Context, algorithm, secret string) (*entv1.SigningKey, error) {
+	kid, privateKey, publicKey, err := utils.GenerateSigningKey(algorithm)
+	if err != nil {
+		return nil, err
+	}
+	if secret != "" {
+		privateKey = secret
+	}
+
+	return h.client.SigningKey.Create().
+		SetID(kid).
+		SetAlgorithm(signingkey.Algorithm(algorithm)).
+		SetPrivateKey(privateKey).
+		SetPublicKey(publicKey).
+		Save(ctx)
+}
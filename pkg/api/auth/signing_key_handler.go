@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetJWKS publishes the public keys that verify dashboard tokens, so other LLMOS services can verify them.
+func (h *Handler) GetJWKS(c *gin.Context) {
+	keys, err := h.GetVerificationKeys(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	jwks := make([]map[string]string, 0, len(keys))
+	for _, k := range keys {
+		key, err := h.GetSigningKey(k.ID)
+		if err != nil {
+			continue
+		}
+		// symmetric keys are never published
+		if jwk := key.JWK(); jwk != nil {
+			jwks = append(jwks, jwk)
+		}
+	}
+	c.JSON(http.StatusOK, gin.H{"keys": jwks})
+}
+
+func (h *Handler) ListSigningKeys(c *gin.Context) {
+	keys, err := h.GetVerificationKeys(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, keys)
+}
+
+func (h *Handler) RotateSigningKeys(c *gin.Context) {
+	key, err := h.RotateSigningKey(c.Request.Context())
+	if err != nil {
+		slog.Error("failed to rotate signing key", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, key)
+}
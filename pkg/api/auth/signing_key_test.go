@@ -0,0 +1,86 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
+)
+
+// resetSigningKeys drops the cached keys, as on a restart, so the keys of the test database are loaded.
+func resetSigningKeys(t *testing.T) {
+	t.Helper()
+	reset := func() {
+		signingKeyLock.Lock()
+		defer signingKeyLock.Unlock()
+		signingKeys = map[string]*cachedSigningKey{}
+		activeSigningKey = nil
+	}
+	reset()
+	t.Cleanup(reset)
+}
+
+func tokenKeyID(t *testing.T, token string) string {
+	t.Helper()
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, &utils.Claims{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	kid, _ := parsed.Header["kid"].(string)
+	return kid
+}
+
+func TestRotatedSigningKeyVerifiesUntilExpired(t *testing.T) {
+	h := newTestHandler(t)
+	resetSigningKeys(t)
+	setSetting(t, settings.JWTSigningAlgorithm, utils.SigningAlgorithmHS256)
+	setSetting(t, settings.JWTKeyGracePeriod, "1h")
+
+	token, err := utils.GenerateToken(uuid.New(), uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	retired := tokenKeyID(t, token)
+
+	setSetting(t, settings.JWTSigningAlgorithm, utils.SigningAlgorithmRS256)
+	if _, err = h.RotateSigningKey(h.ctx); err != nil {
+		t.Fatal(err)
+	}
+	next, err := utils.GenerateToken(uuid.New(), uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kid := tokenKeyID(t, next); kid == retired {
+		t.Fatal("new token signed with the retired key")
+	}
+
+	// the retired key verifies its tokens during the grace period
+	if _, err = utils.VerifyToken(token); err != nil {
+		t.Errorf("token of the retired key within the grace period: %v", err)
+	}
+	if _, err = utils.VerifyToken(next); err != nil {
+		t.Errorf("token of the active key: %v", err)
+	}
+
+	// once the grace period is over the retired key is rejected, but not the active one
+	h.client.SigningKey.UpdateOneID(retired).SetExpiresAt(time.Now().Add(-time.Second)).ExecX(h.ctx)
+	resetSigningKeys(t)
+	if _, err = utils.VerifyToken(token); err == nil {
+		t.Error("token of the expired key verified")
+	}
+	if _, err = utils.VerifyToken(next); err != nil {
+		t.Errorf("token of the active key: %v", err)
+	}
+
+	// the expired key is removed on the next rotation
+	if _, err = h.RotateSigningKey(h.ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = h.client.SigningKey.Get(h.ctx, retired); err == nil {
+		t.Error("expired key kept after the rotation")
+	}
+}
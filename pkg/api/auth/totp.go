@@ -0,0 +1,242 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+
+	"github.com/llmos-ai/llmos-dashboard/pkg/constant"
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/predicate"
+	entuser "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
+)
+
+const (
+	recoveryCodeCount = 10
+	recoveryCodeBytes = 5
+
+	totpPeriod = 30
+	// mfaChallengeAttempts is the number of codes that can be tried with one MFA challenge
+	mfaChallengeAttempts = 5
+)
+
+// errMFAChallengeInvalid is returned when the MFA challenge is used up or replaced by a newer sign-in.
+var errMFAChallengeInvalid = errors.New("mfa challenge is invalid")
+
+// TwoFactorRequired returns whether the user has to enroll TOTP before using the API.
+func TwoFactorRequired(user *entv1.User) bool {
+	return user.Role == entuser.RoleAdmin && settings.AdminTwoFactorRequired.Get() == "true"
+}
+
+// SetupTOTP generates a new TOTP secret for the user, it is not effective until EnableTOTP
+// confirms that the user's authenticator app produces valid codes.
+func (h *Handler) SetupTOTP(ctx context.Context, user *entv1.User) (*otp.Key, error) {
+	if user.TotpEnabled {
+		return nil, fmt.Errorf("two-factor authentication is already enabled")
+	}
+
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      constant.AppName,
+		AccountName: user.Email,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.client.User.UpdateOneID(user.ID).SetTotpSecret(key.Secret()).Exec(ctx); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// EnableTOTP turns on two-factor authentication and returns the one-time recovery codes.
+func (h *Handler) EnableTOTP(ctx context.Context, user *entv1.User, code string) ([]string, error) {
+	if user.TotpEnabled {
+		return nil, fmt.Errorf("two-factor authentication is already enabled")
+	}
+	if user.TotpSecret == "" {
+		return nil, fmt.Errorf("two-factor authentication is not set up")
+	}
+	step, ok := totpStep(code, user.TotpSecret, time.Now())
+	if !ok {
+		return nil, ErrInvalidCredentials
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.client.User.UpdateOneID(user.ID).
+		SetTotpEnabled(true).
+		SetTotpLastStep(step).
+		SetRecoveryCodes(hashes).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+func (h *Handler) DisableTOTP(ctx context.Context, user *entv1.User) error {
+	return h.client.User.UpdateOneID(user.ID).
+		SetTotpEnabled(false).
+		ClearTotpSecret().
+		ClearTotpLastStep().
+		ClearRecoveryCodes().
+		ClearMfaChallenge().
+		Exec(ctx)
+}
+
+// VerifySecondFactor checks a TOTP code or, failing that, consumes a matching recovery code.
+func (h *Handler) VerifySecondFactor(ctx context.Context, user *entv1.User, code string) (bool, error) {
+	if !user.TotpEnabled {
+		return false, nil
+	}
+
+	if step, ok := totpStep(code, user.TotpSecret, time.Now()); ok {
+		// a code is accepted once, and not after a code of a later step
+		n, err := h.client.User.Update().
+			Where(entuser.ID(user.ID), entuser.Or(entuser.TotpLastStepIsNil(), entuser.TotpLastStepLT(step))).
+			SetTotpLastStep(step).
+			Save(ctx)
+		if err != nil {
+			return false, err
+		}
+		return n == 1, nil
+	}
+
+	return h.consumeRecoveryCode(ctx, user.ID, utils.HashToken(normalizeRecoveryCode(code)))
+}
+
+// consumeRecoveryCode removes the recovery code of the user, it returns false if the code is unknown or was
+// consumed concurrently.
+func (h *Handler) consumeRecoveryCode(ctx context.Context, id uuid.UUID, hash string) (bool, error) {
+	consumed := false
+	err := h.inTx(ctx, func(tx *entv1.Tx) error {
+		u, err := tx.User.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		idx := slices.Index(u.RecoveryCodes, hash)
+		if idx < 0 {
+			return nil
+		}
+
+		// the codes are stored the way ent encodes JSON fields, the update only applies while they are unchanged
+		prev, err := json.Marshal(u.RecoveryCodes)
+		if err != nil {
+			return err
+		}
+		n, err := tx.User.Update().
+			Where(entuser.ID(id), predicate.User(sql.FieldEQ(entuser.FieldRecoveryCodes, prev))).
+			SetRecoveryCodes(slices.Delete(slices.Clone(u.RecoveryCodes), idx, idx+1)).
+			Save(ctx)
+		consumed = n == 1
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+	return consumed, nil
+}
+
+// newMFAChallenge starts the second step of the user's sign-in and returns its token,
+// it replaces any pending challenge of the user.
+func (h *Handler) newMFAChallenge(ctx context.Context, user *entv1.User) (string, error) {
+	nonce, err := utils.GenerateMFANonce()
+	if err != nil {
+		return "", err
+	}
+	if err = h.client.User.UpdateOneID(user.ID).
+		SetMfaChallenge(utils.HashToken(nonce)).
+		SetMfaChallengeAttempts(0).
+		Exec(ctx); err != nil {
+		return "", err
+	}
+	return utils.GenerateMFAToken(user.ID, nonce)
+}
+
+// attemptMFAChallenge counts a code tried with the challenge, it fails once the attempts are used up.
+func (h *Handler) attemptMFAChallenge(ctx context.Context, id uuid.UUID, nonce string) error {
+	n, err := h.client.User.Update().
+		Where(
+			entuser.ID(id),
+			entuser.MfaChallenge(utils.HashToken(nonce)),
+			entuser.MfaChallengeAttemptsLT(mfaChallengeAttempts),
+		).
+		AddMfaChallengeAttempts(1).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errMFAChallengeInvalid
+	}
+	return nil
+}
+
+// completeMFAChallenge ends the challenge after the second factor is accepted, so its token can't be used again.
+func (h *Handler) completeMFAChallenge(ctx context.Context, id uuid.UUID, nonce string) error {
+	n, err := h.client.User.Update().
+		Where(entuser.ID(id), entuser.MfaChallenge(utils.HashToken(nonce))).
+		ClearMfaChallenge().
+		SetMfaChallengeAttempts(0).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errMFAChallengeInvalid
+	}
+	return nil
+}
+
+// totpStep returns the time step of a valid TOTP code, the codes of the adjacent steps are accepted for clock skew.
+func totpStep(code, secret string, now time.Time) (int64, bool) {
+	code = strings.TrimSpace(code)
+	current := now.Unix() / totpPeriod
+	for _, step := range []int64{current - 1, current, current + 1} {
+		ok, err := totp.ValidateCustom(code, secret, time.Unix(step*totpPeriod, 0), totp.ValidateOpts{
+			Period:    totpPeriod,
+			Digits:    otp.DigitsSix,
+			Algorithm: otp.AlgorithmSHA1,
+		})
+		if err == nil && ok {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// generateRecoveryCodes returns the raw codes shown to the user and their hashes to store.
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	for i := 0; i < recoveryCodeCount; i++ {
+		b := make([]byte, recoveryCodeBytes)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		code := hex.EncodeToString(b)
+		codes = append(codes, code[:5]+"-"+code[5:])
+		hashes = append(hashes, utils.HashToken(code))
+	}
+	return codes, hashes, nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+}
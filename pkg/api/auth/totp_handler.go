@@ -0,0 +1,168 @@
+package auth
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/llmos-ai/llmos-dashboard/pkg/constant"
+	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
+)
+
+type TwoFactorCodeRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+type SignInMFARequest struct {
+	MFAToken string `json:"mfaToken" binding:"required"`
+	Code     string `json:"code" binding:"required"`
+}
+
+// SignInMFA completes a sign-in that was answered with an MFA challenge.
+func (h *Handler) SignInMFA(c *gin.Context) {
+	var req SignInMFARequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	claims, err := utils.VerifyMFAToken(req.MFAToken)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": constant.MessageErrorMFAExpired})
+		return
+	}
+
+	user, err := h.GetUserByID(c.Request.Context(), claims.UUID)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": constant.MessageErrorMFAExpired})
+		return
+	}
+
+	if h.loginBlocked(c, user.Email) {
+		return
+	}
+
+	// the challenge allows a few attempts and ends with the first accepted code
+	if err = h.attemptMFAChallenge(c.Request.Context(), user.ID, claims.ID); err != nil {
+		h.mfaChallengeFailed(c, err)
+		return
+	}
+
+	ok, err := h.VerifySecondFactor(c.Request.Context(), user, req.Code)
+	if err != nil {
+		slog.Error("failed to verify second factor", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if !ok {
+		h.loginFailed(c, user.Email, loginFailureInvalidMFACode)
+		c.JSON(http.StatusUnauthorized, gin.H{"error": constant.MessageErrorMFACode})
+		return
+	}
+
+	if err = h.completeMFAChallenge(c.Request.Context(), user.ID, claims.ID); err != nil {
+		h.mfaChallengeFailed(c, err)
+		return
+	}
+
+	h.loginSucceeded(c.Request.Context(), user.Email, user)
+	h.issueSession(c, user)
+}
+
+// mfaChallengeFailed answers a sign-in whose MFA challenge is used up as expired.
+func (h *Handler) mfaChallengeFailed(c *gin.Context, err error) {
+	if errors.Is(err, errMFAChallengeInvalid) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": constant.MessageErrorMFAExpired})
+		return
+	}
+	slog.Error("failed to update mfa challenge", "error", err)
+	c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+}
+
+func (h *Handler) SetupTwoFactor(c *gin.Context) {
+	user, err := utils.GetSessionUser(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	key, err := h.SetupTOTP(c.Request.Context(), user)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"secret": key.Secret(),
+		// otpauth:// provisioning uri to be rendered as QR code
+		"uri": key.URL(),
+	})
+}
+
+func (h *Handler) EnableTwoFactor(c *gin.Context) {
+	user, err := utils.GetSessionUser(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	var req TwoFactorCodeRequest
+	if err = c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	codes, err := h.EnableTOTP(c.Request.Context(), user, req.Code)
+	if err != nil {
+		if errors.Is(err, ErrInvalidCredentials) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": constant.MessageErrorMFACode})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"recoveryCodes": codes})
+}
+
+func (h *Handler) DisableTwoFactor(c *gin.Context) {
+	user, err := utils.GetSessionUser(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	if TwoFactorRequired(user) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "two-factor authentication is required for admin accounts"})
+		return
+	}
+
+	var req TwoFactorCodeRequest
+	if err = c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if h.loginBlocked(c, user.Email) {
+		return
+	}
+
+	ok, err := h.VerifySecondFactor(c.Request.Context(), user, req.Code)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": constant.MessageErrorMFACode})
+		return
+	}
+
+	if err = h.DisableTOTP(c.Request.Context(), user); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": true})
+}
@@ -0,0 +1,152 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pquerna/otp/totp"
+
+	"github.com/llmos-ai/llmos-dashboard/pkg/constant"
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+)
+
+const testTOTPSecret = "JBSWY3DPEHPK3PXP"
+
+// enableTestTOTP turns on two-factor authentication of the user and returns its recovery codes.
+func enableTestTOTP(t *testing.T, h *Handler, user *entv1.User) ([]string, *entv1.User) {
+	t.Helper()
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	user = h.client.User.UpdateOne(user).
+		SetTotpSecret(testTOTPSecret).
+		SetTotpEnabled(true).
+		SetRecoveryCodes(hashes).
+		SaveX(h.ctx)
+	return codes, user
+}
+
+func totpCode(t *testing.T, at time.Time) string {
+	t.Helper()
+	code, err := totp.GenerateCode(testTOTPSecret, at)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return code
+}
+
+func TestVerifySecondFactorRejectsReusedCode(t *testing.T) {
+	h := newTestHandler(t)
+	_, user := enableTestTOTP(t, h, createTestUser(t, h, "alice", entv1User.RoleUser, "Passw0rd"))
+
+	now := time.Now()
+	tests := []struct {
+		name string
+		code string
+		want bool
+	}{
+		{"valid code", totpCode(t, now), true},
+		{"same code again", totpCode(t, now), false},
+		{"code of the previous step", totpCode(t, now.Add(-totpPeriod*time.Second)), false},
+		{"code of the next step", totpCode(t, now.Add(totpPeriod*time.Second)), true},
+		{"invalid code", "000000", false},
+	}
+	for _, tt := range tests {
+		ok, err := h.VerifySecondFactor(h.ctx, user, tt.code)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if ok != tt.want {
+			t.Errorf("%s: accepted = %v, want %v", tt.name, ok, tt.want)
+		}
+	}
+}
+
+func TestRecoveryCodeIsSingleUse(t *testing.T) {
+	h := newTestHandler(t)
+	codes, user := enableTestTOTP(t, h, createTestUser(t, h, "bob", entv1User.RoleUser, "Passw0rd"))
+
+	for i, want := range []bool{true, false} {
+		ok, err := h.VerifySecondFactor(h.ctx, user, codes[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok != want {
+			t.Fatalf("attempt %d: accepted = %v, want %v", i+1, ok, want)
+		}
+	}
+
+	// a stale copy of the user doesn't bring the code back
+	ok, err := h.VerifySecondFactor(h.ctx, user, codes[1])
+	if err != nil || !ok {
+		t.Fatalf("second recovery code: accepted = %v, %v", ok, err)
+	}
+	remaining := h.client.User.GetX(h.ctx, user.ID).RecoveryCodes
+	if len(remaining) != recoveryCodeCount-2 {
+		t.Errorf("%d recovery codes left, want %d", len(remaining), recoveryCodeCount-2)
+	}
+}
+
+func signInMFA(t *testing.T, h *Handler, token, code string) (int, string) {
+	t.Helper()
+	w := serve(t, h.SignInMFA, http.MethodPost, SignInMFARequest{MFAToken: token, Code: code}, nil)
+	var resp struct {
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	return w.Code, resp.Error
+}
+
+func TestMFAChallengeIsSingleUse(t *testing.T) {
+	h := newTestHandler(t)
+	codes, user := enableTestTOTP(t, h, createTestUser(t, h, "carol", entv1User.RoleUser, "Passw0rd"))
+
+	replaced, err := h.newMFAChallenge(h.ctx, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := h.newMFAChallenge(h.ctx, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if status, msg := signInMFA(t, h, replaced, codes[0]); status != http.StatusUnauthorized || msg != constant.MessageErrorMFAExpired {
+		t.Fatalf("replaced challenge: status = %d %q", status, msg)
+	}
+	if status, _ := signInMFA(t, h, token, codes[0]); status != http.StatusOK {
+		t.Fatalf("sign-in: status = %d, want %d", status, http.StatusOK)
+	}
+	if status, msg := signInMFA(t, h, token, codes[1]); status != http.StatusUnauthorized || msg != constant.MessageErrorMFAExpired {
+		t.Fatalf("reused challenge: status = %d %q", status, msg)
+	}
+}
+
+func TestMFAChallengeAttemptLimit(t *testing.T) {
+	h := newTestHandler(t)
+	setSetting(t, settings.LoginBackoffBase, "0s")
+	setSetting(t, settings.LoginMaxFailures, "0")
+	codes, user := enableTestTOTP(t, h, createTestUser(t, h, "dave", entv1User.RoleUser, "Passw0rd"))
+
+	token, err := h.newMFAChallenge(h.ctx, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < mfaChallengeAttempts; i++ {
+		if status, msg := signInMFA(t, h, token, "000000"); status != http.StatusUnauthorized || msg != constant.MessageErrorMFACode {
+			t.Fatalf("attempt %d: status = %d %q", i+1, status, msg)
+		}
+	}
+	if status, msg := signInMFA(t, h, token, codes[0]); status != http.StatusUnauthorized || msg != constant.MessageErrorMFAExpired {
+		t.Fatalf("after %d attempts: status = %d %q", mfaChallengeAttempts, status, msg)
+	}
+	if n := len(h.client.User.GetX(h.ctx, user.ID).RecoveryCodes); n != recoveryCodeCount {
+		t.Errorf("recovery code consumed by a used up challenge, %d left", n)
+	}
+}
@@ -1,14 +1,19 @@
 package auth
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
+	"strings"
 
 	"github.com/google/uuid"
 	_ "github.com/mattn/go-sqlite3"
 
 	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
 	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
+	"github.com/llmos-ai/llmos-dashboard/pkg/webhook"
 )
 
 type User struct {
@@ -21,8 +26,8 @@ type User struct {
 	CreatedAt    string `json:"createdAt"`
 }
 
-func (h *Handler) CreateUser(user *entv1.User) (*entv1.User, error) {
-	slog.Debug("Inserting user record ...", user.Email)
+func (h *Handler) CreateUser(ctx context.Context, user *entv1.User) (*entv1.User, error) {
+	slog.Debug("Inserting user record ...", "email", user.Email)
 	createdUser, err := h.client.User.
 		Create().
 		SetName(user.Name).
@@ -30,82 +35,211 @@ func (h *Handler) CreateUser(user *entv1.User) (*entv1.User, error) {
 		SetPassword(user.Password).
 		SetRole(user.Role).
 		SetProfileImageUrl(user.ProfileImageUrl).
-		Save(h.ctx)
+		Save(ctx)
 	if err != nil {
 		return nil, err
 	}
-	slog.Debug("User created successfully", createdUser)
+	slog.Debug("User created successfully", "user", createdUser.ID)
+
+	notifyPendingSignup(createdUser)
 	return createdUser, nil
 }
 
-func (h *Handler) GetUserByEmail(email string) (*entv1.User, error) {
+// notifyPendingSignup lets admins know through the webhook that someone is waiting for approval.
+func notifyPendingSignup(u *entv1.User) {
+	if u.Role != user.RolePending {
+		return
+	}
+	webhook.Notify(webhook.ActionSignup,
+		fmt.Sprintf("New user signup: %s (%s) is waiting for approval", u.Name, u.Email),
+		map[string]interface{}{
+			"id":        u.ID,
+			"name":      u.Name,
+			"email":     u.Email,
+			"role":      u.Role,
+			"createdAt": u.CreatedAt,
+		})
+}
+
+func (h *Handler) GetUserByEmail(ctx context.Context, email string) (*entv1.User, error) {
 	user, err := h.client.User.
 		Query().
 		Where(user.Email(email)).
 		// `Only` fails if no user found,
 		// or more than 1 user returned.
-		Only(h.ctx)
+		Only(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed querying user: %w", err)
 	}
-	slog.Debug("user returned: ", user)
+	slog.Debug("user returned", "user", user.ID)
 	return user, nil
 }
 
-func (h *Handler) GetUserByID(id uuid.UUID) (*entv1.User, error) {
+func (h *Handler) GetUserByID(ctx context.Context, id uuid.UUID) (*entv1.User, error) {
 	slog.Debug("Fetching user record by username ...")
-	user, err := h.client.User.Get(h.ctx, id)
+	user, err := h.client.User.Get(ctx, id)
 	if err != nil {
 		return nil, err
 	}
-	slog.Debug("user returned: ", user)
+	slog.Debug("user returned", "user", user.ID)
 	return user, nil
 }
 
-func (h *Handler) GetAllUser() (entv1.Users, error) {
-	users, err := h.client.User.Query().All(h.ctx)
+// UserListOptions filter, sort and paginate the user listing.
+type UserListOptions struct {
+	// Page starts at 1
+	Page  int
+	Limit int
+	// Query matches the name, and the email if SearchEmail is set, case-insensitively
+	Query       string
+	SearchEmail bool
+	Role        user.Role
+	// Sort is a sortable field, prefixed with - for descending order
+	Sort string
+}
+
+// userSortFields are the fields the user listing can be sorted by.
+var userSortFields = map[string]string{
+	"name":      user.FieldName,
+	"email":     user.FieldEmail,
+	"role":      user.FieldRole,
+	"createdAt": user.FieldCreatedAt,
+}
+
+// ListUsers returns a page of the users matching the options and the total count of matching users.
+func (h *Handler) ListUsers(ctx context.Context, opts UserListOptions) (entv1.Users, int, error) {
+	query := h.client.User.Query()
+	if opts.Query != "" {
+		if opts.SearchEmail {
+			query.Where(user.Or(user.NameContainsFold(opts.Query), user.EmailContainsFold(opts.Query)))
+		} else {
+			query.Where(user.NameContainsFold(opts.Query))
+		}
+	}
+	if opts.Role != "" {
+		query.Where(user.RoleEQ(opts.Role))
+	}
+
+	total, err := query.Clone().Count(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed querying users: %w", err)
+		return nil, 0, fmt.Errorf("failed counting users: %w", err)
 	}
-	slog.Debug("users returned: ", users)
-	return users, nil
+
+	field, desc := strings.CutPrefix(opts.Sort, "-")
+	column, ok := userSortFields[field]
+	if !ok {
+		return nil, 0, fmt.Errorf("invalid sort field %s", field)
+	}
+	order := entv1.Asc(column, user.FieldID)
+	if desc {
+		order = entv1.Desc(column, user.FieldID)
+	}
+
+	users, err := query.
+		Order(order).
+		Offset((opts.Page - 1) * opts.Limit).
+		Limit(opts.Limit).
+		All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed querying users: %w", err)
+	}
+	return users, total, nil
 }
 
-func (h *Handler) DeleteUser(email string) error {
-	slog.Debug("Deleting user record ...", email)
+func (h *Handler) DeleteUser(ctx context.Context, email string) error {
+	slog.Debug("Deleting user record ...", "email", email)
 	id, err := h.client.User.Delete().
 		Where(user.Email(email)).
-		Exec(h.ctx)
+		Exec(ctx)
 	if err != nil {
 		return err
 	}
-	slog.Debug("User deleted successfully", id)
+	slog.Debug("User deleted successfully", "user", id)
 	return nil
 }
 
-func (h *Handler) UpdateUserRoleByID(id uuid.UUID, role user.Role) (*entv1.User, error) {
+func (h *Handler) UpdateUserRoleByID(ctx context.Context, id uuid.UUID, role user.Role) (*entv1.User, error) {
 	user, err := h.client.User.UpdateOneID(id).
 		SetRole(role).
-		Save(h.ctx)
+		Save(ctx)
 	if err != nil {
 		return nil, err
 	}
 	return user, nil
 }
 
-func (h *Handler) UpdateUserByID(id uuid.UUID, u UpdateUser) (*entv1.User, error) {
+func (h *Handler) UpdateUserByID(ctx context.Context, id uuid.UUID, u UpdateUser) (*entv1.User, error) {
 	user, err := h.client.User.UpdateOneID(id).
 		SetEmail(u.Email).
 		SetName(u.Name).
 		SetNillablePassword(u.Password).
 		SetProfileImageUrl(u.ProfileImageUrl).
-		Save(h.ctx)
+		Save(ctx)
 	if err != nil {
 		return nil, err
 	}
 	return user, nil
 }
 
+// NewUserRole returns the role of a newly registered user, the first user is always the admin user.
+func (h *Handler) NewUserRole(ctx context.Context) (user.Role, error) {
+	count, err := h.client.User.Query().Count(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed counting users: %w", err)
+	}
+	if count == 0 {
+		return user.RoleAdmin, nil
+	}
+	return GetUserRole(settings.DefaultUserRole.Get()), nil
+}
+
+// GetOrCreateExternalUser links an identity authenticated by an external provider to the local user
+// with the same email, or provisions a new user with the default role on first login.
+func (h *Handler) GetOrCreateExternalUser(ctx context.Context, email, name, profileImageUrl string) (*entv1.User, error) {
+	existing, err := h.client.User.Query().Where(user.Email(email)).Only(ctx)
+	if err == nil {
+		return existing, nil
+	} else if !entv1.IsNotFound(err) {
+		return nil, fmt.Errorf("failed querying user: %w", err)
+	}
+
+	role, err := h.NewUserRole(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// user names are unique, fall back to the email if the display name is taken
+	if name == "" {
+		name = email
+	} else if taken, err := h.client.User.Query().Where(user.Name(name)).Exist(ctx); err != nil {
+		return nil, fmt.Errorf("failed querying user: %w", err)
+	} else if taken {
+		name = email
+	}
+
+	if profileImageUrl == "" {
+		profileImageUrl = "/user.png"
+	}
+
+	// external users can not sign in with a local password
+	randomPw, err := utils.GenerateRandomPassword()
+	if err != nil {
+		return nil, err
+	}
+	hashPw, err := utils.HashPassword(randomPw)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.CreateUser(ctx, &entv1.User{
+		Name:            name,
+		Email:           email,
+		Password:        hashPw,
+		Role:            role,
+		ProfileImageUrl: profileImageUrl,
+	})
+}
+
 func GetUserRole(role string) user.Role {
 	switch role {
 	case "admin":
@@ -0,0 +1,205 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/chat"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/modelfile"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/session"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/tag"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
+)
+
+// Impersonation is the session of an admin acting as a user.
+type Impersonation struct {
+	ID             uuid.UUID  `json:"id"`
+	UserID         uuid.UUID  `json:"userId"`
+	UserEmail      string     `json:"userEmail"`
+	ImpersonatorID uuid.UUID  `json:"impersonatorId"`
+	AdminEmail     string     `json:"adminEmail"`
+	IP             string     `json:"ip"`
+	UserAgent      string     `json:"userAgent"`
+	ExpiresAt      time.Time  `json:"expiresAt"`
+	RevokedAt      *time.Time `json:"revokedAt"`
+	CreatedAt      time.Time  `json:"createdAt"`
+}
+
+// DeleteUserWithData deletes the user together with its chats and modelfiles,
+// or hands them over to another user if transferTo is set.
+func (h *Handler) DeleteUserWithData(ctx context.Context, id uuid.UUID, transferTo *uuid.UUID) error {
+	if transferTo != nil && *transferTo == id {
+		return fmt.Errorf("can not transfer the chats and modelfiles to the deleted user")
+	}
+
+	return h.inTx(ctx, func(tx *entv1.Tx) error {
+		return h.deleteUserWithData(ctx, tx, id, transferTo)
+	})
+}
+
+// inTx runs fn in a transaction, which is rolled back if fn fails.
+func (h *Handler) inTx(ctx context.Context, fn func(tx *entv1.Tx) error) error {
+	tx, err := h.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err = fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			slog.Error("failed to rollback transaction", "error", rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+func (h *Handler) deleteUserWithData(ctx context.Context, tx *entv1.Tx, id uuid.UUID, transferTo *uuid.UUID) error {
+	if transferTo != nil {
+		if _, err := tx.User.Get(ctx, *transferTo); err != nil {
+			return err
+		}
+		if err := h.transferTags(ctx, tx, id, *transferTo); err != nil {
+			return err
+		}
+		if err := tx.Chat.Update().
+			Where(chat.HasOwnerWith(user.ID(id))).
+			SetUserId(*transferTo).
+			Exec(ctx); err != nil {
+			return err
+		}
+		if err := tx.Modelfile.Update().
+			Where(modelfile.HasOwnerWith(user.ID(id))).
+			SetUserId(*transferTo).
+			Exec(ctx); err != nil {
+			return err
+		}
+	} else {
+		if _, err := tx.Chat.Delete().Where(chat.HasOwnerWith(user.ID(id))).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.Modelfile.Delete().Where(modelfile.HasOwnerWith(user.ID(id))).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	// sessions, api keys, password resets, invitations and tags are removed by the cascading edges
+	return tx.User.DeleteOneID(id).Exec(ctx)
+}
+
+// transferTags attaches the tagged chats of the user to the new owner's tags of the same names,
+// the missing tags are created. Tags without chats are not transferred.
+func (h *Handler) transferTags(ctx context.Context, tx *entv1.Tx, from, to uuid.UUID) error {
+	tags, err := tx.Tag.Query().
+		Where(tag.UserId(from), tag.HasChats()).
+		WithChats(func(q *entv1.ChatQuery) {
+			q.Select(chat.FieldID)
+		}).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, t := range tags {
+		target, err := tx.Tag.Query().Where(tag.UserId(to), tag.Name(t.Name)).Only(ctx)
+		if entv1.IsNotFound(err) {
+			target, err = tx.Tag.Create().SetName(t.Name).SetUserId(to).Save(ctx)
+		}
+		if err != nil {
+			return fmt.Errorf("failed transferring tag %s: %w", t.Name, err)
+		}
+
+		ids := make([]uuid.UUID, 0, len(t.Edges.Chats))
+		for _, c := range t.Edges.Chats {
+			ids = append(ids, c.ID)
+		}
+		if err = tx.Tag.UpdateOne(target).AddChatIDs(ids...).Exec(ctx); err != nil {
+			return fmt.Errorf("failed transferring tag %s: %w", t.Name, err)
+		}
+	}
+	return nil
+}
+
+// Impersonate starts a session of the admin acting as the user and returns its access token,
+// no refresh token is issued so the impersonation ends when the token expires.
+func (h *Handler) Impersonate(c *gin.Context, admin, u *entv1.User) (string, *entv1.Session, error) {
+	duration, err := time.ParseDuration(settings.ImpersonationExpireTime.Get())
+	if err != nil {
+		return "", nil, err
+	}
+
+	// the session still needs a refresh token, but it is never handed out
+	refreshToken, err := utils.GenerateRefreshToken()
+	if err != nil {
+		return "", nil, err
+	}
+
+	s, err := h.client.Session.Create().
+		SetOwner(u).
+		SetRefreshToken(utils.HashToken(refreshToken)).
+		SetUserAgent(c.Request.UserAgent()).
+		SetIP(c.ClientIP()).
+		SetExpiresAt(time.Now().Add(duration)).
+		SetImpersonatorId(admin.ID).
+		Save(c.Request.Context())
+	if err != nil {
+		return "", nil, err
+	}
+
+	token, err := utils.GenerateImpersonationToken(u.ID, s.ID, admin.ID, s.ExpiresAt)
+	if err != nil {
+		return "", nil, err
+	}
+
+	slog.Info("admin started impersonating user", "admin", admin.Email, "user", u.Email, "session", s.ID)
+	return token, s, nil
+}
+
+// ListImpersonations returns the impersonation sessions, newest first.
+func (h *Handler) ListImpersonations(ctx context.Context, limit int) ([]Impersonation, error) {
+	sessions, err := h.client.Session.Query().
+		Where(session.ImpersonatorIdNotNil()).
+		WithOwner().
+		Order(entv1.Desc(session.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying impersonations: %w", err)
+	}
+
+	adminIDs := make([]uuid.UUID, 0, len(sessions))
+	for _, s := range sessions {
+		adminIDs = append(adminIDs, *s.ImpersonatorId)
+	}
+	admins, err := h.client.User.Query().Where(user.IDIn(adminIDs...)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying users: %w", err)
+	}
+	adminEmails := make(map[uuid.UUID]string, len(admins))
+	for _, a := range admins {
+		adminEmails[a.ID] = a.Email
+	}
+
+	impersonations := make([]Impersonation, 0, len(sessions))
+	for _, s := range sessions {
+		impersonations = append(impersonations, Impersonation{
+			ID:             s.ID,
+			UserID:         s.UserId,
+			UserEmail:      s.Edges.Owner.Email,
+			ImpersonatorID: *s.ImpersonatorId,
+			AdminEmail:     adminEmails[*s.ImpersonatorId],
+			IP:             s.IP,
+			UserAgent:      s.UserAgent,
+			ExpiresAt:      s.ExpiresAt,
+			RevokedAt:      s.RevokedAt,
+			CreatedAt:      s.CreatedAt,
+		})
+	}
+	return impersonations, nil
+}
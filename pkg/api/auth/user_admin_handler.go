@@ -0,0 +1,193 @@
+package auth
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/llmos-ai/llmos-dashboard/pkg/constant"
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
+)
+
+const defaultImpersonationsLimit = 100
+
+type AddUserRequest struct {
+	Name     string         `json:"name" binding:"required"`
+	Email    string         `json:"email" binding:"required"`
+	Password string         `json:"password" binding:"required"`
+	Role     entv1User.Role `json:"role"`
+}
+
+// AddUser creates an account with the given role, e.g., while signup is disabled.
+func (h *Handler) AddUser(c *gin.Context) {
+	var req AddUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if req.Role == "" {
+		req.Role = entv1User.RoleUser
+	}
+	if err := entv1User.RoleValidator(req.Role); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if denyAdminChange(c, req.Role) {
+		return
+	}
+	if err := utils.ValidatePasswordPolicy(req.Password); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	hashPw, err := utils.HashPassword(req.Password)
+	if err != nil {
+		slog.Error("failed to hash password", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": constant.MessageErrorHashPassword})
+		return
+	}
+
+	user, err := h.CreateUser(c.Request.Context(), &entv1.User{
+		Name:            req.Name,
+		Email:           req.Email,
+		Password:        hashPw,
+		Role:            req.Role,
+		ProfileImageUrl: "/user.png",
+	})
+	if entv1.IsConstraintError(err) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "a user with this email already exists"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, user)
+}
+
+// DeleteUserByID deletes a user and its chats and modelfiles, unless the transferTo
+// query names the user that takes them over.
+func (h *Handler) DeleteUserByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var transferTo *uuid.UUID
+	if v := c.Query("transferTo"); v != "" {
+		to, err := uuid.Parse(v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid transferTo"})
+			return
+		}
+		if to == id {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "can not transfer the chats and modelfiles to the deleted user"})
+			return
+		}
+		transferTo = &to
+	}
+
+	admin, err := utils.GetSessionUser(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	if admin.ID == id {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "you can not delete your own account"})
+		return
+	}
+
+	user, err := h.GetUserByID(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if denyAdminChange(c, user.Role) {
+		return
+	}
+
+	if err = h.DeleteUserWithData(c.Request.Context(), id, transferTo); err != nil {
+		if entv1.IsNotFound(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "the user to transfer the data to does not exist"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	slog.Info("user deleted", "admin", admin.Email, "user", user.Email, "transferTo", transferTo)
+	c.JSON(http.StatusOK, true)
+}
+
+// ImpersonateUser issues a short-lived token to act as the user for support debugging.
+func (h *Handler) ImpersonateUser(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	admin, err := utils.GetSessionUser(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	// an impersonation token can not be used to start another impersonation
+	if utils.GetImpersonator(c) != nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": constant.MessageErrorImpersonation})
+		return
+	}
+
+	user, err := h.GetUserByID(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if user.ID == admin.ID || user.Role == entv1User.RoleAdmin {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "admin accounts can not be impersonated"})
+		return
+	}
+
+	token, s, err := h.Impersonate(c, admin, user)
+	if err != nil {
+		slog.Error("failed to impersonate user", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": constant.MessageErrorGenerateToken})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"token":           token,
+		"tokenType":       tokenType,
+		"expiresAt":       s.ExpiresAt,
+		"impersonator":    admin.ID,
+		"id":              user.ID,
+		"email":           user.Email,
+		"name":            user.Name,
+		"role":            user.Role,
+		"profileImageUrl": user.ProfileImageUrl,
+	})
+}
+
+func (h *Handler) ListUserImpersonations(c *gin.Context) {
+	limit := defaultImpersonationsLimit
+	if v := c.Query("limit"); v != "" {
+		l, err := strconv.Atoi(v)
+		if err != nil || l <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit"})
+			return
+		}
+		limit = l
+	}
+
+	impersonations, err := h.ListImpersonations(c.Request.Context(), limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, impersonations)
+}
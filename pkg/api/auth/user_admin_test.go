@@ -0,0 +1,184 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/llmos-ai/llmos-dashboard/pkg/constant"
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/chat"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/session"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/tag"
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+)
+
+// tagChat attaches the owner's tag of the name to the chat, creating the tag if needed.
+func tagChat(t *testing.T, h *Handler, c *entv1.Chat, name string) {
+	t.Helper()
+	tg, err := h.client.Tag.Query().Where(tag.UserId(c.UserId), tag.Name(name)).Only(h.ctx)
+	if entv1.IsNotFound(err) {
+		tg, err = h.client.Tag.Create().SetName(name).SetUserId(c.UserId).Save(h.ctx)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.client.Chat.UpdateOne(c).AddTags(tg).ExecX(h.ctx)
+}
+
+// chatTitlesByTag returns the titles of the user's chats per tag name.
+func chatTitlesByTag(t *testing.T, h *Handler, u *entv1.User) map[string][]string {
+	t.Helper()
+	tags := h.client.Tag.Query().
+		Where(tag.UserId(u.ID)).
+		WithChats(func(q *entv1.ChatQuery) {
+			q.Order(entv1.Asc(chat.FieldTitle))
+		}).
+		AllX(h.ctx)
+	titles := map[string][]string{}
+	for _, tg := range tags {
+		for _, c := range tg.Edges.Chats {
+			if c.UserId != u.ID {
+				t.Errorf("tag %s of %s is on a chat of another user", tg.Name, u.Name)
+			}
+			titles[tg.Name] = append(titles[tg.Name], c.Title)
+		}
+	}
+	return titles
+}
+
+func TestDeleteUserTransfersTags(t *testing.T) {
+	h := newTestHandler(t)
+	alice := createTestUser(t, h, "alice", entv1User.RoleUser, "Passw0rd")
+	bob := createTestUser(t, h, "bob", entv1User.RoleUser, "Passw0rd")
+
+	plan := createTestChat(t, h, alice, "a-plan")
+	recipes := createTestChat(t, h, alice, "a-recipes")
+	createTestChat(t, h, alice, "a-untagged")
+	report := createTestChat(t, h, bob, "b-report")
+	tagChat(t, h, plan, "work")
+	tagChat(t, h, plan, "home")
+	tagChat(t, h, recipes, "home")
+	tagChat(t, h, report, "work")
+	h.client.Tag.Create().SetName("unused").SetOwner(alice).ExecX(h.ctx)
+
+	if err := h.DeleteUserWithData(h.ctx, alice.ID, &bob.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if n := h.client.Chat.Query().Where(chat.UserId(bob.ID)).CountX(h.ctx); n != 4 {
+		t.Errorf("bob has %d chats, want 4", n)
+	}
+	want := map[string][]string{
+		"work": {"a-plan", "b-report"},
+		"home": {"a-plan", "a-recipes"},
+	}
+	got := chatTitlesByTag(t, h, bob)
+	if len(got) != len(want) {
+		t.Errorf("bob's tags = %v, want %v", got, want)
+	}
+	for name, titles := range want {
+		if !slices.Equal(got[name], titles) {
+			t.Errorf("chats tagged %s = %v, want %v", name, got[name], titles)
+		}
+	}
+	if n := h.client.Tag.Query().Where(tag.UserId(alice.ID)).CountX(h.ctx); n != 0 {
+		t.Errorf("%d tags of the deleted user are left", n)
+	}
+}
+
+// impersonate asks to impersonate the user of the id with the access token and returns the
+// status and the impersonation token.
+func impersonate(t *testing.T, h *Handler, token string, id string) (int, string) {
+	t.Helper()
+	r := gin.New()
+	r.POST("/api/v1/users/:id/impersonate", h.AuthMiddleware, h.AdminMiddleware, h.ImpersonateUser)
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/users/"+id+"/impersonate", nil)
+	req.Header.Set("Authorization", tokenType+" "+token)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	var res struct {
+		Token string `json:"token"`
+	}
+	_ = json.Unmarshal(w.Body.Bytes(), &res)
+	return w.Code, res.Token
+}
+
+func TestImpersonateUser(t *testing.T) {
+	h := newTestHandler(t)
+	admin := createTestUser(t, h, "admin", entv1User.RoleAdmin, "Passw0rd")
+	other := createTestUser(t, h, "other", entv1User.RoleAdmin, "Passw0rd")
+	alice := createTestUser(t, h, "alice", entv1User.RoleUser, "Passw0rd")
+	bob := createTestUser(t, h, "bob", entv1User.RoleUser, "Passw0rd")
+	adminToken := createTestSession(t, h, admin).Token
+
+	code, token := impersonate(t, h, adminToken, alice.ID.String())
+	if code != http.StatusOK || token == "" {
+		t.Fatalf("impersonate user: status = %d", code)
+	}
+
+	var gotUser *entv1.User
+	var gotImpersonator any
+	w := serveAuthenticated(t, h, token, func(c *gin.Context) {
+		gotUser, _ = c.MustGet("user").(*entv1.User)
+		gotImpersonator, _ = c.Get("impersonator")
+		c.Status(http.StatusOK)
+	})
+	if w.Code != http.StatusOK {
+		t.Fatalf("request with the impersonation token: status = %d", w.Code)
+	}
+	if gotUser == nil || gotUser.ID != alice.ID {
+		t.Errorf("session user = %v, want alice", gotUser)
+	}
+	if id, ok := gotImpersonator.(*uuid.UUID); !ok || *id != admin.ID {
+		t.Errorf("impersonator = %v, want %s", gotImpersonator, admin.ID)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		id    string
+		want  int
+	}{
+		{"another admin", adminToken, other.ID.String(), http.StatusBadRequest},
+		{"the admin itself", adminToken, admin.ID.String(), http.StatusBadRequest},
+		{"nested impersonation", token, bob.ID.String(), http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		if code, _ := impersonate(t, h, tt.token, tt.id); code != tt.want {
+			t.Errorf("impersonate %s: status = %d, want %d", tt.name, code, tt.want)
+		}
+	}
+	if n := h.client.Session.Query().Where(session.ImpersonatorIdNotNil()).CountX(h.ctx); n != 1 {
+		t.Errorf("%d impersonation sessions, want 1", n)
+	}
+}
+
+func TestImpersonateUserRefusesImpersonator(t *testing.T) {
+	h := newTestHandler(t)
+	admin := createTestUser(t, h, "admin", entv1User.RoleAdmin, "Passw0rd")
+	alice := createTestUser(t, h, "alice", entv1User.RoleUser, "Passw0rd")
+
+	// the handler refuses nested impersonation even if the session user passed the admin check
+	r := gin.New()
+	r.POST("/users/:id/impersonate", func(c *gin.Context) {
+		c.Set("user", admin)
+		c.Set("impersonator", &admin.ID)
+	}, h.ImpersonateUser)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/users/"+alice.ID.String()+"/impersonate", nil))
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusForbidden)
+	}
+	var res struct {
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil || res.Error != constant.MessageErrorImpersonation {
+		t.Errorf("error = %q, want %q", res.Error, constant.MessageErrorImpersonation)
+	}
+}
@@ -1,62 +1,296 @@
 package chat
 
 import (
+	"context"
+	"encoding/base64"
 	"fmt"
 	"log/slog"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	_ "github.com/mattn/go-sqlite3"
 
 	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/chat"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/predicate"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/tag"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
 )
 
-func (h *Handler) ListAll() (entv1.Chats, error) {
-	chats, err := h.client.Chat.Query().All(h.ctx)
+const trashPurgeInterval = time.Hour
+
+// ListAll returns the chats of all users, or of the given user, for admins.
+func (h *Handler) ListAll(ctx context.Context, userID *uuid.UUID) (entv1.Chats, error) {
+	query := h.client.Chat.Query()
+	if userID != nil {
+		query.Where(chat.UserId(*userID))
+	}
+	chats, err := query.Order(entv1.Desc(chat.FieldCreatedAt)).All(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed querying chats: %w", err)
 	}
-	slog.Debug("get model files: ", chats)
+	slog.Debug("get chats", "count", len(chats))
 	return chats, nil
 }
 
-func (h *Handler) ListByUser(user *entv1.User) ([]*entv1.Chat, error) {
-	chats, err := user.QueryChats().All(h.ctx)
+// ChatSummary is a chat without its history and messages, for listing the chats.
+type ChatSummary struct {
+	ID        uuid.UUID `json:"id"`
+	Title     string    `json:"title"`
+	Models    []string  `json:"models"`
+	Tags      []string  `json:"tags"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	// DeletedAt is only set in the listing of the trash
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+}
+
+// ChatCursor is the position of the last chat of a page of the chat listing.
+type ChatCursor struct {
+	UpdatedAt time.Time
+	ID        uuid.UUID
+}
+
+// ParseChatCursor parses a cursor returned by ListByUser.
+func ParseChatCursor(s string) (*ChatCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
 	if err != nil {
-		return nil, fmt.Errorf("failed querying chats: %w", err)
+		return nil, fmt.Errorf("invalid cursor")
 	}
-	slog.Debug("get user model files: ", user.Name, chats)
-	return chats, nil
+	updatedAt, id, ok := strings.Cut(string(b), "_")
+	if !ok {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+
+	var cursor ChatCursor
+	if cursor.UpdatedAt, err = time.Parse(time.RFC3339Nano, updatedAt); err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+	if cursor.ID, err = uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+	return &cursor, nil
+}
+
+func (c ChatCursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(c.UpdatedAt.Format(time.RFC3339Nano) + "_" + c.ID.String()))
+}
+
+// ListByUser returns a page of the user's chats after the cursor, most recently updated first, and the cursor
+// of the next page, which is nil on the last page.
+func (h *Handler) ListByUser(ctx context.Context, user *entv1.User, cursor *ChatCursor, limit int) ([]ChatSummary, *ChatCursor, error) {
+	query := user.QueryChats().Where(chat.DeletedAtIsNil())
+	if cursor != nil {
+		query.Where(chat.Or(
+			chat.UpdatedAtLT(cursor.UpdatedAt),
+			chat.And(chat.UpdatedAt(cursor.UpdatedAt), chat.IDLT(cursor.ID)),
+		))
+	}
+	chats, err := query.
+		Order(entv1.Desc(chat.FieldUpdatedAt), entv1.Desc(chat.FieldID)).
+		Limit(limit+1).
+		WithTags(func(q *entv1.TagQuery) {
+			q.Select(tag.FieldName)
+		}).
+		Select(chat.FieldTitle, chat.FieldModels, chat.FieldCreatedAt, chat.FieldUpdatedAt).
+		All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed querying chats: %w", err)
+	}
+
+	var next *ChatCursor
+	if len(chats) > limit {
+		chats = chats[:limit]
+		last := chats[len(chats)-1]
+		next = &ChatCursor{UpdatedAt: last.UpdatedAt, ID: last.ID}
+	}
+	return summaries(chats), next, nil
 }
 
-func (h *Handler) Create(user *entv1.User, req NewChatRequest) (*entv1.Chat, error) {
+// summaries returns the summaries of the chats, their tags must be loaded.
+func summaries(chats []*entv1.Chat) []ChatSummary {
+	s := make([]ChatSummary, 0, len(chats))
+	for _, c := range chats {
+		tags := make([]string, 0, len(c.Edges.Tags))
+		for _, t := range c.Edges.Tags {
+			tags = append(tags, t.Name)
+		}
+		slices.Sort(tags)
+		s = append(s, ChatSummary{
+			ID:        c.ID,
+			Title:     c.Title,
+			Models:    c.Models,
+			Tags:      tags,
+			CreatedAt: c.CreatedAt,
+			UpdatedAt: c.UpdatedAt,
+			DeletedAt: c.DeletedAt,
+		})
+	}
+	return s
+}
+
+func (h *Handler) Create(ctx context.Context, user *entv1.User, req NewChatRequest) (*entv1.Chat, error) {
+	tags, err := h.getOrCreateTags(ctx, user, req.Tags)
+	if err != nil {
+		return nil, err
+	}
+
 	chat, err := h.client.Chat.
 		Create().
 		SetTitle(req.Title).
 		SetHistory(req.History).
 		SetMessages(req.Messages).
 		SetModels(req.Models).
-		SetTags(req.Tags).
+		AddTags(tags...).
 		SetOwner(user).
-		Save(h.ctx)
+		Save(ctx)
 	if err != nil {
 		return nil, err
 	}
+	chat.Edges.Tags = tags
 	return chat, nil
 }
 
-func (h *Handler) Update(id uuid.UUID, req UpdateChatRequest) (*entv1.Chat, error) {
+// GetByOwner returns the chat if it is owned by the user, chats of other users and trashed chats are not found.
+func (h *Handler) GetByOwner(ctx context.Context, user *entv1.User, id uuid.UUID) (*entv1.Chat, error) {
+	return h.client.Chat.Query().
+		Where(chat.ID(id), chat.UserId(user.ID), chat.DeletedAtIsNil()).
+		Only(ctx)
+}
+
+// Get returns any chat regardless of its owner, it is only used for the audited admin access.
+func (h *Handler) Get(ctx context.Context, id uuid.UUID) (*entv1.Chat, error) {
+	return h.client.Chat.Get(ctx, id)
+}
+
+// Update updates the chat if it is owned by the user, chats of other users and trashed chats are not found.
+func (h *Handler) Update(ctx context.Context, user *entv1.User, id uuid.UUID, req UpdateChatRequest) (*entv1.Chat, error) {
 	client := h.client.Chat.UpdateOneID(id).
+		Where(chat.UserId(user.ID), chat.DeletedAtIsNil()).
 		SetNillableHistory(req.History).
-		SetNillableTitle(req.Title)
+		SetNillableTitle(req.Title).
+		SetUpdatedAt(time.Now())
 
 	if req.Messages != nil || len(req.Messages) > 0 {
 		client.SetMessages(req.Messages)
 	}
 
-	chat, err := client.Save(h.ctx)
+	chat, err := client.Save(ctx)
 	if err != nil {
 		return nil, err
 	}
-	slog.Debug("updated chat: ", chat)
+	slog.Debug("updated chat", "chat", chat.ID)
 	return chat, nil
 }
+
+// TrashByOwner moves the chat to the trash if it is owned by the user, chats of other users
+// and trashed chats are not found.
+func (h *Handler) TrashByOwner(ctx context.Context, user *entv1.User, id uuid.UUID) error {
+	return h.client.Chat.UpdateOneID(id).
+		Where(chat.UserId(user.ID), chat.DeletedAtIsNil()).
+		SetDeletedAt(time.Now()).
+		Exec(ctx)
+}
+
+// ListTrash returns the trashed chats of the user, most recently deleted first.
+func (h *Handler) ListTrash(ctx context.Context, user *entv1.User) ([]ChatSummary, error) {
+	chats, err := user.QueryChats().
+		Where(chat.DeletedAtNotNil()).
+		Order(entv1.Desc(chat.FieldDeletedAt), entv1.Desc(chat.FieldID)).
+		WithTags(func(q *entv1.TagQuery) {
+			q.Select(tag.FieldName)
+		}).
+		Select(chat.FieldTitle, chat.FieldModels, chat.FieldCreatedAt, chat.FieldUpdatedAt, chat.FieldDeletedAt).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying trashed chats: %w", err)
+	}
+	return summaries(chats), nil
+}
+
+// RestoreByOwner moves a trashed chat of the user back out of the trash.
+func (h *Handler) RestoreByOwner(ctx context.Context, user *entv1.User, id uuid.UUID) (*entv1.Chat, error) {
+	return h.client.Chat.UpdateOneID(id).
+		Where(chat.UserId(user.ID), chat.DeletedAtNotNil()).
+		ClearDeletedAt().
+		Save(ctx)
+}
+
+// PurgeByOwner permanently deletes a trashed chat of the user.
+func (h *Handler) PurgeByOwner(ctx context.Context, user *entv1.User, id uuid.UUID) error {
+	return h.purgeOne(ctx, chat.ID(id), chat.UserId(user.ID), chat.DeletedAtNotNil())
+}
+
+// EmptyTrash permanently deletes all trashed chats of the user and returns their number.
+func (h *Handler) EmptyTrash(ctx context.Context, user *entv1.User) (int, error) {
+	return h.purge(ctx, chat.UserId(user.ID), chat.DeletedAtNotNil())
+}
+
+// PurgeTrash permanently deletes the chats of all users that were trashed before the cutoff.
+func (h *Handler) PurgeTrash(ctx context.Context, cutoff time.Time) (int, error) {
+	return h.purge(ctx, chat.DeletedAtLT(cutoff))
+}
+
+// purge permanently deletes the chats matching the predicates, and the tags no longer attached to any chat.
+// The handler deletes chats only through it.
+func (h *Handler) purge(ctx context.Context, ps ...predicate.Chat) (int, error) {
+	n, err := h.client.Chat.Delete().Where(ps...).Exec(ctx)
+	if err != nil || n == 0 {
+		return n, err
+	}
+	if _, err = h.client.Tag.Delete().Where(tag.Not(tag.HasChats())).Exec(ctx); err != nil {
+		return n, fmt.Errorf("failed deleting unused tags: %w", err)
+	}
+	return n, nil
+}
+
+// purgeOne is purge for a single chat, it returns a not found error if no chat matches.
+func (h *Handler) purgeOne(ctx context.Context, ps ...predicate.Chat) error {
+	n, err := h.purge(ctx, ps...)
+	if err == nil && n == 0 {
+		_, err = h.client.Chat.Query().Where(ps...).OnlyID(ctx)
+	}
+	return err
+}
+
+// RunTrashPurger purges the chats whose trash retention period has passed, on start and then
+// periodically until the handler's context is done.
+func (h *Handler) RunTrashPurger() {
+	ticker := time.NewTicker(trashPurgeInterval)
+	defer ticker.Stop()
+	for {
+		h.purgeExpiredTrash()
+		select {
+		case <-h.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (h *Handler) purgeExpiredTrash() {
+	retention, err := time.ParseDuration(settings.ChatTrashRetention.Get())
+	if err != nil {
+		slog.Error("invalid chat trash retention", "error", err)
+		return
+	}
+	if retention <= 0 {
+		return
+	}
+
+	n, err := h.PurgeTrash(h.ctx, time.Now().Add(-retention))
+	if err != nil {
+		slog.Error("failed to purge trashed chats", "error", err)
+		return
+	}
+	if n > 0 {
+		slog.Info("purged trashed chats", "count", n)
+	}
+}
+
+// Delete deletes any chat regardless of its owner, it is only used for the audited admin access.
+func (h *Handler) Delete(ctx context.Context, id uuid.UUID) error {
+	return h.purgeOne(ctx, chat.ID(id))
+}
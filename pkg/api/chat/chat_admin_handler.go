@@ -0,0 +1,70 @@
+package chat
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+)
+
+// The admin chat handlers access the chats of any user, they are routed separately from the
+// owner-scoped chat handlers so that admins access other users' chats explicitly and audited.
+
+// ListAllChats returns the chats of all users, or of the user of the userId query.
+func (h *Handler) ListAllChats(c *gin.Context) {
+	var userID *uuid.UUID
+	if v := c.Query("userId"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"status": false, "error": "invalid userId"})
+			return
+		}
+		userID = &id
+	}
+
+	chats, err := h.ListAll(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, chats)
+}
+
+func (h *Handler) GetAnyChatByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": false, "error": "invalid chat id"})
+		return
+	}
+
+	chat, err := h.Get(c.Request.Context(), id)
+	if entv1.IsNotFound(err) {
+		c.JSON(http.StatusNotFound, gin.H{"status": false, "error": "chat not found"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, chatResponse(chat))
+}
+
+func (h *Handler) DeleteAnyChatByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": false, "error": "invalid chat id"})
+		return
+	}
+
+	if err = h.Delete(c.Request.Context(), id); err != nil {
+		if entv1.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"status": false, "error": "chat not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": true})
+}
@@ -3,18 +3,24 @@ package chat
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
 	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
-	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/chat"
 	v1 "github.com/llmos-ai/llmos-dashboard/pkg/types/v1"
 	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
 )
 
+const (
+	defaultChatsLimit = 50
+	maxChatsLimit     = 200
+)
+
 type Handler struct {
 	client *entv1.Client
 	ctx    context.Context
@@ -41,18 +47,40 @@ func NewHandler(c *entv1.Client, ctx context.Context) Handler {
 	}
 }
 
+// GetUserChats returns a page of the session user's chats without their history and messages, most recently
+// updated first. The nextCursor of the response is passed as the cursor query to get the next page.
 func (h *Handler) GetUserChats(c *gin.Context) {
 	user, err := utils.GetSessionUser(c)
 	if err != nil {
 		c.JSON(http.StatusUnauthorized, gin.H{"status": false, "error": err.Error()})
 		return
 	}
-	chats, err := h.ListByUser(user)
+
+	var cursor *ChatCursor
+	if v := c.Query("cursor"); v != "" {
+		if cursor, err = ParseChatCursor(v); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"status": false, "error": err.Error()})
+			return
+		}
+	}
+	limit := defaultChatsLimit
+	if v := c.Query("limit"); v != "" {
+		if limit, err = strconv.Atoi(v); err != nil || limit <= 0 || limit > maxChatsLimit {
+			c.JSON(http.StatusBadRequest, gin.H{"status": false, "error": fmt.Sprintf("invalid limit, must be between 1 and %d", maxChatsLimit)})
+			return
+		}
+	}
+
+	chats, next, err := h.ListByUser(c.Request.Context(), user, cursor, limit)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, err.Error())
 		return
 	}
-	c.JSON(http.StatusOK, chats)
+	res := gin.H{"chats": chats}
+	if next != nil {
+		res["nextCursor"] = next.String()
+	}
+	c.JSON(http.StatusOK, res)
 }
 func (h *Handler) CreateChat(c *gin.Context) {
 	// get session user
@@ -71,12 +99,16 @@ func (h *Handler) CreateChat(c *gin.Context) {
 	var req NewChatRequest
 	err = json.Unmarshal(chatData, &req)
 	if err != nil {
-		slog.Error("failed to parse chat obj", err)
+		slog.Error("failed to parse chat obj", "error", err)
 		c.JSON(http.StatusBadRequest, gin.H{"status": false, "error": err.Error()})
 		return
 	}
 
-	chat, err := h.Create(user, req)
+	chat, err := h.Create(c.Request.Context(), user, req)
+	if entv1.IsValidationError(err) {
+		c.JSON(http.StatusBadRequest, gin.H{"status": false, "error": err.Error()})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"status": false, "error": err.Error()})
 		return
@@ -86,6 +118,12 @@ func (h *Handler) CreateChat(c *gin.Context) {
 }
 
 func (h *Handler) UpdateChatByID(c *gin.Context) {
+	user, err := utils.GetSessionUser(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+
 	id := c.Param("id")
 	if id == "" {
 		c.JSON(http.StatusBadRequest, gin.H{"status": false, "error": "chat id is empty"})
@@ -107,12 +145,16 @@ func (h *Handler) UpdateChatByID(c *gin.Context) {
 	var req UpdateChatRequest
 	err = json.Unmarshal(chatData, &req)
 	if err != nil {
-		slog.Error("failed to parse chat obj", err)
+		slog.Error("failed to parse chat obj", "error", err)
 		c.JSON(http.StatusBadRequest, err.Error())
 		return
 	}
 
-	savedChat, err := h.Update(uuid, req)
+	savedChat, err := h.Update(c.Request.Context(), user, uuid, req)
+	if entv1.IsNotFound(err) {
+		c.JSON(http.StatusNotFound, gin.H{"status": false, "error": "chat not found"})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, err.Error())
 		return
@@ -123,6 +165,12 @@ func (h *Handler) UpdateChatByID(c *gin.Context) {
 
 func (h *Handler) GetChatByID(c *gin.Context) {
 	// get session user
+	user, err := utils.GetSessionUser(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+
 	id := c.Param("id")
 	if id == "" {
 		c.JSON(http.StatusBadRequest, gin.H{"status": false, "error": "chat id empty"})
@@ -135,30 +183,41 @@ func (h *Handler) GetChatByID(c *gin.Context) {
 		return
 	}
 
-	// get chat by id
-	chat, err := h.client.Chat.Query().
-		Where(chat.ID(uuid)).
-		Only(h.ctx)
+	// get chat by id, chats of other users are not found so that their ids are not revealed
+	chat, err := h.GetByOwner(c.Request.Context(), user, uuid)
+	if entv1.IsNotFound(err) {
+		c.JSON(http.StatusNotFound, gin.H{"status": false, "error": "chat not found"})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"status": false, "error": err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{
+	c.JSON(http.StatusOK, chatResponse(chat))
+}
+
+func chatResponse(chat *entv1.Chat) gin.H {
+	return gin.H{
 		"chat":      chat,
 		"id":        chat.ID,
 		"titile":    chat.Title,
 		"userId":    chat.UserId,
 		"createdAt": chat.CreatedAt,
-	})
-
-}
-
-func (h *Handler) GetChatTagsByID(c *gin.Context) {
-	c.JSONP(http.StatusOK, []string{})
+	}
 }
 
+// DeleteChatByID moves the chat to the trash, from where it can be restored until it is purged.
 func (h *Handler) DeleteChatByID(c *gin.Context) {
+	user, err := utils.GetSessionUser(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+	if denyChatDeletion(c, user) {
+		return
+	}
+
 	// get chat id
 	id := c.Param("id")
 	if id == "" {
@@ -172,7 +231,11 @@ func (h *Handler) DeleteChatByID(c *gin.Context) {
 		return
 	}
 
-	if _, err = h.client.Chat.Delete().Where(chat.ID(uid)).Exec(h.ctx); err != nil {
+	if err = h.TrashByOwner(c.Request.Context(), user, uid); err != nil {
+		if entv1.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"status": false, "error": "chat not found"})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, err.Error())
 		return
 	}
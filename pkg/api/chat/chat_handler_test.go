@@ -0,0 +1,89 @@
+package chat
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+	v1 "github.com/llmos-ai/llmos-dashboard/pkg/types/v1"
+)
+
+func TestChatsOfOtherUsersAreNotFound(t *testing.T) {
+	h := newTestHandler(t)
+	prev := settings.AllowChatDelete.Get()
+	if err := settings.AllowChatDelete.Set("true"); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = settings.AllowChatDelete.Set(prev)
+	})
+
+	alice := createTestUser(t, h, "alice", entv1User.RoleUser)
+	bob := createTestUser(t, h, "bob", entv1User.RoleUser)
+	active := createTestChat(t, h, alice, "plans", "secret plans", "work")
+	trashed := createTestChat(t, h, alice, "old plans", "old secret plans", "work")
+	if err := h.TrashByOwner(h.ctx, alice, trashed.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	title := "taken over"
+	tests := []struct {
+		name    string
+		method  string
+		route   string
+		chat    uuid.UUID
+		body    any
+		handler gin.HandlerFunc
+	}{
+		{"get", http.MethodGet, "/chats/:id", active.ID, nil, h.GetChatByID},
+		{"update", http.MethodPost, "/chats/:id", active.ID,
+			UpdateChatRequest{Title: &title, History: &v1.Histroy{}, Messages: []v1.Message{}}, h.UpdateChatByID},
+		{"trash", http.MethodDelete, "/chats/:id", active.ID, nil, h.DeleteChatByID},
+		{"list tags", http.MethodGet, "/chats/:id/tags", active.ID, nil, h.GetChatTagsByID},
+		{"add tag", http.MethodPost, "/chats/:id/tags", active.ID, ChatTagRequest{TagName: "stolen"}, h.AddChatTagByID},
+		{"remove tag", http.MethodDelete, "/chats/:id/tags", active.ID, ChatTagRequest{TagName: "work"}, h.DeleteChatTagByID},
+		{"remove all tags", http.MethodDelete, "/chats/:id/tags/all", active.ID, nil, h.DeleteAllChatTagsByID},
+		{"restore", http.MethodPost, "/chats/:id/restore", trashed.ID, nil, h.RestoreChatByID},
+		{"purge", http.MethodDelete, "/chats/:id/purge", trashed.ID, nil, h.PurgeChatByID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := "/chats/" + tt.chat.String() + tt.route[len("/chats/:id"):]
+			w := serve(t, bob, tt.method, tt.route, path, tt.body, tt.handler)
+			if w.Code != http.StatusNotFound {
+				t.Errorf("status = %d, want %d: %s", w.Code, http.StatusNotFound, w.Body)
+			}
+		})
+	}
+
+	// the chats of alice are untouched
+	got, err := h.GetByOwner(h.ctx, alice, active.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Title != "plans" || got.DeletedAt != nil {
+		t.Errorf("chat is changed by another user: title %q, deleted at %v", got.Title, got.DeletedAt)
+	}
+	tags, err := h.ListChatTags(h.ctx, alice, active.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tags) != 1 || tags[0].Name != "work" {
+		t.Errorf("chat tags = %v, want [work]", tags)
+	}
+	if c := h.client.Chat.GetX(h.ctx, trashed.ID); c.DeletedAt == nil {
+		t.Error("trashed chat is restored by another user")
+	}
+	if n := h.client.Tag.Query().CountX(h.ctx); n != 1 {
+		t.Errorf("%d tags exist, want 1", n)
+	}
+
+	w := serve(t, alice, http.MethodGet, "/chats/:id", "/chats/"+active.ID.String(), nil, h.GetChatByID)
+	if w.Code != http.StatusOK {
+		t.Errorf("owner: status = %d, want %d", w.Code, http.StatusOK)
+	}
+}
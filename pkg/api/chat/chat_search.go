@@ -0,0 +1,293 @@
+package chat
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"log/slog"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"entgo.io/ent"
+	"github.com/google/uuid"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/chat"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/hook"
+)
+
+// The full-text search index of the chats is an SQLite FTS5 table with the titles and the message
+// contents of the chats, trashed chats stay indexed and are filtered out when searching.
+const (
+	createSearchTable = `CREATE VIRTUAL TABLE chat_search USING fts5(chat_id UNINDEXED, title, content)`
+	// the message contents are joined by newlines
+	indexChatsQuery = `INSERT INTO chat_search (chat_id, title, content)
+SELECT id, title, (SELECT group_concat(json_extract(m.value, '$.content'), char(10)) FROM json_each(chats.messages) AS m)
+FROM chats`
+	searchFromQuery = `FROM chat_search JOIN chats ON chats.id = chat_search.chat_id
+WHERE chat_search MATCH ? AND chats.user_id = ? AND chats.deleted_at IS NULL`
+
+	// matches are wrapped in control characters, which are turned into mark tags once the snippets are HTML escaped
+	searchSelectQuery = `SELECT chats.id, chats.title, chats.models, chats.created_at,
+snippet(chat_search, 1, char(2), char(3), '…', 16), snippet(chat_search, 2, char(2), char(3), '…', 32),
+bm25(chat_search, 0, 10.0, 1.0) AS rank `
+)
+
+// snippetMarks turns the match markers of the escaped snippets into mark tags.
+var snippetMarks = strings.NewReplacer("\x02", "<mark>", "\x03", "</mark>")
+
+var errSearchDisabled = errors.New("chat search is not available, SQLite is built without FTS5")
+
+// searchEnabled is set once the search index is ready, it requires SQLite to be built with FTS5,
+// i.e., with the sqlite_fts5 build tag.
+var searchEnabled atomic.Bool
+
+// SearchFilter narrows the chat search, empty fields match all chats.
+type SearchFilter struct {
+	Model string
+	Tag   string
+	// dates the chats were created, e.g., 2024-05-01, both inclusive
+	From string
+	To   string
+}
+
+// SearchResult is a chat matching the search with the highlighted matches in its title and messages.
+type SearchResult struct {
+	ID           uuid.UUID `json:"id"`
+	Title        string    `json:"title"`
+	Models       []string  `json:"models"`
+	CreatedAt    time.Time `json:"createdAt"`
+	TitleSnippet string    `json:"titleSnippet"`
+	Snippet      string    `json:"snippet"`
+	// the higher the rank the better the chat matches
+	Rank float64 `json:"rank"`
+}
+
+// InitSearch creates the search index of the chats, indexing the existing chats if it is new, and keeps it
+// in sync with the chats through hooks. Search stays disabled if SQLite is built without FTS5.
+func (h *Handler) InitSearch() error {
+	var exists bool
+	if err := h.queryRow(h.ctx, "SELECT COUNT(*) > 0 FROM sqlite_master WHERE name = 'chat_search'", nil, &exists); err != nil {
+		return fmt.Errorf("failed checking the chat search index: %w", err)
+	}
+
+	if !exists {
+		tx, err := h.client.Tx(h.ctx)
+		if err != nil {
+			return err
+		}
+		if _, err = tx.ExecContext(h.ctx, createSearchTable); err != nil {
+			_ = tx.Rollback()
+			if strings.Contains(err.Error(), "no such module: fts5") {
+				slog.Warn("chat search is disabled, SQLite is built without FTS5")
+				return nil
+			}
+			return fmt.Errorf("failed creating the chat search index: %w", err)
+		}
+		if _, err = tx.ExecContext(h.ctx, indexChatsQuery); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed indexing the chats: %w", err)
+		}
+		if err = tx.Commit(); err != nil {
+			return err
+		}
+	}
+
+	h.client.Chat.Use(searchHook)
+	searchEnabled.Store(true)
+	return nil
+}
+
+// searchHook updates the search index of the chats whose title or messages are changed, and removes
+// deleted chats from it.
+func searchHook(next ent.Mutator) ent.Mutator {
+	return hook.ChatFunc(func(ctx context.Context, m *entv1.ChatMutation) (ent.Value, error) {
+		deleted := m.Op().Is(ent.OpDelete | ent.OpDeleteOne)
+		indexed := m.Op().Is(ent.OpCreate) || deleted
+		for _, name := range []string{chat.FieldTitle, chat.FieldMessages} {
+			if _, ok := m.Field(name); ok {
+				indexed = true
+			}
+		}
+		if !indexed {
+			return next.Mutate(ctx, m)
+		}
+
+		// the ids of deleted chats are gone after the mutation
+		var ids []uuid.UUID
+		if !m.Op().Is(ent.OpCreate) {
+			var err error
+			if ids, err = m.IDs(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return v, err
+		}
+
+		if m.Op().Is(ent.OpCreate) {
+			id, _ := m.ID()
+			ids = []uuid.UUID{id}
+		}
+		// the change is only rolled back within a transaction, RebuildSearchIndex fixes the index otherwise
+		if err = updateSearchIndex(ctx, m.Client(), ids, !deleted); err != nil {
+			return nil, fmt.Errorf("failed updating the chat search index: %w", err)
+		}
+		return v, nil
+	})
+}
+
+// updateSearchIndex removes the chats from the search index, and indexes their current title and messages if reindex is set.
+func updateSearchIndex(ctx context.Context, client *entv1.Client, ids []uuid.UUID, reindex bool) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(ids)), ", ")
+	args := make([]any, 0, len(ids))
+	for _, id := range ids {
+		args = append(args, id)
+	}
+
+	if _, err := client.ExecContext(ctx, "DELETE FROM chat_search WHERE chat_id IN ("+placeholders+")", args...); err != nil {
+		return err
+	}
+	if !reindex {
+		return nil
+	}
+	_, err := client.ExecContext(ctx, indexChatsQuery+" WHERE id IN ("+placeholders+")", args...)
+	return err
+}
+
+// RebuildSearchIndex reindexes all chats, e.g., after an update of the index failed, and returns the number of indexed chats.
+func (h *Handler) RebuildSearchIndex(ctx context.Context) (int64, error) {
+	if !searchEnabled.Load() {
+		return 0, errSearchDisabled
+	}
+
+	tx, err := h.client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+	if _, err = tx.ExecContext(ctx, "DELETE FROM chat_search"); err != nil {
+		return 0, fmt.Errorf("failed clearing the chat search index: %w", err)
+	}
+	res, err := tx.ExecContext(ctx, indexChatsQuery)
+	if err != nil {
+		return 0, fmt.Errorf("failed indexing the chats: %w", err)
+	}
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// Search returns a page of the user's chats whose title or messages contain all terms of the query, best match first,
+// and the total number of matching chats.
+func (h *Handler) Search(ctx context.Context, user *entv1.User, query string, filter SearchFilter, page, limit int) ([]SearchResult, int, error) {
+	where, args, err := searchWhere(user, query, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var total int
+	if err = h.queryRow(ctx, "SELECT COUNT(*) "+where, args, &total); err != nil {
+		return nil, 0, fmt.Errorf("failed counting chats: %w", err)
+	}
+
+	rows, err := h.client.QueryContext(ctx, searchSelectQuery+where+" ORDER BY rank LIMIT ? OFFSET ?",
+		append(args, limit, (page-1)*limit)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed searching chats: %w", err)
+	}
+	defer rows.Close()
+
+	results := make([]SearchResult, 0, limit)
+	for rows.Next() {
+		var r SearchResult
+		var models []byte
+		if err = rows.Scan(&r.ID, &r.Title, &models, &r.CreatedAt, &r.TitleSnippet, &r.Snippet, &r.Rank); err != nil {
+			return nil, 0, err
+		}
+		if err = json.Unmarshal(models, &r.Models); err != nil {
+			return nil, 0, err
+		}
+		r.TitleSnippet = snippetMarks.Replace(html.EscapeString(r.TitleSnippet))
+		r.Snippet = snippetMarks.Replace(html.EscapeString(r.Snippet))
+		// bm25 is lower for better matches
+		r.Rank = -r.Rank
+		results = append(results, r)
+	}
+	return results, total, rows.Err()
+}
+
+func searchWhere(user *entv1.User, query string, filter SearchFilter) (string, []any, error) {
+	where := searchFromQuery
+	args := []any{matchQuery(query), user.ID}
+	if filter.Model != "" {
+		where += " AND EXISTS (SELECT 1 FROM json_each(chats.models) WHERE json_each.value = ?)"
+		args = append(args, filter.Model)
+	}
+	if filter.Tag != "" {
+		where += ` AND EXISTS (SELECT 1 FROM chat_tags JOIN tags ON tags.id = chat_tags.tag_id
+WHERE chat_tags.chat_id = chats.id AND tags.name = ?)`
+		args = append(args, strings.TrimSpace(filter.Tag))
+	}
+	if filter.From != "" {
+		from, err := time.ParseInLocation(time.DateOnly, filter.From, time.Local)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid from, must be a date, e.g., 2024-05-01")
+		}
+		where += " AND chats.created_at >= ?"
+		args = append(args, from)
+	}
+	if filter.To != "" {
+		to, err := time.ParseInLocation(time.DateOnly, filter.To, time.Local)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid to, must be a date, e.g., 2024-05-01")
+		}
+		where += " AND chats.created_at < ?"
+		args = append(args, to.AddDate(0, 0, 1))
+	}
+	return where, args, nil
+}
+
+// matchQuery turns the search terms into an FTS5 query matching all of them, the last one as a prefix
+// while typing. The terms are quoted so that they are matched literally rather than as FTS5 syntax.
+func matchQuery(query string) string {
+	terms := strings.Fields(query)
+	for i, term := range terms {
+		terms[i] = `"` + strings.ReplaceAll(term, `"`, `""`) + `"`
+	}
+	if len(terms) > 0 {
+		terms[len(terms)-1] += "*"
+	}
+	return strings.Join(terms, " ")
+}
+
+// queryRow scans the first row of the query result into dest.
+func (h *Handler) queryRow(ctx context.Context, query string, args []any, dest ...any) error {
+	rows, err := h.client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		if err = rows.Err(); err != nil {
+			return err
+		}
+		return sql.ErrNoRows
+	}
+	if err = rows.Scan(dest...); err != nil {
+		return err
+	}
+	return rows.Err()
+}
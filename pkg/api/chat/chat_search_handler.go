@@ -0,0 +1,91 @@
+package chat
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+// SearchChats returns a page of the session user's chats matching the q query, best match first,
+// filtered by the model, tag, from and to query.
+func (h *Handler) SearchChats(c *gin.Context) {
+	user, err := utils.GetSessionUser(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+	if !searchEnabled.Load() {
+		c.JSON(http.StatusNotImplemented, gin.H{"status": false, "error": errSearchDisabled.Error()})
+		return
+	}
+
+	query := c.Query("q")
+	if strings.TrimSpace(query) == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"status": false, "error": "q is required"})
+		return
+	}
+	page, limit := 1, defaultSearchLimit
+	if v := c.Query("page"); v != "" {
+		if page, err = strconv.Atoi(v); err != nil || page <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"status": false, "error": "invalid page"})
+			return
+		}
+	}
+	if v := c.Query("limit"); v != "" {
+		if limit, err = strconv.Atoi(v); err != nil || limit <= 0 || limit > maxSearchLimit {
+			c.JSON(http.StatusBadRequest, gin.H{"status": false, "error": fmt.Sprintf("invalid limit, must be between 1 and %d", maxSearchLimit)})
+			return
+		}
+	}
+
+	filter := SearchFilter{
+		Model: c.Query("model"),
+		Tag:   c.Query("tag"),
+		From:  c.Query("from"),
+		To:    c.Query("to"),
+	}
+	for name, v := range map[string]string{"from": filter.From, "to": filter.To} {
+		if _, err := time.Parse(time.DateOnly, v); v != "" && err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"status": false, "error": fmt.Sprintf("invalid %s, must be a date, e.g., 2024-05-01", name)})
+			return
+		}
+	}
+
+	results, total, err := h.Search(c.Request.Context(), user, query, filter, page, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"total":   total,
+		"page":    page,
+		"limit":   limit,
+		"results": results,
+	})
+}
+
+// RebuildChatSearch reindexes the chats of all users.
+func (h *Handler) RebuildChatSearch(c *gin.Context) {
+	if !searchEnabled.Load() {
+		c.JSON(http.StatusNotImplemented, gin.H{"status": false, "error": errSearchDisabled.Error()})
+		return
+	}
+
+	n, err := h.RebuildSearchIndex(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": true, "indexed": n})
+}
@@ -0,0 +1,125 @@
+package chat
+
+import (
+	"strings"
+	"testing"
+
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+)
+
+// newSearchTestHandler returns a handler with the search index, the tests are skipped unless
+// SQLite is built with FTS5, i.e., with the sqlite_fts5 build tag.
+func newSearchTestHandler(t *testing.T) *Handler {
+	t.Helper()
+	h := newTestHandler(t)
+	if err := h.InitSearch(); err != nil {
+		t.Fatal(err)
+	}
+	if !searchEnabled.Load() {
+		t.Skip("SQLite is built without FTS5")
+	}
+	return h
+}
+
+func TestSearchSnippetsAreEscaped(t *testing.T) {
+	h := newSearchTestHandler(t)
+	user := createTestUser(t, h, "alice", entv1User.RoleUser)
+	createTestChat(t, h, user, `<script>alert("title")</script> notes`,
+		`<img src=x onerror=alert(1)> the notes & more`)
+
+	results, total, err := h.Search(h.ctx, user, "notes", SearchFilter{}, 1, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 1 || len(results) != 1 {
+		t.Fatalf("found %d chats, want 1", total)
+	}
+
+	r := results[0]
+	wantTitle := `&lt;script&gt;alert(&#34;title&#34;)&lt;/script&gt; <mark>notes</mark>`
+	if r.TitleSnippet != wantTitle {
+		t.Errorf("title snippet = %q, want %q", r.TitleSnippet, wantTitle)
+	}
+	wantSnippet := `&lt;img src=x onerror=alert(1)&gt; the <mark>notes</mark> &amp; more`
+	if r.Snippet != wantSnippet {
+		t.Errorf("snippet = %q, want %q", r.Snippet, wantSnippet)
+	}
+}
+
+func TestSearchIndexFollowsChats(t *testing.T) {
+	h := newSearchTestHandler(t)
+	alice := createTestUser(t, h, "alice", entv1User.RoleUser)
+	bob := createTestUser(t, h, "bob", entv1User.RoleUser)
+	c := createTestChat(t, h, alice, "Holiday plans", "flights to lisbon")
+	createTestChat(t, h, bob, "Lisbon", "bob's lisbon chat")
+
+	search := func(query string) int {
+		t.Helper()
+		_, total, err := h.Search(h.ctx, alice, query, SearchFilter{}, 1, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return total
+	}
+	if n := search("lisb"); n != 1 {
+		t.Fatalf("prefix search found %d chats, want 1 of the user", n)
+	}
+
+	title := "Work trip"
+	if _, err := h.Update(h.ctx, alice, c.ID, UpdateChatRequest{Title: &title}); err != nil {
+		t.Fatal(err)
+	}
+	if n := search("holiday"); n != 0 {
+		t.Errorf("old title found in %d chats", n)
+	}
+	if n := search("trip"); n != 1 {
+		t.Errorf("new title found in %d chats, want 1", n)
+	}
+
+	if err := h.TrashByOwner(h.ctx, alice, c.ID); err != nil {
+		t.Fatal(err)
+	}
+	if n := search("trip"); n != 0 {
+		t.Errorf("trashed chat found in %d results", n)
+	}
+}
+
+func TestRebuildSearchIndex(t *testing.T) {
+	h := newSearchTestHandler(t)
+	user := createTestUser(t, h, "alice", entv1User.RoleUser)
+	createTestChat(t, h, user, "Recipes", "pancakes with syrup")
+	createTestChat(t, h, user, "Groceries", "flour and eggs")
+
+	if _, err := h.client.ExecContext(h.ctx, "DELETE FROM chat_search"); err != nil {
+		t.Fatal(err)
+	}
+	if _, total, _ := h.Search(h.ctx, user, "pancakes", SearchFilter{}, 1, 10); total != 0 {
+		t.Fatalf("found %d chats in the cleared index", total)
+	}
+
+	n, err := h.RebuildSearchIndex(h.ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Errorf("indexed %d chats, want 2", n)
+	}
+	if _, total, _ := h.Search(h.ctx, user, "pancakes", SearchFilter{}, 1, 10); total != 1 {
+		t.Errorf("found %d chats after the rebuild, want 1", total)
+	}
+}
+
+func TestSearchIndexErrorFailsMutation(t *testing.T) {
+	h := newSearchTestHandler(t)
+	user := createTestUser(t, h, "alice", entv1User.RoleUser)
+	c := createTestChat(t, h, user, "Recipes", "pancakes with syrup")
+
+	if _, err := h.client.ExecContext(h.ctx, "DROP TABLE chat_search"); err != nil {
+		t.Fatal(err)
+	}
+	title := "Desserts"
+	_, err := h.Update(h.ctx, user, c.ID, UpdateChatRequest{Title: &title})
+	if err == nil || !strings.Contains(err.Error(), "chat search index") {
+		t.Errorf("err = %v, want the index error", err)
+	}
+}
@@ -0,0 +1,174 @@
+package chat
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/chat"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/tag"
+)
+
+// errEmptyTagName is returned when a chat is tagged with a blank name.
+var errEmptyTagName = errors.New("tag name must not be empty")
+
+// TagCount is a tag of the user with the number of chats it is attached to.
+type TagCount struct {
+	ID    uuid.UUID `json:"id"`
+	Name  string    `json:"name"`
+	Count int       `json:"count"`
+}
+
+// ListTags returns the tags of the user with the number of their chats, trashed chats are not
+// counted and tags without chats are omitted.
+func (h *Handler) ListTags(ctx context.Context, user *entv1.User) ([]TagCount, error) {
+	tags, err := user.QueryTags().
+		WithChats(func(q *entv1.ChatQuery) {
+			q.Where(chat.DeletedAtIsNil()).Select(chat.FieldID)
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying tags: %w", err)
+	}
+
+	counts := make([]TagCount, 0, len(tags))
+	for _, t := range tags {
+		if len(t.Edges.Chats) == 0 {
+			continue
+		}
+		counts = append(counts, TagCount{ID: t.ID, Name: t.Name, Count: len(t.Edges.Chats)})
+	}
+	slices.SortFunc(counts, func(a, b TagCount) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return counts, nil
+}
+
+// ListByTag returns the summaries of the user's chats with the tag, most recently updated first.
+func (h *Handler) ListByTag(ctx context.Context, user *entv1.User, name string) ([]ChatSummary, error) {
+	chats, err := user.QueryChats().
+		Where(chat.DeletedAtIsNil(), chat.HasTagsWith(tag.UserId(user.ID), tag.Name(strings.TrimSpace(name)))).
+		Order(entv1.Desc(chat.FieldUpdatedAt), entv1.Desc(chat.FieldID)).
+		WithTags(func(q *entv1.TagQuery) {
+			q.Select(tag.FieldName)
+		}).
+		Select(chat.FieldTitle, chat.FieldModels, chat.FieldCreatedAt, chat.FieldUpdatedAt).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying chats by tag: %w", err)
+	}
+	return summaries(chats), nil
+}
+
+// ListChatTags returns the tags of a chat of the user, sorted by name.
+func (h *Handler) ListChatTags(ctx context.Context, user *entv1.User, id uuid.UUID) ([]*entv1.Tag, error) {
+	c, err := h.GetByOwner(ctx, user, id)
+	if err != nil {
+		return nil, err
+	}
+	return c.QueryTags().Order(entv1.Asc(tag.FieldName)).All(ctx)
+}
+
+// AddTag attaches the tag to a chat of the user, the tag is created if the user does not have it yet.
+func (h *Handler) AddTag(ctx context.Context, user *entv1.User, id uuid.UUID, name string) (*entv1.Tag, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, errEmptyTagName
+	}
+	c, err := h.GetByOwner(ctx, user, id)
+	if err != nil {
+		return nil, err
+	}
+	t, err := h.getOrCreateTag(ctx, user, name)
+	if err != nil {
+		return nil, err
+	}
+
+	tagged, err := c.QueryTags().Where(tag.ID(t.ID)).Exist(ctx)
+	if err != nil || tagged {
+		return t, err
+	}
+	if err = h.client.Chat.UpdateOne(c).AddTags(t).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed tagging chat: %w", err)
+	}
+	return t, nil
+}
+
+// RemoveTag detaches the tag from a chat of the user, the tag is deleted once no chat has it.
+func (h *Handler) RemoveTag(ctx context.Context, user *entv1.User, id uuid.UUID, name string) error {
+	c, err := h.GetByOwner(ctx, user, id)
+	if err != nil {
+		return err
+	}
+	t, err := c.QueryTags().Where(tag.Name(strings.TrimSpace(name))).Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = h.client.Chat.UpdateOne(c).RemoveTags(t).Exec(ctx); err != nil {
+		return fmt.Errorf("failed untagging chat: %w", err)
+	}
+	return h.deleteUnusedTags(ctx, user)
+}
+
+// RemoveAllTags detaches all tags from a chat of the user.
+func (h *Handler) RemoveAllTags(ctx context.Context, user *entv1.User, id uuid.UUID) error {
+	c, err := h.GetByOwner(ctx, user, id)
+	if err != nil {
+		return err
+	}
+	if err = h.client.Chat.UpdateOne(c).ClearTags().Exec(ctx); err != nil {
+		return fmt.Errorf("failed untagging chat: %w", err)
+	}
+	return h.deleteUnusedTags(ctx, user)
+}
+
+// getOrCreateTags returns the tags of the user with the names, creating the missing ones.
+func (h *Handler) getOrCreateTags(ctx context.Context, user *entv1.User, names []string) ([]*entv1.Tag, error) {
+	tags := make([]*entv1.Tag, 0, len(names))
+	for _, name := range names {
+		t, err := h.getOrCreateTag(ctx, user, name)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.ContainsFunc(tags, func(e *entv1.Tag) bool { return e.ID == t.ID }) {
+			tags = append(tags, t)
+		}
+	}
+	return tags, nil
+}
+
+func (h *Handler) getOrCreateTag(ctx context.Context, user *entv1.User, name string) (*entv1.Tag, error) {
+	name = strings.TrimSpace(name)
+	query := h.client.Tag.Query().Where(tag.UserId(user.ID), tag.Name(name))
+	t, err := query.Only(ctx)
+	if !entv1.IsNotFound(err) {
+		return t, err
+	}
+
+	t, err = h.client.Tag.Create().
+		SetName(name).
+		SetOwner(user).
+		Save(ctx)
+	if entv1.IsConstraintError(err) {
+		// created by a concurrent request
+		return query.Only(ctx)
+	}
+	return t, err
+}
+
+// deleteUnusedTags deletes the tags of the user that are not attached to any chat.
+func (h *Handler) deleteUnusedTags(ctx context.Context, user *entv1.User) error {
+	_, err := h.client.Tag.Delete().
+		Where(tag.UserId(user.ID), tag.Not(tag.HasChats())).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed deleting unused tags: %w", err)
+	}
+	return nil
+}
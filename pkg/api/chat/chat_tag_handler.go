@@ -0,0 +1,160 @@
+package chat
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
+)
+
+// ChatTagRequest names the tag to add to or remove from a chat, the chat is taken from the path.
+type ChatTagRequest struct {
+	TagName string `json:"tag_name" binding:"required"`
+}
+
+// ListAllChatTags returns the tags of the session user with the number of their chats.
+func (h *Handler) ListAllChatTags(c *gin.Context) {
+	user, err := utils.GetSessionUser(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+
+	tags, err := h.ListTags(c.Request.Context(), user)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, tags)
+}
+
+func (h *Handler) ListChatsByTag(c *gin.Context) {
+	user, err := utils.GetSessionUser(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+
+	chats, err := h.ListByTag(c.Request.Context(), user, c.Param("name"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, chats)
+}
+
+func (h *Handler) GetChatTagsByID(c *gin.Context) {
+	user, err := utils.GetSessionUser(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": false, "error": "invalid chat id"})
+		return
+	}
+
+	tags, err := h.ListChatTags(c.Request.Context(), user, id)
+	if entv1.IsNotFound(err) {
+		c.JSON(http.StatusNotFound, gin.H{"status": false, "error": "chat not found"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, tags)
+}
+
+func (h *Handler) AddChatTagByID(c *gin.Context) {
+	user, err := utils.GetSessionUser(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": false, "error": "invalid chat id"})
+		return
+	}
+	var req ChatTagRequest
+	if err = c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+
+	tag, err := h.AddTag(c.Request.Context(), user, id, req.TagName)
+	if entv1.IsNotFound(err) {
+		c.JSON(http.StatusNotFound, gin.H{"status": false, "error": "chat not found"})
+		return
+	}
+	if entv1.IsValidationError(err) || errors.Is(err, errEmptyTagName) {
+		c.JSON(http.StatusBadRequest, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, tag)
+}
+
+func (h *Handler) DeleteChatTagByID(c *gin.Context) {
+	user, err := utils.GetSessionUser(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": false, "error": "invalid chat id"})
+		return
+	}
+	var req ChatTagRequest
+	if err = c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+
+	if err = h.RemoveTag(c.Request.Context(), user, id, req.TagName); err != nil {
+		if entv1.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"status": false, "error": "chat or tag not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": true})
+}
+
+func (h *Handler) DeleteAllChatTagsByID(c *gin.Context) {
+	user, err := utils.GetSessionUser(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": false, "error": "invalid chat id"})
+		return
+	}
+
+	if err = h.RemoveAllTags(c.Request.Context(), user, id); err != nil {
+		if entv1.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"status": false, "error": "chat not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": true})
+}
@@ -0,0 +1,38 @@
+package chat
+
+import (
+	"net/http"
+	"testing"
+
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+)
+
+func TestAddChatTagRejectsBlankName(t *testing.T) {
+	h := newTestHandler(t)
+	alice := createTestUser(t, h, "alice", entv1User.RoleUser)
+	c := createTestChat(t, h, alice, "plans", "weekend plans")
+
+	for _, name := range []string{" ", "\t\n"} {
+		w := serve(t, alice, http.MethodPost, "/chats/:id/tags", "/chats/"+c.ID.String()+"/tags",
+			ChatTagRequest{TagName: name}, h.AddChatTagByID)
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("tag %q: status = %d, want %d", name, w.Code, http.StatusBadRequest)
+		}
+	}
+	if n := h.client.Tag.Query().CountX(h.ctx); n != 0 {
+		t.Errorf("%d tags created", n)
+	}
+
+	w := serve(t, alice, http.MethodPost, "/chats/:id/tags", "/chats/"+c.ID.String()+"/tags",
+		ChatTagRequest{TagName: " work "}, h.AddChatTagByID)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+	}
+	tags, err := h.ListChatTags(h.ctx, alice, c.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tags) != 1 || tags[0].Name != "work" {
+		t.Errorf("chat tags = %v, want [work]", tags)
+	}
+}
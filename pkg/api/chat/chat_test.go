@@ -0,0 +1,77 @@
+package chat
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/chat"
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+)
+
+func TestGetUserChatsPagesChatsWithEqualUpdateTime(t *testing.T) {
+	h := newTestHandler(t)
+	alice := createTestUser(t, h, "alice", entv1User.RoleUser)
+	bob := createTestUser(t, h, "bob", entv1User.RoleUser)
+
+	updatedAt := time.Now().Add(-time.Hour).Truncate(time.Microsecond)
+	want := make([]uuid.UUID, 0, 6)
+	latest := createTestChat(t, h, alice, "latest", "hello")
+	want = append(want, latest.ID)
+	for _, title := range []string{"a", "b", "c", "d", "e"} {
+		createTestChat(t, h, alice, title, "hello")
+	}
+	createTestChat(t, h, bob, "other", "hello")
+	h.client.Chat.Update().
+		Where(chat.UserId(alice.ID), chat.IDNEQ(latest.ID)).
+		SetUpdatedAt(updatedAt).
+		ExecX(h.ctx)
+	// the chats updated at the same time are listed by descending id
+	want = append(want, h.client.Chat.Query().
+		Where(chat.UserId(alice.ID), chat.IDNEQ(latest.ID)).
+		Order(entv1.Desc(chat.FieldID)).
+		IDsX(h.ctx)...)
+
+	var got []uuid.UUID
+	cursor := ""
+	for page := 0; ; page++ {
+		if page > len(want) {
+			t.Fatal("paging does not end")
+		}
+		path := "/chats/?limit=2"
+		if cursor != "" {
+			path += "&cursor=" + cursor
+		}
+		w := serve(t, alice, http.MethodGet, "/chats/", path, nil, h.GetUserChats)
+		if w.Code != http.StatusOK {
+			t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+		}
+		var res struct {
+			Chats      []ChatSummary `json:"chats"`
+			NextCursor string        `json:"nextCursor"`
+		}
+		if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+			t.Fatal(err)
+		}
+		for _, c := range res.Chats {
+			got = append(got, c.ID)
+		}
+		if res.NextCursor == "" {
+			break
+		}
+		cursor = res.NextCursor
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("listed %d chats, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("chat %d = %s, want %s\ngot  %v\nwant %v", i, got[i], want[i], got, want)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package chat
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/llmos-ai/llmos-dashboard/pkg/constant"
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
+)
+
+// denyChatDeletion responds with 403 and returns true if the user may not delete chats,
+// only admins can delete chats while allow-chat-deletion is disabled.
+func denyChatDeletion(c *gin.Context, user *entv1.User) bool {
+	if user.Role == entv1User.RoleAdmin || settings.AllowChatDelete.Get() == "true" {
+		return false
+	}
+	c.JSON(http.StatusForbidden, gin.H{"status": false, "error": constant.MessageErrorChatDeletion})
+	return true
+}
+
+func (h *Handler) ListTrashedChats(c *gin.Context) {
+	user, err := utils.GetSessionUser(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+
+	chats, err := h.ListTrash(c.Request.Context(), user)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, chats)
+}
+
+func (h *Handler) RestoreChatByID(c *gin.Context) {
+	user, err := utils.GetSessionUser(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": false, "error": "invalid chat id"})
+		return
+	}
+
+	chat, err := h.RestoreByOwner(c.Request.Context(), user, id)
+	if entv1.IsNotFound(err) {
+		c.JSON(http.StatusNotFound, gin.H{"status": false, "error": "chat not found in the trash"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, chat)
+}
+
+// PurgeChatByID permanently deletes a chat from the trash.
+func (h *Handler) PurgeChatByID(c *gin.Context) {
+	user, err := utils.GetSessionUser(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+	if denyChatDeletion(c, user) {
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": false, "error": "invalid chat id"})
+		return
+	}
+
+	if err = h.PurgeByOwner(c.Request.Context(), user, id); err != nil {
+		if entv1.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"status": false, "error": "chat not found in the trash"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": true})
+}
+
+// EmptyChatTrash permanently deletes all chats in the trash of the session user.
+func (h *Handler) EmptyChatTrash(c *gin.Context) {
+	user, err := utils.GetSessionUser(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+	if denyChatDeletion(c, user) {
+		return
+	}
+
+	n, err := h.EmptyTrash(c.Request.Context(), user)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": false, "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": true, "count": n})
+}
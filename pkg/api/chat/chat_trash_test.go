@@ -0,0 +1,115 @@
+package chat
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"testing"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/tag"
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+)
+
+func tagNames(t *testing.T, h *Handler, user *entv1.User) []string {
+	t.Helper()
+	return h.client.Tag.Query().
+		Where(tag.UserId(user.ID)).
+		Order(entv1.Asc(tag.FieldName)).
+		Select(tag.FieldName).
+		StringsX(h.ctx)
+}
+
+func TestListTrash(t *testing.T) {
+	h := newTestHandler(t)
+	alice := createTestUser(t, h, "alice", entv1User.RoleUser)
+	trashed := createTestChat(t, h, alice, "old plans", "secret plans", "work", "home")
+	createTestChat(t, h, alice, "current plans", "new plans")
+	if err := h.TrashByOwner(h.ctx, alice, trashed.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	w := serve(t, alice, http.MethodGet, "/chats/trash", "/chats/trash", nil, h.ListTrashedChats)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+	var list []map[string]any
+	if err := json.Unmarshal(w.Body.Bytes(), &list); err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 {
+		t.Fatalf("%d chats in the trash, want 1", len(list))
+	}
+	got := list[0]
+	if got["id"] != trashed.ID.String() || got["title"] != "old plans" || got["deletedAt"] == nil {
+		t.Errorf("trashed chat = %v", got)
+	}
+	if tags, _ := got["tags"].([]any); len(tags) != 2 || tags[0] != "home" || tags[1] != "work" {
+		t.Errorf("tags = %v, want [home work]", got["tags"])
+	}
+	for _, field := range []string{"messages", "history", "chat"} {
+		if _, ok := got[field]; ok {
+			t.Errorf("trash listing includes the chat %s", field)
+		}
+	}
+}
+
+func TestPurgeDeletesUnusedTags(t *testing.T) {
+	tests := []struct {
+		name  string
+		purge func(h *Handler, user *entv1.User, trashed *entv1.Chat) error
+	}{
+		{"purge one chat", func(h *Handler, user *entv1.User, trashed *entv1.Chat) error {
+			return h.PurgeByOwner(h.ctx, user, trashed.ID)
+		}},
+		{"empty trash", func(h *Handler, user *entv1.User, _ *entv1.Chat) error {
+			_, err := h.EmptyTrash(h.ctx, user)
+			return err
+		}},
+		{"admin delete", func(h *Handler, _ *entv1.User, trashed *entv1.Chat) error {
+			return h.Delete(h.ctx, trashed.ID)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newTestHandler(t)
+			alice := createTestUser(t, h, "alice", entv1User.RoleUser)
+			trashed := createTestChat(t, h, alice, "old plans", "secret plans", "work", "archive")
+			createTestChat(t, h, alice, "current plans", "new plans", "work")
+			if err := h.TrashByOwner(h.ctx, alice, trashed.ID); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := tt.purge(h, alice, trashed); err != nil {
+				t.Fatal(err)
+			}
+			if h.client.Chat.Query().CountX(h.ctx) != 1 {
+				t.Error("trashed chat is not deleted")
+			}
+			if got := tagNames(t, h, alice); !slices.Equal(got, []string{"work"}) {
+				t.Errorf("tags = %v, want [work]", got)
+			}
+		})
+	}
+}
+
+func TestPurgeByOwnerNotFound(t *testing.T) {
+	h := newTestHandler(t)
+	alice := createTestUser(t, h, "alice", entv1User.RoleUser)
+	bob := createTestUser(t, h, "bob", entv1User.RoleUser)
+	active := createTestChat(t, h, alice, "current plans", "new plans")
+	trashed := createTestChat(t, h, alice, "old plans", "secret plans")
+	if err := h.TrashByOwner(h.ctx, alice, trashed.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := h.PurgeByOwner(h.ctx, alice, active.ID); !entv1.IsNotFound(err) {
+		t.Errorf("purging a chat not in the trash: err = %v, want not found", err)
+	}
+	if err := h.PurgeByOwner(h.ctx, bob, trashed.ID); !entv1.IsNotFound(err) {
+		t.Errorf("purging a chat of another user: err = %v, want not found", err)
+	}
+	if n := h.client.Chat.Query().CountX(h.ctx); n != 2 {
+		t.Errorf("%d chats left, want 2", n)
+	}
+}
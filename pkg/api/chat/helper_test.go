@@ -0,0 +1,81 @@
+package chat
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/gin-gonic/gin"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/enttest"
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+	v1 "github.com/llmos-ai/llmos-dashboard/pkg/types/v1"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+// newTestHandler returns a handler backed by a fresh in-memory database.
+func newTestHandler(t *testing.T) *Handler {
+	t.Helper()
+	client := enttest.Open(t, dialect.SQLite, "file:"+url.PathEscape(t.Name())+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	h := NewHandler(client, context.Background())
+	return &h
+}
+
+func createTestUser(t *testing.T, h *Handler, name string, role entv1User.Role) *entv1.User {
+	t.Helper()
+	return h.client.User.Create().
+		SetName(name).
+		SetEmail(name + "@example.com").
+		SetPassword("not a hash").
+		SetRole(role).
+		SaveX(h.ctx)
+}
+
+// createTestChat creates a chat of the user with the title, a message of the content and the tags.
+func createTestChat(t *testing.T, h *Handler, owner *entv1.User, title, content string, tags ...string) *entv1.Chat {
+	t.Helper()
+	c, err := h.Create(h.ctx, owner, NewChatRequest{
+		Title:    title,
+		Models:   []string{"llama3:latest"},
+		History:  v1.Histroy{},
+		Messages: []v1.Message{{ID: "1", Role: "user", Content: content}},
+		Tags:     tags,
+	})
+	if err != nil {
+		t.Fatalf("failed to create chat %s: %v", title, err)
+	}
+	return c
+}
+
+// serve sends a request as the user to the handler registered at the route and returns the recorded response.
+func serve(t *testing.T, user *entv1.User, method, route, path string, body any, handler gin.HandlerFunc) *httptest.ResponseRecorder {
+	t.Helper()
+	var buf bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&buf).Encode(body); err != nil {
+			t.Fatal(err)
+		}
+	}
+	r := gin.New()
+	r.Handle(method, route, func(c *gin.Context) {
+		if user != nil {
+			c.Set("user", user)
+		}
+	}, handler)
+	req := httptest.NewRequest(method, path, &buf)
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
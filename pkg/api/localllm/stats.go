@@ -0,0 +1,128 @@
+package localllm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxLineSize bounds the buffered response line, a larger line is not parsed.
+const maxLineSize = 4 << 20
+
+// Stats are the statistics of an ollama generate or chat request, sent with its final response chunk.
+// Durations are in nanoseconds.
+type Stats struct {
+	Model              string `json:"model"`
+	Done               bool   `json:"done"`
+	TotalDuration      int64  `json:"total_duration"`
+	LoadDuration       int64  `json:"load_duration"`
+	PromptEvalCount    int    `json:"prompt_eval_count"`
+	PromptEvalDuration int64  `json:"prompt_eval_duration"`
+	EvalCount          int    `json:"eval_count"`
+	EvalDuration       int64  `json:"eval_duration"`
+}
+
+func (s *Stats) Tokens() int {
+	return s.PromptEvalCount + s.EvalCount
+}
+
+// StatsWriter passes an ollama response through and picks the statistics out of its
+// final chunk, both streamed NDJSON and single JSON responses are supported.
+type StatsWriter struct {
+	gin.ResponseWriter
+	line  []byte
+	skip  bool
+	stats *Stats
+}
+
+func NewStatsWriter(w gin.ResponseWriter) *StatsWriter {
+	return &StatsWriter{ResponseWriter: w}
+}
+
+// CaptureStats passes the response of the request through a StatsWriter, which is shared
+// by all middlewares of the request that need the statistics.
+func CaptureStats(c *gin.Context) *StatsWriter {
+	if w, ok := c.Writer.(*StatsWriter); ok {
+		return w
+	}
+	w := NewStatsWriter(c.Writer)
+	c.Writer = w
+	return w
+}
+
+func (w *StatsWriter) Write(b []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(b)
+	w.scan(b[:n])
+	return n, err
+}
+
+func (w *StatsWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+func (w *StatsWriter) scan(b []byte) {
+	for len(b) > 0 {
+		i := bytes.IndexByte(b, '\n')
+		if i < 0 {
+			w.buffer(b)
+			return
+		}
+		w.buffer(b[:i])
+		w.parse()
+		b = b[i+1:]
+	}
+}
+
+func (w *StatsWriter) buffer(b []byte) {
+	if w.skip || len(w.line)+len(b) > maxLineSize {
+		w.skip = true
+		return
+	}
+	w.line = append(w.line, b...)
+}
+
+func (w *StatsWriter) parse() {
+	line := bytes.TrimSpace(w.line)
+	if !w.skip && len(line) > 0 {
+		var s Stats
+		if err := json.Unmarshal(line, &s); err == nil && s.Done {
+			w.stats = &s
+		}
+	}
+	w.line = w.line[:0]
+	w.skip = false
+}
+
+// Stats returns the statistics of the response, or nil if the response had no final chunk,
+// e.g., the request failed or was canceled.
+func (w *StatsWriter) Stats() *Stats {
+	if len(w.line) > 0 {
+		w.parse()
+	}
+	return w.stats
+}
+
+// RequestModel returns the model of an ollama request and restores the body for the proxy.
+func RequestModel(c *gin.Context) (string, error) {
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return "", err
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	var req struct {
+		Model string `json:"model"`
+		Name  string `json:"name"`
+	}
+	if err = json.Unmarshal(body, &req); err != nil {
+		return "", fmt.Errorf("invalid request body: %w", err)
+	}
+	// pull requests of older ollama versions name the model by name
+	if req.Model == "" {
+		return req.Name, nil
+	}
+	return req.Model, nil
+}
@@ -0,0 +1,113 @@
+package localllm
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+const (
+	chunk     = `{"model":"llama3:latest","message":{"content":"hi"},"done":false}`
+	lastChunk = `{"model":"llama3:latest","done":true,"total_duration":900,"load_duration":100,` +
+		`"prompt_eval_count":12,"prompt_eval_duration":200,"eval_count":34,"eval_duration":600}`
+)
+
+var wantStats = Stats{
+	Model:              "llama3:latest",
+	Done:               true,
+	TotalDuration:      900,
+	LoadDuration:       100,
+	PromptEvalCount:    12,
+	PromptEvalDuration: 200,
+	EvalCount:          34,
+	EvalDuration:       600,
+}
+
+func TestCaptureStats(t *testing.T) {
+	tests := []struct {
+		name string
+		// writes of the response body
+		writes []string
+		want   *Stats
+	}{
+		{
+			name:   "streamed",
+			writes: []string{chunk + "\n", chunk + "\n", lastChunk + "\n"},
+			want:   &wantStats,
+		},
+		{
+			name:   "streamed lines split across writes",
+			writes: []string{chunk + "\n" + lastChunk[:20], lastChunk[20:50], lastChunk[50:] + "\n"},
+			want:   &wantStats,
+		},
+		{
+			name:   "single response without a trailing newline",
+			writes: []string{lastChunk},
+			want:   &wantStats,
+		},
+		{
+			name:   "canceled before the final chunk",
+			writes: []string{chunk + "\n", chunk + "\n"},
+		},
+		{
+			name:   "error response",
+			writes: []string{`{"error":"model 'llama3:latest' not found"}`},
+		},
+		{
+			name:   "oversized line",
+			writes: []string{`{"done":true,"eval_count":1,"padding":"` + strings.Repeat("x", maxLineSize) + `"}` + "\n"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var w *StatsWriter
+			r := gin.New()
+			r.POST("/api/chat", func(c *gin.Context) {
+				w = CaptureStats(c)
+				c.Next()
+			}, func(c *gin.Context) {
+				c.Status(http.StatusOK)
+				for _, s := range tt.writes {
+					_, _ = c.Writer.WriteString(s)
+				}
+			})
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/chat", nil))
+
+			if got, want := rec.Body.String(), strings.Join(tt.writes, ""); got != want {
+				t.Errorf("response body changed by the writer")
+			}
+			got := w.Stats()
+			if (got == nil) != (tt.want == nil) || got != nil && *got != *tt.want {
+				t.Errorf("stats = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCaptureStatsShared(t *testing.T) {
+	var writers []*StatsWriter
+	capture := func(c *gin.Context) {
+		writers = append(writers, CaptureStats(c))
+		c.Next()
+	}
+	r := gin.New()
+	r.POST("/api/chat", capture, capture, func(c *gin.Context) {
+		_, _ = c.Writer.WriteString(lastChunk + "\n")
+	})
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/api/chat", nil))
+
+	if len(writers) != 2 || writers[0] != writers[1] {
+		t.Fatalf("middlewares of the request got different writers")
+	}
+	if got := writers[0].Stats(); got == nil || got.Tokens() != 46 {
+		t.Errorf("stats = %+v, want 46 tokens", got)
+	}
+}
@@ -23,51 +23,51 @@ func NewHandler(c *entv1.Client, ctx context.Context) Handler {
 	}
 }
 
-func (h *Handler) GetModelFiles() (entv1.Modelfiles, error) {
-	modelfiles, err := h.client.Modelfile.Query().All(h.ctx)
+func (h *Handler) GetModelFiles(ctx context.Context) (entv1.Modelfiles, error) {
+	modelfiles, err := h.client.Modelfile.Query().All(ctx)
 	if err != nil {
 		return nil, err
 	}
-	slog.Debug("get model files: ", modelfiles)
+	slog.Debug("get model files", "count", len(modelfiles))
 	return modelfiles, nil
 }
 
-func (h *Handler) Create(user *entv1.User, req ModelFileRequest, mf string) (*entv1.Modelfile, error) {
+func (h *Handler) Create(ctx context.Context, user *entv1.User, req ModelFileRequest, mf string) (*entv1.Modelfile, error) {
 	modelfile, err := h.client.Modelfile.
 		Create().
 		SetOwner(user).
 		SetTagName(req.TagName).
 		SetModelfile(mf).
-		Save(h.ctx)
+		Save(ctx)
 	if err != nil {
 		return nil, err
 	}
-	slog.Debug("modelfile created successfully", mf)
+	slog.Debug("modelfile created successfully", "modelfile", modelfile.ID)
 	return modelfile, nil
 }
 
-func (h *Handler) Update(update ModelFileUpdate, modelfile string) (*entv1.Modelfile, error) {
+func (h *Handler) Update(ctx context.Context, update ModelFileUpdate, modelfile string) (*entv1.Modelfile, error) {
 	mf, err := h.client.Modelfile.
 		UpdateOneID(update.Id).
 		SetTagName(update.TagName).
 		SetModelfile(modelfile).
-		Save(h.ctx)
+		Save(ctx)
 	if err != nil {
 		return nil, err
 	}
-	slog.Debug("modelfile updated successfully", mf)
+	slog.Debug("modelfile updated successfully", "modelfile", mf.ID)
 	return mf, nil
 }
 
-func (h *Handler) DeleteByUser(id uuid.UUID, userId uuid.UUID) error {
+func (h *Handler) DeleteByUser(ctx context.Context, id uuid.UUID, userId uuid.UUID) error {
 	return h.client.Modelfile.
 		DeleteOneID(id).
 		Where(modelfile.UserId(userId)).
-		Exec(h.ctx)
+		Exec(ctx)
 }
 
-func (h *Handler) DeleteByID(id uuid.UUID) error {
+func (h *Handler) DeleteByID(ctx context.Context, id uuid.UUID) error {
 	return h.client.Modelfile.
 		DeleteOneID(id).
-		Exec(h.ctx)
+		Exec(ctx)
 }
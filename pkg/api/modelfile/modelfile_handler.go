@@ -67,7 +67,7 @@ func (h *Handler) CreateModelFile(c *gin.Context) {
 	var req ModelFileRequest
 	err = json.Unmarshal(data, &req)
 	if err != nil {
-		slog.Error("failed to parse chat obj", err)
+		slog.Error("failed to parse chat obj", "error", err)
 		c.JSON(http.StatusBadRequest, err.Error())
 		return
 	}
@@ -78,7 +78,7 @@ func (h *Handler) CreateModelFile(c *gin.Context) {
 		return
 	}
 
-	newMF, err := h.Create(user, req, string(mf))
+	newMF, err := h.Create(c.Request.Context(), user, req, string(mf))
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, err.Error())
 		return
@@ -88,7 +88,7 @@ func (h *Handler) CreateModelFile(c *gin.Context) {
 }
 
 func (h *Handler) ListModelFile(c *gin.Context) {
-	modelfiles, err := h.GetModelFiles()
+	modelfiles, err := h.GetModelFiles(c.Request.Context())
 	if err != nil {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, err.Error())
 	}
@@ -99,7 +99,7 @@ func (h *Handler) ListModelFile(c *gin.Context) {
 		var modelfile Modelfile
 		err = json.Unmarshal([]byte(mf.Modelfile), &modelfile)
 		if err != nil {
-			slog.Error("failed to parse modelfile obj", err)
+			slog.Error("failed to parse modelfile obj", "error", err)
 			c.AbortWithStatusJSON(http.StatusBadRequest, err.Error())
 			return
 		}
@@ -124,7 +124,7 @@ func (h *Handler) DeleteModelFile(c *gin.Context) {
 		return
 	}
 
-	err = h.DeleteByID(uid)
+	err = h.DeleteByID(c.Request.Context(), uid)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, err.Error())
 		return
@@ -148,7 +148,7 @@ func (h *Handler) GetModelFileByTagName(c *gin.Context) {
 	}
 
 	mfs, err := h.client.Modelfile.Query().
-		Where(modelfile.TagName(req.TagName)).Only(h.ctx)
+		Where(modelfile.TagName(req.TagName)).Only(c.Request.Context())
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, err.Error())
 		return
@@ -166,7 +166,7 @@ func (h *Handler) UpdateModelFile(c *gin.Context) {
 	var req ModelFileUpdate
 	err = json.Unmarshal(data, &req)
 	if err != nil {
-		slog.Error("failed to parse chat obj", err)
+		slog.Error("failed to parse chat obj", "error", err)
 		c.JSON(http.StatusBadRequest, err.Error())
 		return
 	}
@@ -177,7 +177,7 @@ func (h *Handler) UpdateModelFile(c *gin.Context) {
 		return
 	}
 
-	updatedMF, err := h.Update(req, string(mf))
+	updatedMF, err := h.Update(c.Request.Context(), req, string(mf))
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, err.Error())
 		return
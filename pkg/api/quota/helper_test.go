@@ -0,0 +1,91 @@
+package quota
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/gin-gonic/gin"
+	_ "github.com/mattn/go-sqlite3"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/enttest"
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+// newTestHandler returns a handler backed by a fresh in-memory database and a fresh limiter.
+func newTestHandler(t *testing.T) *Handler {
+	t.Helper()
+	client := enttest.Open(t, dialect.SQLite, "file:"+url.PathEscape(t.Name())+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	prev := limiter
+	limiter = newLimiter()
+	t.Cleanup(func() {
+		limiter = prev
+	})
+	h := NewHandler(client, context.Background())
+	return &h
+}
+
+func createTestUser(t *testing.T, h *Handler, name string, role entv1User.Role) *entv1.User {
+	t.Helper()
+	return h.client.User.Create().
+		SetName(name).
+		SetEmail(name + "@example.com").
+		SetPassword("not a hash").
+		SetRole(role).
+		SaveX(h.ctx)
+}
+
+// setSetting changes a setting for the duration of the test.
+func setSetting(t *testing.T, s settings.Setting, value string) {
+	t.Helper()
+	prev := s.Get()
+	if err := s.Set(value); err != nil {
+		t.Fatalf("failed to set %s: %v", s.Name, err)
+	}
+	t.Cleanup(func() {
+		_ = s.Set(prev)
+	})
+}
+
+// setClock stops the clock of the quotas at start for the duration of the test,
+// the clock is moved by changing the returned time.
+func setClock(t *testing.T, start time.Time) *time.Time {
+	t.Helper()
+	now := start
+	timeNow = func() time.Time {
+		return now
+	}
+	t.Cleanup(func() {
+		timeNow = time.Now
+	})
+	return &now
+}
+
+func intPtr(v int) *int {
+	return &v
+}
+
+// serveLLM sends an LLM request of the user through the quota middleware to the handler.
+func serveLLM(t *testing.T, h *Handler, user *entv1.User, handler gin.HandlerFunc) *httptest.ResponseRecorder {
+	t.Helper()
+	r := gin.New()
+	r.POST("/api/chat", func(c *gin.Context) {
+		c.Set("user", user)
+	}, h.Middleware, handler)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/chat", nil))
+	return w
+}
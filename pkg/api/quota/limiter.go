@@ -0,0 +1,152 @@
+package quota
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// limiter is shared by all handlers, the usage of the users is kept in memory as the dashboard
+// runs as a single instance. The daily token usage is loaded from the usage records once a day.
+var limiter = newLimiter()
+
+// timeNow is the clock the quotas are enforced by, tests replace it.
+var timeNow = time.Now
+
+// QuotaExceededError is returned when a request would exceed a quota of the user.
+type QuotaExceededError struct {
+	Limit      string
+	RetryAfter time.Duration
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("%s quota exceeded, retry in %s", e.Limit, e.RetryAfter.Round(time.Second))
+}
+
+// Usage is the current usage of a user.
+type Usage struct {
+	RequestsLastMinute int `json:"requestsLastMinute"`
+	ActiveStreams      int `json:"activeStreams"`
+	TokensToday        int `json:"tokensToday"`
+}
+
+type userUsage struct {
+	// start times of the requests within the last minute, oldest first
+	requests []time.Time
+	streams  int
+	day      string
+	tokens   int
+	// whether the tokens of the day were loaded from the usage records
+	loaded bool
+}
+
+type usageLimiter struct {
+	mu    sync.Mutex
+	users map[uuid.UUID]*userUsage
+}
+
+func newLimiter() *usageLimiter {
+	return &usageLimiter{users: map[uuid.UUID]*userUsage{}}
+}
+
+// get returns the usage of the user with the requests older than a minute and the tokens
+// of past days dropped, the caller must hold the lock.
+func (l *usageLimiter) get(id uuid.UUID, now time.Time) *userUsage {
+	u, ok := l.users[id]
+	if !ok {
+		u = &userUsage{}
+		l.users[id] = u
+	}
+
+	cutoff := now.Add(-time.Minute)
+	i := 0
+	for i < len(u.requests) && !u.requests[i].After(cutoff) {
+		i++
+	}
+	u.requests = u.requests[i:]
+
+	if day := now.UTC().Format(time.DateOnly); u.day != day {
+		u.day = day
+		u.tokens = 0
+		u.loaded = false
+	}
+	return u
+}
+
+// acquire starts a request of the user if it is within the limits, the returned release
+// function must be called once the request has finished.
+func (l *usageLimiter) acquire(id uuid.UUID, limits Limits, now time.Time) (func(), error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	u := l.get(id, now)
+	if limits.DailyTokens > 0 && u.tokens >= limits.DailyTokens {
+		midnight := now.UTC().Truncate(24 * time.Hour).Add(24 * time.Hour)
+		return nil, &QuotaExceededError{Limit: "daily tokens", RetryAfter: midnight.Sub(now)}
+	}
+	if limits.RequestsPerMinute > 0 && len(u.requests) >= limits.RequestsPerMinute {
+		oldest := u.requests[len(u.requests)-limits.RequestsPerMinute]
+		return nil, &QuotaExceededError{Limit: "requests per minute", RetryAfter: oldest.Add(time.Minute).Sub(now)}
+	}
+	if limits.ConcurrentStreams > 0 && u.streams >= limits.ConcurrentStreams {
+		return nil, &QuotaExceededError{Limit: "concurrent streams", RetryAfter: time.Second}
+	}
+
+	u.requests = append(u.requests, now)
+	u.streams++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			l.users[id].streams--
+		})
+	}, nil
+}
+
+// loadTokens sets the daily token usage of the user from the usage records, once a day
+// so that the budget is kept across restarts.
+func (l *usageLimiter) loadTokens(id uuid.UUID, now time.Time, load func(day string) (int, error)) error {
+	l.mu.Lock()
+	u := l.get(id, now)
+	day, loaded := u.day, u.loaded
+	l.mu.Unlock()
+	if loaded {
+		return nil
+	}
+
+	tokens, err := load(day)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if u = l.get(id, now); u.day == day && !u.loaded {
+		// the tokens charged meanwhile are also in the records
+		u.tokens = max(u.tokens, tokens)
+		u.loaded = true
+	}
+	return nil
+}
+
+// addTokens charges the tokens of a finished request to the daily budget of the user.
+func (l *usageLimiter) addTokens(id uuid.UUID, tokens int, now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.get(id, now).tokens += tokens
+}
+
+func (l *usageLimiter) usage(id uuid.UUID, now time.Time) Usage {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	u := l.get(id, now)
+	return Usage{
+		RequestsLastMinute: len(u.requests),
+		ActiveStreams:      u.streams,
+		TokensToday:        u.tokens,
+	}
+}
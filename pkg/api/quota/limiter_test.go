@@ -0,0 +1,140 @@
+package quota
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestLimiterRequestsPerMinute(t *testing.T) {
+	l := newLimiter()
+	id := uuid.New()
+	limits := Limits{RequestsPerMinute: 2}
+	start := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	for _, offset := range []time.Duration{0, 10 * time.Second} {
+		release, err := l.acquire(id, limits, start.Add(offset))
+		if err != nil {
+			t.Fatalf("request at +%s: %v", offset, err)
+		}
+		release()
+	}
+
+	_, err := l.acquire(id, limits, start.Add(20*time.Second))
+	var exceeded *QuotaExceededError
+	if !errors.As(err, &exceeded) {
+		t.Fatalf("third request within the minute: err = %v, want a quota error", err)
+	}
+	if exceeded.Limit != "requests per minute" || exceeded.RetryAfter != 40*time.Second {
+		t.Errorf("exceeded %s, retry after %s, want requests per minute, 40s", exceeded.Limit, exceeded.RetryAfter)
+	}
+
+	// the first request leaves the window a minute after it started
+	release, err := l.acquire(id, limits, start.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("request after the window: %v", err)
+	}
+	release()
+	if got := l.usage(id, start.Add(time.Minute)).RequestsLastMinute; got != 2 {
+		t.Errorf("requests in the last minute = %d, want 2", got)
+	}
+}
+
+func TestLimiterConcurrentStreams(t *testing.T) {
+	l := newLimiter()
+	id := uuid.New()
+	limits := Limits{ConcurrentStreams: 1}
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	release, err := l.acquire(id, limits, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = l.acquire(id, limits, now); err == nil {
+		t.Fatal("second stream started while the first is active")
+	}
+
+	// releasing twice must not free a stream of another request
+	release()
+	release()
+	if got := l.usage(id, now).ActiveStreams; got != 0 {
+		t.Errorf("active streams = %d, want 0", got)
+	}
+	if _, err = l.acquire(id, limits, now); err != nil {
+		t.Errorf("stream after release: %v", err)
+	}
+}
+
+func TestLimiterDailyTokens(t *testing.T) {
+	l := newLimiter()
+	id := uuid.New()
+	limits := Limits{DailyTokens: 1000}
+	now := time.Date(2024, 5, 1, 23, 0, 0, 0, time.UTC)
+
+	loads := map[string]int{}
+	load := func(day string) (int, error) {
+		loads[day]++
+		if day == "2024-05-01" {
+			return 900, nil
+		}
+		return 0, nil
+	}
+
+	if err := l.loadTokens(id, now, load); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.loadTokens(id, now.Add(time.Minute), load); err != nil {
+		t.Fatal(err)
+	}
+	if loads["2024-05-01"] != 1 {
+		t.Errorf("tokens of the day loaded %d times, want once", loads["2024-05-01"])
+	}
+
+	release, err := l.acquire(id, limits, now)
+	if err != nil {
+		t.Fatalf("request within the budget: %v", err)
+	}
+	release()
+	l.addTokens(id, 200, now)
+
+	_, err = l.acquire(id, limits, now)
+	var exceeded *QuotaExceededError
+	if !errors.As(err, &exceeded) {
+		t.Fatalf("request over the budget: err = %v, want a quota error", err)
+	}
+	if exceeded.Limit != "daily tokens" || exceeded.RetryAfter != time.Hour {
+		t.Errorf("exceeded %s, retry after %s, want daily tokens, 1h", exceeded.Limit, exceeded.RetryAfter)
+	}
+
+	// the budget starts over at UTC midnight, with the tokens of the new day loaded again
+	midnight := time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)
+	if got := l.usage(id, midnight).TokensToday; got != 0 {
+		t.Errorf("tokens after midnight = %d, want 0", got)
+	}
+	if err = l.loadTokens(id, midnight, load); err != nil {
+		t.Fatal(err)
+	}
+	if loads["2024-05-02"] != 1 {
+		t.Errorf("tokens of the new day loaded %d times, want once", loads["2024-05-02"])
+	}
+	if _, err = l.acquire(id, limits, midnight); err != nil {
+		t.Errorf("request after midnight: %v", err)
+	}
+}
+
+func TestLimiterLoadKeepsChargedTokens(t *testing.T) {
+	l := newLimiter()
+	id := uuid.New()
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	// a request finished before the records were loaded, its tokens are also in the records
+	l.addTokens(id, 300, now)
+	if err := l.loadTokens(id, now, func(string) (int, error) { return 250, nil }); err != nil {
+		t.Fatal(err)
+	}
+	if got := l.usage(id, now).TokensToday; got != 300 {
+		t.Errorf("tokens today = %d, want 300", got)
+	}
+}
@@ -0,0 +1,184 @@
+package quota
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/group"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/quota"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/role"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/usagerecord"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+)
+
+type Handler struct {
+	client *entv1.Client
+	ctx    context.Context
+}
+
+func NewHandler(c *entv1.Client, ctx context.Context) Handler {
+	return Handler{
+		client: c,
+		ctx:    ctx,
+	}
+}
+
+// Limits are the effective quotas of a user, 0 means unlimited.
+type Limits struct {
+	RequestsPerMinute int `json:"requestsPerMinute"`
+	ConcurrentStreams int `json:"concurrentStreams"`
+	DailyTokens       int `json:"dailyTokens"`
+}
+
+// GetLimits resolves the quotas of a user. Each limit is taken from the user's own quota, else from
+// the quotas of the user's groups, else from the quotas of the user's built-in and custom roles, else
+// from the default settings. Of several groups or roles the most permissive limit applies.
+func (h *Handler) GetLimits(ctx context.Context, u *entv1.User) (Limits, error) {
+	own, err := h.client.Quota.Query().Where(quota.UserId(u.ID)).All(ctx)
+	if err != nil {
+		return Limits{}, fmt.Errorf("failed querying quotas: %w", err)
+	}
+	groups, err := h.client.Quota.Query().
+		Where(quota.HasGroupWith(group.HasUsersWith(user.ID(u.ID)))).
+		All(ctx)
+	if err != nil {
+		return Limits{}, fmt.Errorf("failed querying quotas: %w", err)
+	}
+	roles, err := h.client.Quota.Query().
+		Where(quota.HasRoleWith(role.Or(
+			role.And(role.Builtin(true), role.Name(u.Role.String())),
+			role.HasUsersWith(user.ID(u.ID)),
+		))).
+		All(ctx)
+	if err != nil {
+		return Limits{}, fmt.Errorf("failed querying quotas: %w", err)
+	}
+
+	levels := [][]*entv1.Quota{own, groups, roles}
+	return Limits{
+		RequestsPerMinute: resolve(levels, func(q *entv1.Quota) *int { return q.RequestsPerMinute },
+			settings.QuotaRequestsPerMinute.Get()),
+		ConcurrentStreams: resolve(levels, func(q *entv1.Quota) *int { return q.ConcurrentStreams },
+			settings.QuotaConcurrentStreams.Get()),
+		DailyTokens: resolve(levels, func(q *entv1.Quota) *int { return q.DailyTokens },
+			settings.QuotaDailyTokens.Get()),
+	}, nil
+}
+
+// resolve returns the most permissive limit of the first level that sets it, or the default.
+func resolve(levels [][]*entv1.Quota, limit func(*entv1.Quota) *int, defaultValue string) int {
+	for _, quotas := range levels {
+		found, value := false, 0
+		for _, q := range quotas {
+			v := limit(q)
+			if v == nil {
+				continue
+			}
+			if !found || *v == 0 || (value != 0 && *v > value) {
+				value = *v
+			}
+			found = true
+		}
+		if found {
+			return value
+		}
+	}
+
+	value, err := strconv.Atoi(defaultValue)
+	if err != nil || value < 0 {
+		return 0
+	}
+	return value
+}
+
+// dailyTokens returns the tokens the user has used on the UTC day according to the usage records.
+func (h *Handler) dailyTokens(ctx context.Context, id uuid.UUID) func(day string) (int, error) {
+	return func(day string) (int, error) {
+		var rows []struct {
+			Tokens int `sql:"tokens"`
+		}
+		err := h.client.UsageRecord.Query().
+			Where(usagerecord.UserId(id), usagerecord.Day(day)).
+			Aggregate(entv1.As(func(s *sql.Selector) string {
+				return fmt.Sprintf("COALESCE(SUM(%s + %s), 0)",
+					s.C(usagerecord.FieldPromptTokens), s.C(usagerecord.FieldCompletionTokens))
+			}, "tokens")).
+			Scan(ctx, &rows)
+		if err != nil || len(rows) == 0 {
+			return 0, err
+		}
+		return rows[0].Tokens, nil
+	}
+}
+
+func (h *Handler) ListQuotas(ctx context.Context) (entv1.QuotaSlice, error) {
+	quotas, err := h.client.Quota.Query().
+		WithUser(func(q *entv1.UserQuery) {
+			q.Select(user.FieldID, user.FieldName, user.FieldEmail)
+		}).
+		WithGroup(func(q *entv1.GroupQuery) {
+			q.Select(group.FieldID, group.FieldName)
+		}).
+		WithRole(func(q *entv1.RoleQuery) {
+			q.Select(role.FieldID, role.FieldName)
+		}).
+		Order(entv1.Asc(quota.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying quotas: %w", err)
+	}
+	return quotas, nil
+}
+
+// SaveQuota creates or replaces the quota of the user, group or role.
+func (h *Handler) SaveQuota(ctx context.Context, req QuotaRequest) (*entv1.Quota, error) {
+	var subject *entv1.QuotaQuery
+	switch {
+	case req.UserID != nil:
+		subject = h.client.Quota.Query().Where(quota.UserId(*req.UserID))
+	case req.GroupID != nil:
+		subject = h.client.Quota.Query().Where(quota.GroupId(*req.GroupID))
+	default:
+		subject = h.client.Quota.Query().Where(quota.RoleId(*req.RoleID))
+	}
+
+	existing, err := subject.Only(ctx)
+	if entv1.IsNotFound(err) {
+		return h.client.Quota.Create().
+			SetNillableUserId(req.UserID).
+			SetNillableGroupId(req.GroupID).
+			SetNillableRoleId(req.RoleID).
+			SetNillableRequestsPerMinute(req.RequestsPerMinute).
+			SetNillableConcurrentStreams(req.ConcurrentStreams).
+			SetNillableDailyTokens(req.DailyTokens).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	update := h.client.Quota.UpdateOne(existing).
+		SetNillableRequestsPerMinute(req.RequestsPerMinute).
+		SetNillableConcurrentStreams(req.ConcurrentStreams).
+		SetNillableDailyTokens(req.DailyTokens)
+	if req.RequestsPerMinute == nil {
+		update.ClearRequestsPerMinute()
+	}
+	if req.ConcurrentStreams == nil {
+		update.ClearConcurrentStreams()
+	}
+	if req.DailyTokens == nil {
+		update.ClearDailyTokens()
+	}
+	return update.Save(ctx)
+}
+
+func (h *Handler) DeleteQuota(ctx context.Context, id uuid.UUID) error {
+	return h.client.Quota.DeleteOneID(id).Exec(ctx)
+}
@@ -0,0 +1,166 @@
+package quota
+
+import (
+	"errors"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/llmos-ai/llmos-dashboard/pkg/api/localllm"
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
+)
+
+// QuotaRequest sets the quota of exactly one of a user, a group or a role,
+// a nil limit is inherited and 0 means unlimited.
+type QuotaRequest struct {
+	UserID            *uuid.UUID `json:"userId"`
+	GroupID           *uuid.UUID `json:"groupId"`
+	RoleID            *uuid.UUID `json:"roleId"`
+	RequestsPerMinute *int       `json:"requestsPerMinute"`
+	ConcurrentStreams *int       `json:"concurrentStreams"`
+	DailyTokens       *int       `json:"dailyTokens"`
+}
+
+func (h *Handler) ListAllQuotas(c *gin.Context) {
+	quotas, err := h.ListQuotas(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, quotas)
+}
+
+func (h *Handler) SaveQuotaBySubject(c *gin.Context) {
+	var req QuotaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	subjects := 0
+	for _, id := range []*uuid.UUID{req.UserID, req.GroupID, req.RoleID} {
+		if id != nil {
+			subjects++
+		}
+	}
+	if subjects != 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "exactly one of userId, groupId and roleId must be set"})
+		return
+	}
+
+	q, err := h.SaveQuota(c.Request.Context(), req)
+	if entv1.IsValidationError(err) || entv1.IsConstraintError(err) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, q)
+}
+
+func (h *Handler) DeleteQuotaByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	if err = h.DeleteQuota(c.Request.Context(), id); err != nil {
+		if entv1.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, true)
+}
+
+// GetSessionUserUsage returns the quotas of the session user with the current usage and remaining budget,
+// a nil remaining budget means unlimited.
+func (h *Handler) GetSessionUserUsage(c *gin.Context) {
+	user, err := utils.GetSessionUser(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	limits, err := h.GetLimits(c.Request.Context(), user)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	now := timeNow()
+	if err = limiter.loadTokens(user.ID, now, h.dailyTokens(c.Request.Context(), user.ID)); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	usage := limiter.usage(user.ID, now)
+	c.JSON(http.StatusOK, gin.H{
+		"limits": limits,
+		"usage":  usage,
+		"remaining": gin.H{
+			"requestsPerMinute": remaining(limits.RequestsPerMinute, usage.RequestsLastMinute),
+			"concurrentStreams": remaining(limits.ConcurrentStreams, usage.ActiveStreams),
+			"dailyTokens":       remaining(limits.DailyTokens, usage.TokensToday),
+		},
+		"dailyTokensResetAt": now.UTC().Truncate(24 * time.Hour).Add(24 * time.Hour),
+	})
+}
+
+func remaining(limit, used int) *int {
+	if limit == 0 {
+		return nil
+	}
+	r := max(limit-used, 0)
+	return &r
+}
+
+// Middleware rejects LLM requests that exceed the quotas of the session user with 429 and a Retry-After
+// header, and charges the tokens of the finished requests to the user's daily budget.
+func (h *Handler) Middleware(c *gin.Context) {
+	user, err := utils.GetSessionUser(c)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	limits, err := h.GetLimits(c.Request.Context(), user)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	now := timeNow()
+	if err = limiter.loadTokens(user.ID, now, h.dailyTokens(c.Request.Context(), user.ID)); err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	release, err := limiter.acquire(user.ID, limits, now)
+	if err != nil {
+		var exceeded *QuotaExceededError
+		if errors.As(err, &exceeded) {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(exceeded.RetryAfter.Seconds()))))
+		}
+		c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"error": err.Error()})
+		return
+	}
+	defer release()
+
+	w := localllm.CaptureStats(c)
+	c.Next()
+
+	if stats := w.Stats(); stats != nil {
+		limiter.addTokens(user.ID, stats.Tokens(), timeNow())
+	} else {
+		slog.Debug("no token usage in the response", "user", user.Email, "path", c.Request.URL.Path)
+	}
+}
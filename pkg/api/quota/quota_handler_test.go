@@ -0,0 +1,179 @@
+package quota
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+)
+
+// answer streams an ollama chat response whose final chunk reports the token counts.
+func answer(prompt, completion int) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header("Content-Type", "application/x-ndjson")
+		c.Status(http.StatusOK)
+		enc := json.NewEncoder(c.Writer)
+		_ = enc.Encode(gin.H{"model": "llama3:latest", "message": gin.H{"content": "hi"}, "done": false})
+		_ = enc.Encode(gin.H{"model": "llama3:latest", "done": true,
+			"prompt_eval_count": prompt, "eval_count": completion})
+	}
+}
+
+func TestMiddlewareRequestsPerMinute(t *testing.T) {
+	h := newTestHandler(t)
+	alice := createTestUser(t, h, "alice", entv1User.RoleUser)
+	if _, err := h.SaveQuota(h.ctx, QuotaRequest{UserID: &alice.ID, RequestsPerMinute: intPtr(2)}); err != nil {
+		t.Fatal(err)
+	}
+	now := setClock(t, time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC))
+
+	for i := 0; i < 2; i++ {
+		if w := serveLLM(t, h, alice, answer(1, 1)); w.Code != http.StatusOK {
+			t.Fatalf("request %d: status = %d: %s", i+1, w.Code, w.Body)
+		}
+		*now = now.Add(15 * time.Second)
+	}
+
+	w := serveLLM(t, h, alice, answer(1, 1))
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("third request: status = %d, want %d", w.Code, http.StatusTooManyRequests)
+	}
+	// the first request leaves the window 30s later
+	if got := w.Header().Get("Retry-After"); got != "30" {
+		t.Errorf("Retry-After = %q, want 30", got)
+	}
+
+	*now = now.Add(30 * time.Second)
+	if w = serveLLM(t, h, alice, answer(1, 1)); w.Code != http.StatusOK {
+		t.Errorf("request after the window: status = %d: %s", w.Code, w.Body)
+	}
+}
+
+func TestMiddlewareReleasesStreams(t *testing.T) {
+	h := newTestHandler(t)
+	alice := createTestUser(t, h, "alice", entv1User.RoleUser)
+	if _, err := h.SaveQuota(h.ctx, QuotaRequest{UserID: &alice.ID, ConcurrentStreams: intPtr(1)}); err != nil {
+		t.Fatal(err)
+	}
+	now := setClock(t, time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC))
+
+	// a second request while the first one streams is rejected
+	var nested *httptest.ResponseRecorder
+	w := serveLLM(t, h, alice, func(c *gin.Context) {
+		nested = serveLLM(t, h, alice, answer(1, 1))
+		c.Status(http.StatusOK)
+	})
+	if w.Code != http.StatusOK {
+		t.Fatalf("first request: status = %d: %s", w.Code, w.Body)
+	}
+	if nested.Code != http.StatusTooManyRequests || nested.Header().Get("Retry-After") != "1" {
+		t.Errorf("concurrent request: status = %d, Retry-After = %q, want %d, 1",
+			nested.Code, nested.Header().Get("Retry-After"), http.StatusTooManyRequests)
+	}
+
+	// an aborted or failed request frees its stream as well
+	w = serveLLM(t, h, alice, func(c *gin.Context) {
+		c.AbortWithStatusJSON(http.StatusBadGateway, gin.H{"error": "upstream is down"})
+	})
+	if w.Code != http.StatusBadGateway {
+		t.Fatalf("aborted request: status = %d", w.Code)
+	}
+	if got := limiter.usage(alice.ID, *now).ActiveStreams; got != 0 {
+		t.Errorf("active streams = %d, want 0", got)
+	}
+	if w = serveLLM(t, h, alice, answer(1, 1)); w.Code != http.StatusOK {
+		t.Errorf("request after the abort: status = %d: %s", w.Code, w.Body)
+	}
+}
+
+func TestMiddlewareDailyTokens(t *testing.T) {
+	h := newTestHandler(t)
+	alice := createTestUser(t, h, "alice", entv1User.RoleUser)
+	if _, err := h.SaveQuota(h.ctx, QuotaRequest{UserID: &alice.ID, DailyTokens: intPtr(100)}); err != nil {
+		t.Fatal(err)
+	}
+	now := setClock(t, time.Date(2024, 5, 1, 23, 30, 0, 0, time.UTC))
+
+	// the usage recorded before a restart counts towards the budget
+	h.client.UsageRecord.Create().
+		SetUserId(alice.ID).
+		SetPromptTokens(40).
+		SetCompletionTokens(50).
+		SetStatus(http.StatusOK).
+		SetDay("2024-05-01").
+		ExecX(h.ctx)
+
+	if w := serveLLM(t, h, alice, answer(10, 20)); w.Code != http.StatusOK {
+		t.Fatalf("request within the budget: status = %d: %s", w.Code, w.Body)
+	}
+	if got := limiter.usage(alice.ID, *now).TokensToday; got != 120 {
+		t.Errorf("tokens today = %d, want 120", got)
+	}
+
+	w := serveLLM(t, h, alice, answer(1, 1))
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("request over the budget: status = %d, want %d", w.Code, http.StatusTooManyRequests)
+	}
+	if got := w.Header().Get("Retry-After"); got != "1800" {
+		t.Errorf("Retry-After = %q, want the 1800 seconds until UTC midnight", got)
+	}
+
+	*now = time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)
+	if w = serveLLM(t, h, alice, answer(1, 1)); w.Code != http.StatusOK {
+		t.Errorf("request after midnight: status = %d: %s", w.Code, w.Body)
+	}
+	if got := limiter.usage(alice.ID, *now).TokensToday; got != 2 {
+		t.Errorf("tokens of the new day = %d, want 2", got)
+	}
+}
+
+func TestGetSessionUserUsage(t *testing.T) {
+	h := newTestHandler(t)
+	alice := createTestUser(t, h, "alice", entv1User.RoleUser)
+	if _, err := h.SaveQuota(h.ctx, QuotaRequest{UserID: &alice.ID, RequestsPerMinute: intPtr(10),
+		ConcurrentStreams: intPtr(0), DailyTokens: intPtr(1000)}); err != nil {
+		t.Fatal(err)
+	}
+	setClock(t, time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC))
+	if w := serveLLM(t, h, alice, answer(100, 200)); w.Code != http.StatusOK {
+		t.Fatalf("request: status = %d: %s", w.Code, w.Body)
+	}
+
+	r := gin.New()
+	r.GET("/usage", func(c *gin.Context) {
+		c.Set("user", alice)
+	}, h.GetSessionUserUsage)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/usage", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d: %s", w.Code, w.Body)
+	}
+
+	var got struct {
+		Usage     Usage `json:"usage"`
+		Remaining struct {
+			RequestsPerMinute *int `json:"requestsPerMinute"`
+			ConcurrentStreams *int `json:"concurrentStreams"`
+			DailyTokens       *int `json:"dailyTokens"`
+		} `json:"remaining"`
+		DailyTokensResetAt time.Time `json:"dailyTokensResetAt"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Usage != (Usage{RequestsLastMinute: 1, ActiveStreams: 0, TokensToday: 300}) {
+		t.Errorf("usage = %+v", got.Usage)
+	}
+	if rem := got.Remaining; rem.RequestsPerMinute == nil || *rem.RequestsPerMinute != 9 ||
+		rem.ConcurrentStreams != nil || rem.DailyTokens == nil || *rem.DailyTokens != 700 {
+		t.Errorf("remaining = %+v, want 9 requests, unlimited streams and 700 tokens", rem)
+	}
+	if want := time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC); !got.DailyTokensResetAt.Equal(want) {
+		t.Errorf("daily tokens reset at %s, want %s", got.DailyTokensResetAt, want)
+	}
+}
@@ -0,0 +1,156 @@
+package quota
+
+import (
+	"testing"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+)
+
+func TestGetLimits(t *testing.T) {
+	tests := []struct {
+		name string
+		// quotas of the user, its two groups, its built-in role and its custom role, nil means none
+		user, group, otherGroup, builtinRole, customRole *QuotaRequest
+		want                                             Limits
+	}{
+		{
+			name: "defaults",
+			want: Limits{RequestsPerMinute: 60, ConcurrentStreams: 2, DailyTokens: 100000},
+		},
+		{
+			name:        "built-in role over defaults",
+			builtinRole: &QuotaRequest{RequestsPerMinute: intPtr(30)},
+			want:        Limits{RequestsPerMinute: 30, ConcurrentStreams: 2, DailyTokens: 100000},
+		},
+		{
+			name:        "most permissive role",
+			builtinRole: &QuotaRequest{RequestsPerMinute: intPtr(30)},
+			customRole:  &QuotaRequest{RequestsPerMinute: intPtr(120)},
+			want:        Limits{RequestsPerMinute: 120, ConcurrentStreams: 2, DailyTokens: 100000},
+		},
+		{
+			name:        "group over role",
+			group:       &QuotaRequest{RequestsPerMinute: intPtr(10)},
+			builtinRole: &QuotaRequest{RequestsPerMinute: intPtr(30), DailyTokens: intPtr(500)},
+			want:        Limits{RequestsPerMinute: 10, ConcurrentStreams: 2, DailyTokens: 500},
+		},
+		{
+			name:       "unlimited group",
+			group:      &QuotaRequest{ConcurrentStreams: intPtr(1)},
+			otherGroup: &QuotaRequest{ConcurrentStreams: intPtr(0)},
+			want:       Limits{RequestsPerMinute: 60, ConcurrentStreams: 0, DailyTokens: 100000},
+		},
+		{
+			name:        "user over group and role",
+			user:        &QuotaRequest{DailyTokens: intPtr(1000)},
+			group:       &QuotaRequest{RequestsPerMinute: intPtr(10), DailyTokens: intPtr(5000)},
+			builtinRole: &QuotaRequest{ConcurrentStreams: intPtr(4), DailyTokens: intPtr(9000)},
+			want:        Limits{RequestsPerMinute: 10, ConcurrentStreams: 4, DailyTokens: 1000},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newTestHandler(t)
+			setSetting(t, settings.QuotaRequestsPerMinute, "60")
+			setSetting(t, settings.QuotaConcurrentStreams, "2")
+			setSetting(t, settings.QuotaDailyTokens, "100000")
+
+			alice := createTestUser(t, h, "alice", entv1User.RoleUser)
+			createTestUser(t, h, "bob", entv1User.RoleUser)
+			group := h.client.Group.Create().SetName("staff").AddUsers(alice).SaveX(h.ctx)
+			otherGroup := h.client.Group.Create().SetName("ops").AddUsers(alice).SaveX(h.ctx)
+			builtinRole := h.client.Role.Create().SetName(entv1User.RoleUser.String()).SetBuiltin(true).SaveX(h.ctx)
+			customRole := h.client.Role.Create().SetName("researcher").AddUsers(alice).SaveX(h.ctx)
+			// quotas of other subjects must not apply
+			unrelated := h.client.Role.Create().SetName("intern").SaveX(h.ctx)
+			if _, err := h.SaveQuota(h.ctx, QuotaRequest{RoleID: &unrelated.ID, RequestsPerMinute: intPtr(1)}); err != nil {
+				t.Fatal(err)
+			}
+
+			for _, q := range []struct {
+				req   *QuotaRequest
+				apply func(*QuotaRequest)
+			}{
+				{tt.user, func(r *QuotaRequest) { r.UserID = &alice.ID }},
+				{tt.group, func(r *QuotaRequest) { r.GroupID = &group.ID }},
+				{tt.otherGroup, func(r *QuotaRequest) { r.GroupID = &otherGroup.ID }},
+				{tt.builtinRole, func(r *QuotaRequest) { r.RoleID = &builtinRole.ID }},
+				{tt.customRole, func(r *QuotaRequest) { r.RoleID = &customRole.ID }},
+			} {
+				if q.req == nil {
+					continue
+				}
+				req := *q.req
+				q.apply(&req)
+				if _, err := h.SaveQuota(h.ctx, req); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got, err := h.GetLimits(h.ctx, alice)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("limits = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSaveQuotaReplaces(t *testing.T) {
+	h := newTestHandler(t)
+	alice := createTestUser(t, h, "alice", entv1User.RoleUser)
+
+	if _, err := h.SaveQuota(h.ctx, QuotaRequest{UserID: &alice.ID, RequestsPerMinute: intPtr(5), DailyTokens: intPtr(100)}); err != nil {
+		t.Fatal(err)
+	}
+	q, err := h.SaveQuota(h.ctx, QuotaRequest{UserID: &alice.ID, RequestsPerMinute: intPtr(10)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.RequestsPerMinute == nil || *q.RequestsPerMinute != 10 || q.DailyTokens != nil {
+		t.Errorf("quota = %+v, want 10 requests per minute and inherited daily tokens", q)
+	}
+	if n := h.client.Quota.Query().CountX(h.ctx); n != 1 {
+		t.Errorf("%d quotas, want 1", n)
+	}
+}
+
+func TestDailyTokens(t *testing.T) {
+	h := newTestHandler(t)
+	alice := createTestUser(t, h, "alice", entv1User.RoleUser)
+	bob := createTestUser(t, h, "bob", entv1User.RoleUser)
+
+	for _, r := range []struct {
+		owner          *entv1.User
+		day            string
+		prompt, answer int
+	}{
+		{owner: alice, day: "2024-05-01", prompt: 10, answer: 20},
+		{owner: alice, day: "2024-05-01", prompt: 5, answer: 100},
+		{owner: alice, day: "2024-04-30", prompt: 1000, answer: 1000},
+		{owner: bob, day: "2024-05-01", prompt: 1000, answer: 1000},
+	} {
+		h.client.UsageRecord.Create().
+			SetUserId(r.owner.ID).
+			SetPromptTokens(r.prompt).
+			SetCompletionTokens(r.answer).
+			SetStatus(200).
+			SetDay(r.day).
+			ExecX(h.ctx)
+	}
+
+	tests := map[string]int{"2024-05-01": 135, "2024-04-30": 2000, "2024-05-02": 0}
+	for day, want := range tests {
+		got, err := h.dailyTokens(h.ctx, alice.ID)(day)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("tokens of %s = %d, want %d", day, got, want)
+		}
+	}
+}
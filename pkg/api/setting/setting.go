@@ -40,12 +40,22 @@ func (h *handler) Get(name string) string {
 }
 
 func (h *handler) Set(name, value string) error {
+	return h.set(h.ctx, name, value)
+}
+
+// set updates the setting in the context, a request context records the change in the audit log.
+func (h *handler) set(ctx context.Context, name, value string) error {
 	envValue := os.Getenv(settings.GetEnvKey(name))
 	if envValue != "" {
 		return fmt.Errorf("setting %s can not be set because it is from environment variable", name)
 	}
 
-	return h.client.Setting.Update().Where(entSetting.Name(name)).SetNillableValue(&value).Exec(h.ctx)
+	obj, err := h.client.Setting.Query().Where(entSetting.Name(name)).Only(ctx)
+	if err != nil {
+		return err
+	}
+	// updating the queried setting lets the audit log record its old value
+	return h.client.Setting.UpdateOne(obj).SetValue(value).Exec(ctx)
 }
 
 func (h *handler) SetIfUnset(name, value string) error {
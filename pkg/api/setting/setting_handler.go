@@ -4,11 +4,16 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/llmos-ai/llmos-dashboard/pkg/api/auth"
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/mailer"
 	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
 )
 
 type settingRequest struct {
@@ -17,12 +22,29 @@ type settingRequest struct {
 }
 
 func (h *handler) GetAllSettings(c *gin.Context) {
-	settings, err := h.client.Setting.Query().All(h.ctx)
+	h.listSettings(c)
+}
+
+// listSettings responds with all settings, the values of the secret ones are masked.
+func (h *handler) listSettings(c *gin.Context) {
+	list, err := h.client.Setting.Query().All(c.Request.Context())
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, err.Error())
 		return
 	}
-	c.JSON(200, settings)
+	for _, s := range list {
+		if !settings.IsSecret(s.Name) {
+			continue
+		}
+		// an empty value still tells the secret is not configured
+		if s.Value != "" {
+			s.Value = settings.MaskedValue
+		}
+		if s.Default != "" {
+			s.Default = settings.MaskedValue
+		}
+	}
+	c.JSON(200, list)
 }
 
 func (h *handler) UpdateSettingByName(c *gin.Context) {
@@ -32,7 +54,23 @@ func (h *handler) UpdateSettingByName(c *gin.Context) {
 		return
 	}
 
-	if setting.Name == settings.TokenExpireTimeSettingName {
+	// the masked value of a secret is sent back unchanged, it must not replace the secret
+	if settings.IsSecret(setting.Name) && setting.Value == settings.MaskedValue {
+		h.listSettings(c)
+		return
+	}
+
+	if setting.Name == settings.TokenExpireTimeSettingName ||
+		setting.Name == settings.RefreshTokenExpireTimeSettingName ||
+		setting.Name == settings.LoginFailureWindowSettingName ||
+		setting.Name == settings.LoginLockoutDurationSettingName ||
+		setting.Name == settings.LoginBackoffBaseSettingName ||
+		setting.Name == settings.LoginBackoffMaxSettingName ||
+		setting.Name == settings.JWTKeyGracePeriodSettingName ||
+		setting.Name == settings.PasswordResetExpireTimeSettingName ||
+		setting.Name == settings.InvitationExpireTimeSettingName ||
+		setting.Name == settings.ImpersonationExpireTimeSettingName ||
+		setting.Name == settings.ChatTrashRetentionSettingName {
 		if err := validateSettingTokenExpireTime(setting.Value); err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 			return
@@ -46,18 +84,96 @@ func (h *handler) UpdateSettingByName(c *gin.Context) {
 		}
 	}
 
-	err := h.Set(setting.Name, setting.Value)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, err.Error())
-		return
+	if setting.Name == settings.OIDCIssuerURLSettingName ||
+		setting.Name == settings.PublicURLSettingName ||
+		setting.Name == settings.OIDCRedirectURLSettingName {
+		if err := validateSettingURL(setting.Value); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	if setting.Name == settings.LoginMaxFailuresSettingName ||
+		setting.Name == settings.PasswordMinLengthSettingName ||
+		setting.Name == settings.LoginIPMaxFailuresSettingName ||
+		setting.Name == settings.QuotaRequestsPerMinuteSettingName ||
+		setting.Name == settings.QuotaConcurrentStreamsSettingName ||
+		setting.Name == settings.QuotaDailyTokensSettingName {
+		if err := validateSettingNonNegativeInt(setting.Value); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	if setting.Name == settings.SMTPPortSettingName {
+		if err := validateSettingPort(setting.Value); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	if setting.Name == settings.SMTPTLSModeSettingName {
+		if err := validateSettingSMTPTLSMode(setting.Value); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	if setting.Name == settings.JWTSigningAlgorithmSettingName {
+		if err := validateSettingSigningAlgorithm(setting.Value); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	if setting.Name == settings.ProxyAuthTrustedCIDRsSettingName {
+		if _, err := auth.ParseProxyCIDRs(setting.Value); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	if setting.Name == settings.ModelWhitelistSettingName {
+		if _, err := auth.ParseModelWhitelist(setting.Value); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	if setting.Name == settings.LDAPServerURLSettingName {
+		if err := validateSettingLDAPURL(setting.Value); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	if setting.Name == settings.LDAPGroupRoleMappingSettingName {
+		if _, err := auth.ParseLDAPGroupRoleMapping(setting.Value); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
 	}
 
-	settings, err := h.client.Setting.Query().All(h.ctx)
+	err := h.set(c.Request.Context(), setting.Name, setting.Value)
+	if entv1.IsNotFound(err) {
+		c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("setting %s not found", setting.Name)})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, err.Error())
 		return
 	}
-	c.JSON(200, settings)
+
+	// new tokens are signed with the new algorithm right away
+	if setting.Name == settings.JWTSigningAlgorithmSettingName {
+		authHandler := auth.NewAuthHandler(h.client, h.ctx)
+		if _, err = authHandler.RotateSigningKey(c.Request.Context()); err != nil {
+			c.JSON(http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+
+	h.listSettings(c)
 }
 
 func validateSettingTokenExpireTime(value string) error {
@@ -68,6 +184,30 @@ func validateSettingTokenExpireTime(value string) error {
 	return nil
 }
 
+func validateSettingSigningAlgorithm(value string) error {
+	switch value {
+	case utils.SigningAlgorithmHS256, utils.SigningAlgorithmRS256, utils.SigningAlgorithmEdDSA:
+		return nil
+	}
+	return fmt.Errorf("invalid signing algorithm: %s, options are HS256, RS256, EdDSA", value)
+}
+
+func validateSettingPort(value string) error {
+	port, err := strconv.Atoi(value)
+	if err != nil || port <= 0 || port > 65535 {
+		return fmt.Errorf("invalid port: %s", value)
+	}
+	return nil
+}
+
+func validateSettingSMTPTLSMode(value string) error {
+	switch value {
+	case mailer.TLSModeNone, mailer.TLSModeStartTLS, mailer.TLSModeTLS:
+		return nil
+	}
+	return fmt.Errorf("invalid smtp tls mode: %s, options are none, starttls, tls", value)
+}
+
 func validateSettingWebhookURL(value string) error {
 	// allow to reset empty value
 	if value == "" {
@@ -79,3 +219,35 @@ func validateSettingWebhookURL(value string) error {
 	}
 	return nil
 }
+
+func validateSettingURL(value string) error {
+	// allow to reset empty value
+	if value == "" {
+		return nil
+	}
+	u, err := url.ParseRequestURI(value)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("invalid url: %s", value)
+	}
+	return nil
+}
+
+func validateSettingLDAPURL(value string) error {
+	// allow to reset empty value
+	if value == "" {
+		return nil
+	}
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "ldap" && u.Scheme != "ldaps") || u.Host == "" {
+		return fmt.Errorf("invalid ldap url: %s, expected ldap:// or ldaps://", value)
+	}
+	return nil
+}
+
+func validateSettingNonNegativeInt(value string) error {
+	i, err := strconv.Atoi(value)
+	if err != nil || i < 0 {
+		return fmt.Errorf("invalid number: %s", value)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package setting
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/gin-gonic/gin"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/enttest"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+)
+
+func newTestHandler(t *testing.T) *handler {
+	t.Helper()
+	client := enttest.Open(t, dialect.SQLite, "file:"+url.PathEscape(t.Name())+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	h := NewHandler(client, context.Background())
+	err := h.SetAll(map[string]settings.Setting{
+		settings.UIPlSettingName:             {Name: settings.UIPlSettingName, Default: "LLMOS Dashboard"},
+		settings.SMTPPasswordSettingName:     {Name: settings.SMTPPasswordSettingName},
+		settings.JWTSecretSettingName:        {Name: settings.JWTSecretSettingName, Default: "default-secret"},
+		settings.LDAPBindPasswordSettingName: {Name: settings.LDAPBindPasswordSettingName},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &h
+}
+
+func serveSettings(t *testing.T, handler gin.HandlerFunc, method string, body any) map[string]*entv1.Setting {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	var buf bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&buf).Encode(body); err != nil {
+			t.Fatal(err)
+		}
+	}
+	r := gin.New()
+	r.Handle(method, "/", handler)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(method, "/", &buf))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+	}
+
+	var list []*entv1.Setting
+	if err := json.Unmarshal(w.Body.Bytes(), &list); err != nil {
+		t.Fatal(err)
+	}
+	byName := map[string]*entv1.Setting{}
+	for _, s := range list {
+		byName[s.Name] = s
+	}
+	return byName
+}
+
+func TestSecretSettingsAreMasked(t *testing.T) {
+	h := newTestHandler(t)
+	if err := h.Set(settings.SMTPPasswordSettingName, "hunter2"); err != nil {
+		t.Fatal(err)
+	}
+
+	list := serveSettings(t, h.GetAllSettings, http.MethodGet, nil)
+	if got := list[settings.SMTPPasswordSettingName].Value; got != settings.MaskedValue {
+		t.Errorf("smtp password value = %q, want it masked", got)
+	}
+	if got := list[settings.JWTSecretSettingName].Default; got != settings.MaskedValue {
+		t.Errorf("jwt secret default = %q, want it masked", got)
+	}
+	if got := list[settings.LDAPBindPasswordSettingName].Value; got != "" {
+		t.Errorf("unset ldap bind password = %q, want it empty", got)
+	}
+	if got := list[settings.UIPlSettingName].Default; got != "LLMOS Dashboard" {
+		t.Errorf("ui-pl default = %q, want it unmasked", got)
+	}
+}
+
+func TestUpdateSecretSetting(t *testing.T) {
+	h := newTestHandler(t)
+	if err := h.Set(settings.SMTPPasswordSettingName, "hunter2"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the masked value sent back keeps the secret
+	list := serveSettings(t, h.UpdateSettingByName, http.MethodPost,
+		settingRequest{Name: settings.SMTPPasswordSettingName, Value: settings.MaskedValue})
+	if got := h.Get(settings.SMTPPasswordSettingName); got != "hunter2" {
+		t.Errorf("smtp password = %q after sending the masked value", got)
+	}
+	if got := list[settings.SMTPPasswordSettingName].Value; got != settings.MaskedValue {
+		t.Errorf("smtp password value = %q in the response, want it masked", got)
+	}
+
+	list = serveSettings(t, h.UpdateSettingByName, http.MethodPost,
+		settingRequest{Name: settings.SMTPPasswordSettingName, Value: "correct horse"})
+	if got := h.Get(settings.SMTPPasswordSettingName); got != "correct horse" {
+		t.Errorf("smtp password = %q, want it updated", got)
+	}
+	if got := list[settings.SMTPPasswordSettingName].Value; got != settings.MaskedValue {
+		t.Errorf("smtp password value = %q in the response, want it masked", got)
+	}
+}
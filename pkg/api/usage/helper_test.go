@@ -0,0 +1,56 @@
+package usage
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/gin-gonic/gin"
+	_ "github.com/mattn/go-sqlite3"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/enttest"
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+// newTestHandler returns a handler backed by a fresh in-memory database.
+func newTestHandler(t *testing.T) *Handler {
+	t.Helper()
+	client := enttest.Open(t, dialect.SQLite, "file:"+url.PathEscape(t.Name())+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	h := NewHandler(client, context.Background())
+	return &h
+}
+
+func createTestUser(t *testing.T, h *Handler, name string, role entv1User.Role) *entv1.User {
+	t.Helper()
+	return h.client.User.Create().
+		SetName(name).
+		SetEmail(name + "@example.com").
+		SetPassword("not a hash").
+		SetRole(role).
+		SaveX(h.ctx)
+}
+
+// serveLLM sends an ollama request of the user for the model to path through the handlers.
+func serveLLM(t *testing.T, user *entv1.User, path, model string, handlers ...gin.HandlerFunc) *httptest.ResponseRecorder {
+	t.Helper()
+	r := gin.New()
+	r.POST(path, append([]gin.HandlerFunc{func(c *gin.Context) {
+		c.Set("user", user)
+	}}, handlers...)...)
+	w := httptest.NewRecorder()
+	body := bytes.NewBufferString(`{"model":"` + model + `","prompt":"hi"}`)
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, path, body))
+	return w
+}
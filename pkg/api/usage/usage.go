@@ -0,0 +1,145 @@
+package usage
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/predicate"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/usagerecord"
+)
+
+type Handler struct {
+	client *entv1.Client
+	ctx    context.Context
+}
+
+func NewHandler(c *entv1.Client, ctx context.Context) Handler {
+	return Handler{
+		client: c,
+		ctx:    ctx,
+	}
+}
+
+// reportGroups are the dimensions the usage can be aggregated by, with their fields.
+var reportGroups = map[string][]string{
+	"user":  {usagerecord.FieldUserId, usagerecord.FieldUserEmail},
+	"model": {usagerecord.FieldModel},
+	"day":   {usagerecord.FieldDay},
+}
+
+// ReportFilter narrows the aggregated usage records, empty fields match all records.
+type ReportFilter struct {
+	UserID *uuid.UUID
+	Model  string
+	// UTC dates, e.g., 2024-05-01, both inclusive
+	From string
+	To   string
+}
+
+func (f ReportFilter) predicates() []predicate.UsageRecord {
+	var ps []predicate.UsageRecord
+	if f.UserID != nil {
+		ps = append(ps, usagerecord.UserId(*f.UserID))
+	}
+	if f.Model != "" {
+		ps = append(ps, usagerecord.Model(f.Model))
+	}
+	if f.From != "" {
+		ps = append(ps, usagerecord.DayGTE(f.From))
+	}
+	if f.To != "" {
+		ps = append(ps, usagerecord.DayLTE(f.To))
+	}
+	return ps
+}
+
+// ReportRow is the aggregated usage of a user, model or day, or a combination of them.
+type ReportRow struct {
+	UserID           *uuid.UUID `json:"userId,omitempty" sql:"user_id"`
+	UserEmail        string     `json:"userEmail,omitempty" sql:"user_email"`
+	Model            string     `json:"model,omitempty" sql:"model"`
+	Day              string     `json:"day,omitempty" sql:"day"`
+	Requests         int        `json:"requests" sql:"requests"`
+	FailedRequests   int        `json:"failedRequests" sql:"failed_requests"`
+	PromptTokens     int        `json:"promptTokens" sql:"prompt_tokens"`
+	CompletionTokens int        `json:"completionTokens" sql:"completion_tokens"`
+	TotalTokens      int        `json:"totalTokens"`
+	// average time until the responses were proxied completely, in milliseconds
+	AvgLatency float64 `json:"avgLatency" sql:"avg_latency"`
+}
+
+// Record stores the usage of a finished LLM request.
+func (h *Handler) Record(ctx context.Context, r *entv1.UsageRecord) error {
+	return h.client.UsageRecord.Create().
+		SetUserId(r.UserId).
+		SetUserEmail(r.UserEmail).
+		SetModel(r.Model).
+		SetEndpoint(r.Endpoint).
+		SetPromptTokens(r.PromptTokens).
+		SetCompletionTokens(r.CompletionTokens).
+		SetTotalDuration(r.TotalDuration).
+		SetLoadDuration(r.LoadDuration).
+		SetPromptEvalDuration(r.PromptEvalDuration).
+		SetEvalDuration(r.EvalDuration).
+		SetLatency(r.Latency).
+		SetStatus(r.Status).
+		SetDay(r.CreatedAt.UTC().Format(time.DateOnly)).
+		SetCreatedAt(r.CreatedAt).
+		Exec(ctx)
+}
+
+// Report aggregates the matching usage records by the given dimensions, i.e., user, model and day.
+func (h *Handler) Report(ctx context.Context, filter ReportFilter, groupBy []string) ([]ReportRow, error) {
+	var fields []string
+	for _, g := range groupBy {
+		f, ok := reportGroups[g]
+		if !ok {
+			return nil, fmt.Errorf("invalid group %s, options are user, model, day", g)
+		}
+		fields = append(fields, f...)
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("at least one group is required")
+	}
+
+	var rows []ReportRow
+	err := h.client.UsageRecord.Query().
+		Where(filter.predicates()...).
+		GroupBy(fields[0], fields[1:]...).
+		Aggregate(
+			entv1.As(entv1.Count(), "requests"),
+			entv1.As(failedRequests, "failed_requests"),
+			entv1.As(entv1.Sum(usagerecord.FieldPromptTokens), "prompt_tokens"),
+			entv1.As(entv1.Sum(usagerecord.FieldCompletionTokens), "completion_tokens"),
+			entv1.As(entv1.Mean(usagerecord.FieldLatency), "avg_latency"),
+		).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed aggregating usage records: %w", err)
+	}
+
+	for i := range rows {
+		rows[i].TotalTokens = rows[i].PromptTokens + rows[i].CompletionTokens
+	}
+	slices.SortFunc(rows, func(a, b ReportRow) int {
+		if c := cmp.Compare(a.Day, b.Day); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.UserEmail, b.UserEmail); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Model, b.Model)
+	})
+	return rows, nil
+}
+
+func failedRequests(s *sql.Selector) string {
+	return fmt.Sprintf("SUM(CASE WHEN %s >= 400 THEN 1 ELSE 0 END)", s.C(usagerecord.FieldStatus))
+}
@@ -0,0 +1,126 @@
+package usage
+
+import (
+	"encoding/csv"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/llmos-ai/llmos-dashboard/pkg/api/localllm"
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
+)
+
+var csvHeader = []string{"day", "userId", "userEmail", "model", "requests", "failedRequests",
+	"promptTokens", "completionTokens", "totalTokens", "avgLatency"}
+
+// Middleware records the token usage, durations and status of the proxied LLM requests.
+func (h *Handler) Middleware(c *gin.Context) {
+	user, err := utils.GetSessionUser(c)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	model, _ := localllm.RequestModel(c)
+	start := time.Now()
+
+	w := localllm.CaptureStats(c)
+	c.Next()
+
+	r := &entv1.UsageRecord{
+		UserId:    user.ID,
+		UserEmail: user.Email,
+		Model:     model,
+		Endpoint:  path.Base(c.Request.URL.Path),
+		Latency:   time.Since(start).Milliseconds(),
+		Status:    w.Status(),
+		CreatedAt: start,
+	}
+	if stats := w.Stats(); stats != nil {
+		if stats.Model != "" {
+			r.Model = stats.Model
+		}
+		r.PromptTokens = stats.PromptEvalCount
+		r.CompletionTokens = stats.EvalCount
+		r.TotalDuration = stats.TotalDuration
+		r.LoadDuration = stats.LoadDuration
+		r.PromptEvalDuration = stats.PromptEvalDuration
+		r.EvalDuration = stats.EvalDuration
+	}
+	// the request context is canceled when the client disconnects, the usage is recorded regardless
+	if err = h.Record(h.ctx, r); err != nil {
+		slog.Error("failed to record usage", "error", err)
+	}
+}
+
+// GetUsageReport returns the usage aggregated by the dimensions of the groupBy query, e.g., user,day,
+// as JSON or as CSV for chargeback.
+func (h *Handler) GetUsageReport(c *gin.Context) {
+	filter := ReportFilter{
+		Model: c.Query("model"),
+		From:  c.Query("from"),
+		To:    c.Query("to"),
+	}
+	if v := c.Query("userId"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid userId"})
+			return
+		}
+		filter.UserID = &id
+	}
+	for name, v := range map[string]string{"from": filter.From, "to": filter.To} {
+		if _, err := time.Parse(time.DateOnly, v); v != "" && err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid %s, must be a date, e.g., 2024-05-01", name)})
+			return
+		}
+	}
+
+	rows, err := h.Report(c.Request.Context(), filter, strings.Split(c.DefaultQuery("groupBy", "user"), ","))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	switch format := c.DefaultQuery("format", "json"); format {
+	case "json":
+		c.JSON(http.StatusOK, rows)
+	case "csv":
+		c.Header("Content-Type", "text/csv")
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=usage-%s.csv",
+			time.Now().UTC().Format(time.DateOnly)))
+		c.Status(http.StatusOK)
+		if err = writeCSV(csv.NewWriter(c.Writer), rows); err != nil {
+			slog.Error("failed to write usage report", "error", err)
+		}
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid format %s, options are json, csv", format)})
+	}
+}
+
+func writeCSV(w *csv.Writer, rows []ReportRow) error {
+	if err := w.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, r := range rows {
+		var userID string
+		if r.UserID != nil {
+			userID = r.UserID.String()
+		}
+		if err := w.Write([]string{r.Day, userID, r.UserEmail, r.Model,
+			strconv.Itoa(r.Requests), strconv.Itoa(r.FailedRequests), strconv.Itoa(r.PromptTokens),
+			strconv.Itoa(r.CompletionTokens), strconv.Itoa(r.TotalTokens),
+			strconv.FormatFloat(r.AvgLatency, 'f', 1, 64)}); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
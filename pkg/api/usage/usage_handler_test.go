@@ -0,0 +1,153 @@
+package usage
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/llmos-ai/llmos-dashboard/pkg/api/quota"
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+)
+
+// stream answers like ollama with streaming enabled, the statistics come with the final chunk.
+func stream(c *gin.Context) {
+	c.Header("Content-Type", "application/x-ndjson")
+	c.Status(http.StatusOK)
+	enc := json.NewEncoder(c.Writer)
+	for _, word := range []string{"hello", " world"} {
+		_ = enc.Encode(gin.H{"model": "llama3:latest", "response": word, "done": false})
+		c.Writer.Flush()
+	}
+	_ = enc.Encode(gin.H{"model": "llama3:latest", "response": "", "done": true, "total_duration": 900,
+		"load_duration": 100, "prompt_eval_count": 12, "prompt_eval_duration": 200, "eval_count": 34, "eval_duration": 600})
+}
+
+// single answers like ollama with streaming disabled.
+func single(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"model": "llama3:latest", "message": gin.H{"role": "assistant", "content": "hello world"},
+		"done": true, "total_duration": 900, "load_duration": 100, "prompt_eval_count": 12, "prompt_eval_duration": 200,
+		"eval_count": 34, "eval_duration": 600})
+}
+
+// record are the fields of a usage record that come from the request and its response.
+type record struct {
+	Model              string
+	Endpoint           string
+	Status             int
+	PromptTokens       int
+	CompletionTokens   int
+	TotalDuration      int64
+	LoadDuration       int64
+	PromptEvalDuration int64
+	EvalDuration       int64
+}
+
+func TestMiddleware(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		model    string
+		upstream gin.HandlerFunc
+		want     record
+	}{
+		{
+			name:     "streamed",
+			path:     "/ollama/api/generate",
+			model:    "llama3",
+			upstream: stream,
+			want: record{Model: "llama3:latest", Endpoint: "generate", Status: http.StatusOK,
+				PromptTokens: 12, CompletionTokens: 34, TotalDuration: 900, LoadDuration: 100,
+				PromptEvalDuration: 200, EvalDuration: 600},
+		},
+		{
+			name:     "not streamed",
+			path:     "/ollama/api/chat",
+			model:    "llama3",
+			upstream: single,
+			want: record{Model: "llama3:latest", Endpoint: "chat", Status: http.StatusOK,
+				PromptTokens: 12, CompletionTokens: 34, TotalDuration: 900, LoadDuration: 100,
+				PromptEvalDuration: 200, EvalDuration: 600},
+		},
+		{
+			name:  "failed",
+			path:  "/ollama/api/chat",
+			model: "mistral",
+			upstream: func(c *gin.Context) {
+				c.AbortWithStatusJSON(http.StatusBadGateway, gin.H{"error": "connection refused"})
+			},
+			want: record{Model: "mistral", Endpoint: "chat", Status: http.StatusBadGateway},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newTestHandler(t)
+			alice := createTestUser(t, h, "alice", entv1User.RoleUser)
+
+			w := serveLLM(t, alice, tt.path, tt.model, h.Middleware, tt.upstream)
+			if w.Code != tt.want.Status {
+				t.Fatalf("status = %d, want %d", w.Code, tt.want.Status)
+			}
+
+			records := h.client.UsageRecord.Query().AllX(h.ctx)
+			if len(records) != 1 {
+				t.Fatalf("%d usage records, want 1", len(records))
+			}
+			r := records[0]
+			if r.UserId != alice.ID || r.UserEmail != alice.Email {
+				t.Errorf("user = %s %q, want %s %q", r.UserId, r.UserEmail, alice.ID, alice.Email)
+			}
+			if r.Day != r.CreatedAt.UTC().Format("2006-01-02") {
+				t.Errorf("day = %s, created at %s", r.Day, r.CreatedAt)
+			}
+			got := record{Model: r.Model, Endpoint: r.Endpoint, Status: r.Status,
+				PromptTokens: r.PromptTokens, CompletionTokens: r.CompletionTokens, TotalDuration: r.TotalDuration,
+				LoadDuration: r.LoadDuration, PromptEvalDuration: r.PromptEvalDuration, EvalDuration: r.EvalDuration}
+			if got != tt.want {
+				t.Errorf("record = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMiddlewareWithQuota(t *testing.T) {
+	h := newTestHandler(t)
+	alice := createTestUser(t, h, "alice", entv1User.RoleUser)
+	quotaHandler := quota.NewHandler(h.client, h.ctx)
+
+	for i := 0; i < 2; i++ {
+		w := serveLLM(t, alice, "/ollama/api/generate", "llama3", quotaHandler.Middleware, h.Middleware, stream)
+		if w.Code != http.StatusOK {
+			t.Fatalf("request %d: status = %d: %s", i+1, w.Code, w.Body)
+		}
+	}
+
+	records := h.client.UsageRecord.Query().AllX(h.ctx)
+	if len(records) != 2 {
+		t.Fatalf("%d usage records, want 2", len(records))
+	}
+	for _, r := range records {
+		if r.PromptTokens != 12 || r.CompletionTokens != 34 {
+			t.Errorf("record tokens = %d + %d, want 12 + 34", r.PromptTokens, r.CompletionTokens)
+		}
+	}
+
+	// the quota counts the tokens of each response once, whether charged or loaded from the records
+	r := gin.New()
+	r.GET("/usage", func(c *gin.Context) {
+		c.Set("user", alice)
+	}, quotaHandler.GetSessionUserUsage)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/usage", nil))
+	var got struct {
+		Usage quota.Usage `json:"usage"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Usage.TokensToday != 2*46 {
+		t.Errorf("quota tokens today = %d, want %d", got.Usage.TokensToday, 2*46)
+	}
+}
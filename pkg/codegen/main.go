@@ -27,6 +27,7 @@ func main() {
 		Package: "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent",
 		Features: []gen.Feature{
 			gen.FeatureUpsert,
+			gen.FeatureExecQuery,
 		},
 	}
 	if err = entc.Generate("./pkg/types/v1", config); err != nil {
@@ -7,4 +7,16 @@ const (
 	MessageErrorGenerateToken = "Failed to generate token"
 	MessageEmptySessionUser   = "Empty session user"
 	MessageErrorHashPassword  = "Failed to hash password"
+	MessageErrorRefreshToken  = "The session has expired, please sign in again"
+	MessageErrorMFAExpired    = "The sign-in attempt has expired, please sign in again"
+	MessageErrorMFACode       = "The two-factor authentication code is invalid"
+	MessageErrorMFARequired   = "Two-factor authentication is required for admin accounts, please enable it first"
+	MessageErrorLoginLimited  = "Too many failed sign-in attempts, please try again later"
+	MessageErrorLoginLocked   = "The account is locked because of too many failed sign-in attempts, please try again later or contact admin"
+	MessageErrorPassword      = "The current password is incorrect"
+	MessageErrorPasswordReset = "The password reset link is invalid or has expired"
+	MessageErrorInvitation    = "The invitation is invalid or has expired, please ask admin for a new one"
+	MessageErrorImpersonation = "This action is not allowed while impersonating a user"
+	MessageErrorMailDisabled  = "Sending emails is not configured, please contact admin to reset your password"
+	MessageErrorChatDeletion  = "Deleting chats is disabled, please contact admin"
 )
@@ -0,0 +1,139 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxTagNameLength is the max length of the tag names, see the Tag schema.
+const maxTagNameLength = 64
+
+// addChatUpdatedAt adds the updated_at column to an existing chats table, set to the creation time of the chats.
+// It does nothing if the table does not exist yet or already has the column.
+func addChatUpdatedAt(ctx context.Context, db *sql.DB) error {
+	var tableExists, columnExists bool
+	err := db.QueryRowContext(ctx, `SELECT COUNT(*) > 0, COALESCE(SUM(name = 'updated_at'), 0) > 0
+FROM pragma_table_info('chats')`).Scan(&tableExists, &columnExists)
+	if err != nil || !tableExists || columnExists {
+		return err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+	// adding a required column needs a default, the auto migration drops it afterwards
+	if _, err = tx.ExecContext(ctx, "ALTER TABLE chats ADD COLUMN updated_at datetime NOT NULL DEFAULT ''"); err != nil {
+		return err
+	}
+	if _, err = tx.ExecContext(ctx, "UPDATE chats SET updated_at = created_at"); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// migrateChatTags moves the tags of the chats from the former JSON tags column into the tags and
+// chat_tags tables, and drops the column. It does nothing once the column is dropped.
+func migrateChatTags(ctx context.Context, db *sql.DB) error {
+	var exists bool
+	err := db.QueryRowContext(ctx,
+		"SELECT COUNT(*) > 0 FROM pragma_table_info('chats') WHERE name = 'tags'").Scan(&exists)
+	if err != nil || !exists {
+		return err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	type chatTags struct {
+		chatID uuid.UUID
+		userID uuid.UUID
+		names  []string
+	}
+	var chats []chatTags
+	rows, err := tx.QueryContext(ctx, "SELECT id, user_id, tags FROM chats")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var c chatTags
+		var raw sql.NullString
+		if err = rows.Scan(&c.chatID, &c.userID, &raw); err != nil {
+			return err
+		}
+		if raw.String == "" {
+			continue
+		}
+		if err = json.Unmarshal([]byte(raw.String), &c.names); err != nil {
+			slog.Warn("skipping invalid chat tags", "chat", c.chatID, "error", err)
+			continue
+		}
+		chats = append(chats, c)
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	// the tags are scoped per user
+	type tagKey struct {
+		userID uuid.UUID
+		name   string
+	}
+	tagIDs := map[tagKey]uuid.UUID{}
+	links := 0
+	for _, c := range chats {
+		tagged := map[uuid.UUID]bool{}
+		for _, name := range c.names {
+			name = strings.TrimSpace(name)
+			if name == "" || len(name) > maxTagNameLength {
+				slog.Warn("skipping invalid chat tag", "chat", c.chatID, "tag", name)
+				continue
+			}
+
+			key := tagKey{userID: c.userID, name: name}
+			id, ok := tagIDs[key]
+			if !ok {
+				id = uuid.New()
+				if _, err = tx.ExecContext(ctx, "INSERT INTO tags (id, name, user_id, created_at) VALUES (?, ?, ?, ?)",
+					id, name, c.userID, time.Now()); err != nil {
+					return fmt.Errorf("failed creating tag %s: %w", name, err)
+				}
+				tagIDs[key] = id
+			}
+			if tagged[id] {
+				continue
+			}
+			if _, err = tx.ExecContext(ctx, "INSERT INTO chat_tags (chat_id, tag_id) VALUES (?, ?)",
+				c.chatID, id); err != nil {
+				return fmt.Errorf("failed tagging chat %s: %w", c.chatID, err)
+			}
+			tagged[id] = true
+			links++
+		}
+	}
+
+	if _, err = tx.ExecContext(ctx, "ALTER TABLE chats DROP COLUMN tags"); err != nil {
+		return fmt.Errorf("failed dropping the chat tags column: %w", err)
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	slog.Info("migrated chat tags", "tags", len(tagIDs), "chatTags", links)
+	return nil
+}
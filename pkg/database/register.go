@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	_ "github.com/mattn/go-sqlite3"
 
 	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
@@ -12,14 +14,23 @@ import (
 const dbFileName = "data/llmos-dashboard.db"
 
 func RegisterDBClient(ctx context.Context) (*ent.Client, error) {
-	client, err := ent.Open("sqlite3", fmt.Sprintf("file:%s?_fk=1", dbFileName))
+	drv, err := entsql.Open(dialect.SQLite, fmt.Sprintf("file:%s?_fk=1", dbFileName))
 	if err != nil {
 		return nil, fmt.Errorf("failed opening connection to sqlite: %v", err)
 	}
+	client := ent.NewClient(ent.Driver(drv))
+	// the auto migration can not add required columns to tables with rows
+	if err = addChatUpdatedAt(ctx, drv.DB()); err != nil {
+		return nil, fmt.Errorf("failed adding the chat updated time: %v", err)
+	}
 	// Run the auto migration tool.
 	if err = client.Schema.Create(ctx); err != nil {
 		return nil, fmt.Errorf("failed creating schema resources: %v", err)
 	}
+	// migrate the data the auto migration does not cover
+	if err = migrateChatTags(ctx, drv.DB()); err != nil {
+		return nil, fmt.Errorf("failed migrating chat tags: %v", err)
+	}
 	return client, nil
 }
 
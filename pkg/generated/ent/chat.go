@@ -41,14 +41,16 @@ type Chat struct {
 	UserId uuid.UUID `json:"userId,omitempty"`
 	// Models holds the value of the "models" field.
 	Models []string `json:"models,omitempty"`
-	// Tags holds the value of the "tags" field.
-	Tags []string `json:"tags,omitempty"`
 	// History holds the value of the "history" field.
 	History v1.Histroy `json:"history,omitempty"`
 	// Messages holds the value of the "messages" field.
 	Messages []v1.Message `json:"messages,omitempty"`
 	// CreatedAt holds the value of the "createdAt" field.
 	CreatedAt time.Time `json:"createdAt,omitempty"`
+	// UpdatedAt holds the value of the "updatedAt" field.
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+	// DeletedAt holds the value of the "deletedAt" field.
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ChatQuery when eager-loading is set.
 	Edges        ChatEdges `json:"edges"`
@@ -59,9 +61,11 @@ type Chat struct {
 type ChatEdges struct {
 	// Owner holds the value of the owner edge.
 	Owner *User `json:"owner,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -75,16 +79,25 @@ func (e ChatEdges) OwnerOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "owner"}
 }
 
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e ChatEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[1] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Chat) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case chat.FieldModels, chat.FieldTags, chat.FieldHistory, chat.FieldMessages:
+		case chat.FieldModels, chat.FieldHistory, chat.FieldMessages:
 			values[i] = new([]byte)
 		case chat.FieldTitle:
 			values[i] = new(sql.NullString)
-		case chat.FieldCreatedAt:
+		case chat.FieldCreatedAt, chat.FieldUpdatedAt, chat.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case chat.FieldID, chat.FieldUserId:
 			values[i] = new(uuid.UUID)
@@ -129,14 +142,6 @@ func (c *Chat) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field models: %w", err)
 				}
 			}
-		case chat.FieldTags:
-			if value, ok := values[i].(*[]byte); !ok {
-				return fmt.Errorf("unexpected type %T for field tags", values[i])
-			} else if value != nil && len(*value) > 0 {
-				if err := json.Unmarshal(*value, &c.Tags); err != nil {
-					return fmt.Errorf("unmarshal field tags: %w", err)
-				}
-			}
 		case chat.FieldHistory:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field history", values[i])
@@ -159,6 +164,19 @@ func (c *Chat) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.CreatedAt = value.Time
 			}
+		case chat.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updatedAt", values[i])
+			} else if value.Valid {
+				c.UpdatedAt = value.Time
+			}
+		case chat.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deletedAt", values[i])
+			} else if value.Valid {
+				c.DeletedAt = new(time.Time)
+				*c.DeletedAt = value.Time
+			}
 		default:
 			c.selectValues.Set(columns[i], values[i])
 		}
@@ -177,6 +195,11 @@ func (c *Chat) QueryOwner() *UserQuery {
 	return NewChatClient(c.config).QueryOwner(c)
 }
 
+// QueryTags queries the "tags" edge of the Chat entity.
+func (c *Chat) QueryTags() *TagQuery {
+	return NewChatClient(c.config).QueryTags(c)
+}
+
 // Update returns a builder for updating this Chat.
 // Note that you need to call Chat.Unwrap() before calling this method if this Chat
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -209,9 +232,6 @@ func (c *Chat) String() string {
 	builder.WriteString("models=")
 	builder.WriteString(fmt.Sprintf("%v", c.Models))
 	builder.WriteString(", ")
-	builder.WriteString("tags=")
-	builder.WriteString(fmt.Sprintf("%v", c.Tags))
-	builder.WriteString(", ")
 	builder.WriteString("history=")
 	builder.WriteString(fmt.Sprintf("%v", c.History))
 	builder.WriteString(", ")
@@ -220,6 +240,14 @@ func (c *Chat) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("createdAt=")
 	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updatedAt=")
+	builder.WriteString(c.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := c.DeletedAt; v != nil {
+		builder.WriteString("deletedAt=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -28,6 +28,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/chat"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/tag"
 	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
 	v1 "github.com/llmos-ai/llmos-dashboard/pkg/types/v1"
 )
@@ -58,12 +59,6 @@ func (cc *ChatCreate) SetModels(s []string) *ChatCreate {
 	return cc
 }
 
-// SetTags sets the "tags" field.
-func (cc *ChatCreate) SetTags(s []string) *ChatCreate {
-	cc.mutation.SetTags(s)
-	return cc
-}
-
 // SetHistory sets the "history" field.
 func (cc *ChatCreate) SetHistory(v v1.Histroy) *ChatCreate {
 	cc.mutation.SetHistory(v)
@@ -90,6 +85,34 @@ func (cc *ChatCreate) SetNillableCreatedAt(t *time.Time) *ChatCreate {
 	return cc
 }
 
+// SetUpdatedAt sets the "updatedAt" field.
+func (cc *ChatCreate) SetUpdatedAt(t time.Time) *ChatCreate {
+	cc.mutation.SetUpdatedAt(t)
+	return cc
+}
+
+// SetNillableUpdatedAt sets the "updatedAt" field if the given value is not nil.
+func (cc *ChatCreate) SetNillableUpdatedAt(t *time.Time) *ChatCreate {
+	if t != nil {
+		cc.SetUpdatedAt(*t)
+	}
+	return cc
+}
+
+// SetDeletedAt sets the "deletedAt" field.
+func (cc *ChatCreate) SetDeletedAt(t time.Time) *ChatCreate {
+	cc.mutation.SetDeletedAt(t)
+	return cc
+}
+
+// SetNillableDeletedAt sets the "deletedAt" field if the given value is not nil.
+func (cc *ChatCreate) SetNillableDeletedAt(t *time.Time) *ChatCreate {
+	if t != nil {
+		cc.SetDeletedAt(*t)
+	}
+	return cc
+}
+
 // SetID sets the "id" field.
 func (cc *ChatCreate) SetID(u uuid.UUID) *ChatCreate {
 	cc.mutation.SetID(u)
@@ -115,6 +138,21 @@ func (cc *ChatCreate) SetOwner(u *User) *ChatCreate {
 	return cc.SetOwnerID(u.ID)
 }
 
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (cc *ChatCreate) AddTagIDs(ids ...uuid.UUID) *ChatCreate {
+	cc.mutation.AddTagIDs(ids...)
+	return cc
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (cc *ChatCreate) AddTags(t ...*Tag) *ChatCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return cc.AddTagIDs(ids...)
+}
+
 // Mutation returns the ChatMutation object of the builder.
 func (cc *ChatCreate) Mutation() *ChatMutation {
 	return cc.mutation
@@ -151,9 +189,13 @@ func (cc *ChatCreate) ExecX(ctx context.Context) {
 // defaults sets the default values of the builder before save.
 func (cc *ChatCreate) defaults() {
 	if _, ok := cc.mutation.CreatedAt(); !ok {
-		v := chat.DefaultCreatedAt
+		v := chat.DefaultCreatedAt()
 		cc.mutation.SetCreatedAt(v)
 	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		v := chat.DefaultUpdatedAt()
+		cc.mutation.SetUpdatedAt(v)
+	}
 	if _, ok := cc.mutation.ID(); !ok {
 		v := chat.DefaultID()
 		cc.mutation.SetID(v)
@@ -176,9 +218,6 @@ func (cc *ChatCreate) check() error {
 	if _, ok := cc.mutation.Models(); !ok {
 		return &ValidationError{Name: "models", err: errors.New(`ent: missing required field "Chat.models"`)}
 	}
-	if _, ok := cc.mutation.Tags(); !ok {
-		return &ValidationError{Name: "tags", err: errors.New(`ent: missing required field "Chat.tags"`)}
-	}
 	if _, ok := cc.mutation.History(); !ok {
 		return &ValidationError{Name: "history", err: errors.New(`ent: missing required field "Chat.history"`)}
 	}
@@ -188,6 +227,9 @@ func (cc *ChatCreate) check() error {
 	if _, ok := cc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "createdAt", err: errors.New(`ent: missing required field "Chat.createdAt"`)}
 	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updatedAt", err: errors.New(`ent: missing required field "Chat.updatedAt"`)}
+	}
 	if _, ok := cc.mutation.OwnerID(); !ok {
 		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "Chat.owner"`)}
 	}
@@ -235,10 +277,6 @@ func (cc *ChatCreate) createSpec() (*Chat, *sqlgraph.CreateSpec) {
 		_spec.SetField(chat.FieldModels, field.TypeJSON, value)
 		_node.Models = value
 	}
-	if value, ok := cc.mutation.Tags(); ok {
-		_spec.SetField(chat.FieldTags, field.TypeJSON, value)
-		_node.Tags = value
-	}
 	if value, ok := cc.mutation.History(); ok {
 		_spec.SetField(chat.FieldHistory, field.TypeJSON, value)
 		_node.History = value
@@ -251,6 +289,14 @@ func (cc *ChatCreate) createSpec() (*Chat, *sqlgraph.CreateSpec) {
 		_spec.SetField(chat.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := cc.mutation.UpdatedAt(); ok {
+		_spec.SetField(chat.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := cc.mutation.DeletedAt(); ok {
+		_spec.SetField(chat.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if nodes := cc.mutation.OwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -268,6 +314,22 @@ func (cc *ChatCreate) createSpec() (*Chat, *sqlgraph.CreateSpec) {
 		_node.UserId = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := cc.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   chat.TagsTable,
+			Columns: chat.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -356,18 +418,6 @@ func (u *ChatUpsert) UpdateModels() *ChatUpsert {
 	return u
 }
 
-// SetTags sets the "tags" field.
-func (u *ChatUpsert) SetTags(v []string) *ChatUpsert {
-	u.Set(chat.FieldTags, v)
-	return u
-}
-
-// UpdateTags sets the "tags" field to the value that was provided on create.
-func (u *ChatUpsert) UpdateTags() *ChatUpsert {
-	u.SetExcluded(chat.FieldTags)
-	return u
-}
-
 // SetHistory sets the "history" field.
 func (u *ChatUpsert) SetHistory(v v1.Histroy) *ChatUpsert {
 	u.Set(chat.FieldHistory, v)
@@ -392,6 +442,36 @@ func (u *ChatUpsert) UpdateMessages() *ChatUpsert {
 	return u
 }
 
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *ChatUpsert) SetUpdatedAt(v time.Time) *ChatUpsert {
+	u.Set(chat.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *ChatUpsert) UpdateUpdatedAt() *ChatUpsert {
+	u.SetExcluded(chat.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deletedAt" field.
+func (u *ChatUpsert) SetDeletedAt(v time.Time) *ChatUpsert {
+	u.Set(chat.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deletedAt" field to the value that was provided on create.
+func (u *ChatUpsert) UpdateDeletedAt() *ChatUpsert {
+	u.SetExcluded(chat.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deletedAt" field.
+func (u *ChatUpsert) ClearDeletedAt() *ChatUpsert {
+	u.SetNull(chat.FieldDeletedAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -485,20 +565,6 @@ func (u *ChatUpsertOne) UpdateModels() *ChatUpsertOne {
 	})
 }
 
-// SetTags sets the "tags" field.
-func (u *ChatUpsertOne) SetTags(v []string) *ChatUpsertOne {
-	return u.Update(func(s *ChatUpsert) {
-		s.SetTags(v)
-	})
-}
-
-// UpdateTags sets the "tags" field to the value that was provided on create.
-func (u *ChatUpsertOne) UpdateTags() *ChatUpsertOne {
-	return u.Update(func(s *ChatUpsert) {
-		s.UpdateTags()
-	})
-}
-
 // SetHistory sets the "history" field.
 func (u *ChatUpsertOne) SetHistory(v v1.Histroy) *ChatUpsertOne {
 	return u.Update(func(s *ChatUpsert) {
@@ -527,6 +593,41 @@ func (u *ChatUpsertOne) UpdateMessages() *ChatUpsertOne {
 	})
 }
 
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *ChatUpsertOne) SetUpdatedAt(v time.Time) *ChatUpsertOne {
+	return u.Update(func(s *ChatUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *ChatUpsertOne) UpdateUpdatedAt() *ChatUpsertOne {
+	return u.Update(func(s *ChatUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deletedAt" field.
+func (u *ChatUpsertOne) SetDeletedAt(v time.Time) *ChatUpsertOne {
+	return u.Update(func(s *ChatUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deletedAt" field to the value that was provided on create.
+func (u *ChatUpsertOne) UpdateDeletedAt() *ChatUpsertOne {
+	return u.Update(func(s *ChatUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deletedAt" field.
+func (u *ChatUpsertOne) ClearDeletedAt() *ChatUpsertOne {
+	return u.Update(func(s *ChatUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *ChatUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -787,20 +888,6 @@ func (u *ChatUpsertBulk) UpdateModels() *ChatUpsertBulk {
 	})
 }
 
-// SetTags sets the "tags" field.
-func (u *ChatUpsertBulk) SetTags(v []string) *ChatUpsertBulk {
-	return u.Update(func(s *ChatUpsert) {
-		s.SetTags(v)
-	})
-}
-
-// UpdateTags sets the "tags" field to the value that was provided on create.
-func (u *ChatUpsertBulk) UpdateTags() *ChatUpsertBulk {
-	return u.Update(func(s *ChatUpsert) {
-		s.UpdateTags()
-	})
-}
-
 // SetHistory sets the "history" field.
 func (u *ChatUpsertBulk) SetHistory(v v1.Histroy) *ChatUpsertBulk {
 	return u.Update(func(s *ChatUpsert) {
@@ -829,6 +916,41 @@ func (u *ChatUpsertBulk) UpdateMessages() *ChatUpsertBulk {
 	})
 }
 
+// SetUpdatedAt sets the "updatedAt" field.
+func (u *ChatUpsertBulk) SetUpdatedAt(v time.Time) *ChatUpsertBulk {
+	return u.Update(func(s *ChatUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updatedAt" field to the value that was provided on create.
+func (u *ChatUpsertBulk) UpdateUpdatedAt() *ChatUpsertBulk {
+	return u.Update(func(s *ChatUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deletedAt" field.
+func (u *ChatUpsertBulk) SetDeletedAt(v time.Time) *ChatUpsertBulk {
+	return u.Update(func(s *ChatUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deletedAt" field to the value that was provided on create.
+func (u *ChatUpsertBulk) UpdateDeletedAt() *ChatUpsertBulk {
+	return u.Update(func(s *ChatUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deletedAt" field.
+func (u *ChatUpsertBulk) ClearDeletedAt() *ChatUpsertBulk {
+	return u.Update(func(s *ChatUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *ChatUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
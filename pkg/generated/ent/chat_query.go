@@ -18,6 +18,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -27,6 +28,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/chat"
 	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/predicate"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/tag"
 	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
 )
 
@@ -38,6 +40,7 @@ type ChatQuery struct {
 	inters     []Interceptor
 	predicates []predicate.Chat
 	withOwner  *UserQuery
+	withTags   *TagQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -96,6 +99,28 @@ func (cq *ChatQuery) QueryOwner() *UserQuery {
 	return query
 }
 
+// QueryTags chains the current query on the "tags" edge.
+func (cq *ChatQuery) QueryTags() *TagQuery {
+	query := (&TagClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(chat.Table, chat.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, chat.TagsTable, chat.TagsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Chat entity from the query.
 // Returns a *NotFoundError when no Chat was found.
 func (cq *ChatQuery) First(ctx context.Context) (*Chat, error) {
@@ -289,6 +314,7 @@ func (cq *ChatQuery) Clone() *ChatQuery {
 		inters:     append([]Interceptor{}, cq.inters...),
 		predicates: append([]predicate.Chat{}, cq.predicates...),
 		withOwner:  cq.withOwner.Clone(),
+		withTags:   cq.withTags.Clone(),
 		// clone intermediate query.
 		sql:  cq.sql.Clone(),
 		path: cq.path,
@@ -306,6 +332,17 @@ func (cq *ChatQuery) WithOwner(opts ...func(*UserQuery)) *ChatQuery {
 	return cq
 }
 
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *ChatQuery) WithTags(opts ...func(*TagQuery)) *ChatQuery {
+	query := (&TagClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withTags = query
+	return cq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -384,8 +421,9 @@ func (cq *ChatQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Chat, e
 	var (
 		nodes       = []*Chat{}
 		_spec       = cq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			cq.withOwner != nil,
+			cq.withTags != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -412,6 +450,13 @@ func (cq *ChatQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Chat, e
 			return nil, err
 		}
 	}
+	if query := cq.withTags; query != nil {
+		if err := cq.loadTags(ctx, query, nodes,
+			func(n *Chat) { n.Edges.Tags = []*Tag{} },
+			func(n *Chat, e *Tag) { n.Edges.Tags = append(n.Edges.Tags, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -444,6 +489,67 @@ func (cq *ChatQuery) loadOwner(ctx context.Context, query *UserQuery, nodes []*C
 	}
 	return nil
 }
+func (cq *ChatQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*Chat, init func(*Chat), assign func(*Chat, *Tag)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*Chat)
+	nids := make(map[uuid.UUID]map[*Chat]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(chat.TagsTable)
+		s.Join(joinT).On(s.C(tag.FieldID), joinT.C(chat.TagsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(chat.TagsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(chat.TagsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Chat]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Tag](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "tags" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 
 func (cq *ChatQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := cq.querySpec()
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -28,6 +29,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/chat"
 	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/predicate"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/tag"
 	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
 	v1 "github.com/llmos-ai/llmos-dashboard/pkg/types/v1"
 )
@@ -85,18 +87,6 @@ func (cu *ChatUpdate) AppendModels(s []string) *ChatUpdate {
 	return cu
 }
 
-// SetTags sets the "tags" field.
-func (cu *ChatUpdate) SetTags(s []string) *ChatUpdate {
-	cu.mutation.SetTags(s)
-	return cu
-}
-
-// AppendTags appends s to the "tags" field.
-func (cu *ChatUpdate) AppendTags(s []string) *ChatUpdate {
-	cu.mutation.AppendTags(s)
-	return cu
-}
-
 // SetHistory sets the "history" field.
 func (cu *ChatUpdate) SetHistory(v v1.Histroy) *ChatUpdate {
 	cu.mutation.SetHistory(v)
@@ -123,6 +113,40 @@ func (cu *ChatUpdate) AppendMessages(v []v1.Message) *ChatUpdate {
 	return cu
 }
 
+// SetUpdatedAt sets the "updatedAt" field.
+func (cu *ChatUpdate) SetUpdatedAt(t time.Time) *ChatUpdate {
+	cu.mutation.SetUpdatedAt(t)
+	return cu
+}
+
+// SetNillableUpdatedAt sets the "updatedAt" field if the given value is not nil.
+func (cu *ChatUpdate) SetNillableUpdatedAt(t *time.Time) *ChatUpdate {
+	if t != nil {
+		cu.SetUpdatedAt(*t)
+	}
+	return cu
+}
+
+// SetDeletedAt sets the "deletedAt" field.
+func (cu *ChatUpdate) SetDeletedAt(t time.Time) *ChatUpdate {
+	cu.mutation.SetDeletedAt(t)
+	return cu
+}
+
+// SetNillableDeletedAt sets the "deletedAt" field if the given value is not nil.
+func (cu *ChatUpdate) SetNillableDeletedAt(t *time.Time) *ChatUpdate {
+	if t != nil {
+		cu.SetDeletedAt(*t)
+	}
+	return cu
+}
+
+// ClearDeletedAt clears the value of the "deletedAt" field.
+func (cu *ChatUpdate) ClearDeletedAt() *ChatUpdate {
+	cu.mutation.ClearDeletedAt()
+	return cu
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
 func (cu *ChatUpdate) SetOwnerID(id uuid.UUID) *ChatUpdate {
 	cu.mutation.SetOwnerID(id)
@@ -134,6 +158,21 @@ func (cu *ChatUpdate) SetOwner(u *User) *ChatUpdate {
 	return cu.SetOwnerID(u.ID)
 }
 
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (cu *ChatUpdate) AddTagIDs(ids ...uuid.UUID) *ChatUpdate {
+	cu.mutation.AddTagIDs(ids...)
+	return cu
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (cu *ChatUpdate) AddTags(t ...*Tag) *ChatUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return cu.AddTagIDs(ids...)
+}
+
 // Mutation returns the ChatMutation object of the builder.
 func (cu *ChatUpdate) Mutation() *ChatMutation {
 	return cu.mutation
@@ -145,6 +184,27 @@ func (cu *ChatUpdate) ClearOwner() *ChatUpdate {
 	return cu
 }
 
+// ClearTags clears all "tags" edges to the Tag entity.
+func (cu *ChatUpdate) ClearTags() *ChatUpdate {
+	cu.mutation.ClearTags()
+	return cu
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (cu *ChatUpdate) RemoveTagIDs(ids ...uuid.UUID) *ChatUpdate {
+	cu.mutation.RemoveTagIDs(ids...)
+	return cu
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (cu *ChatUpdate) RemoveTags(t ...*Tag) *ChatUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return cu.RemoveTagIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (cu *ChatUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
@@ -208,14 +268,6 @@ func (cu *ChatUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			sqljson.Append(u, chat.FieldModels, value)
 		})
 	}
-	if value, ok := cu.mutation.Tags(); ok {
-		_spec.SetField(chat.FieldTags, field.TypeJSON, value)
-	}
-	if value, ok := cu.mutation.AppendedTags(); ok {
-		_spec.AddModifier(func(u *sql.UpdateBuilder) {
-			sqljson.Append(u, chat.FieldTags, value)
-		})
-	}
 	if value, ok := cu.mutation.History(); ok {
 		_spec.SetField(chat.FieldHistory, field.TypeJSON, value)
 	}
@@ -227,6 +279,15 @@ func (cu *ChatUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			sqljson.Append(u, chat.FieldMessages, value)
 		})
 	}
+	if value, ok := cu.mutation.UpdatedAt(); ok {
+		_spec.SetField(chat.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := cu.mutation.DeletedAt(); ok {
+		_spec.SetField(chat.FieldDeletedAt, field.TypeTime, value)
+	}
+	if cu.mutation.DeletedAtCleared() {
+		_spec.ClearField(chat.FieldDeletedAt, field.TypeTime)
+	}
 	if cu.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -256,6 +317,51 @@ func (cu *ChatUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if cu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   chat.TagsTable,
+			Columns: chat.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedTagsIDs(); len(nodes) > 0 && !cu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   chat.TagsTable,
+			Columns: chat.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   chat.TagsTable,
+			Columns: chat.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{chat.Label}
@@ -316,18 +422,6 @@ func (cuo *ChatUpdateOne) AppendModels(s []string) *ChatUpdateOne {
 	return cuo
 }
 
-// SetTags sets the "tags" field.
-func (cuo *ChatUpdateOne) SetTags(s []string) *ChatUpdateOne {
-	cuo.mutation.SetTags(s)
-	return cuo
-}
-
-// AppendTags appends s to the "tags" field.
-func (cuo *ChatUpdateOne) AppendTags(s []string) *ChatUpdateOne {
-	cuo.mutation.AppendTags(s)
-	return cuo
-}
-
 // SetHistory sets the "history" field.
 func (cuo *ChatUpdateOne) SetHistory(v v1.Histroy) *ChatUpdateOne {
 	cuo.mutation.SetHistory(v)
@@ -354,6 +448,40 @@ func (cuo *ChatUpdateOne) AppendMessages(v []v1.Message) *ChatUpdateOne {
 	return cuo
 }
 
+// SetUpdatedAt sets the "updatedAt" field.
+func (cuo *ChatUpdateOne) SetUpdatedAt(t time.Time) *ChatUpdateOne {
+	cuo.mutation.SetUpdatedAt(t)
+	return cuo
+}
+
+// SetNillableUpdatedAt sets the "updatedAt" field if the given value is not nil.
+func (cuo *ChatUpdateOne) SetNillableUpdatedAt(t *time.Time) *ChatUpdateOne {
+	if t != nil {
+		cuo.SetUpdatedAt(*t)
+	}
+	return cuo
+}
+
+// SetDeletedAt sets the "deletedAt" field.
+func (cuo *ChatUpdateOne) SetDeletedAt(t time.Time) *ChatUpdateOne {
+	cuo.mutation.SetDeletedAt(t)
+	return cuo
+}
+
+// SetNillableDeletedAt sets the "deletedAt" field if the given value is not nil.
+func (cuo *ChatUpdateOne) SetNillableDeletedAt(t *time.Time) *ChatUpdateOne {
+	if t != nil {
+		cuo.SetDeletedAt(*t)
+	}
+	return cuo
+}
+
+// ClearDeletedAt clears the value of the "deletedAt" field.
+func (cuo *ChatUpdateOne) ClearDeletedAt() *ChatUpdateOne {
+	cuo.mutation.ClearDeletedAt()
+	return cuo
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
 func (cuo *ChatUpdateOne) SetOwnerID(id uuid.UUID) *ChatUpdateOne {
 	cuo.mutation.SetOwnerID(id)
@@ -365,6 +493,21 @@ func (cuo *ChatUpdateOne) SetOwner(u *User) *ChatUpdateOne {
 	return cuo.SetOwnerID(u.ID)
 }
 
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (cuo *ChatUpdateOne) AddTagIDs(ids ...uuid.UUID) *ChatUpdateOne {
+	cuo.mutation.AddTagIDs(ids...)
+	return cuo
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (cuo *ChatUpdateOne) AddTags(t ...*Tag) *ChatUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return cuo.AddTagIDs(ids...)
+}
+
 // Mutation returns the ChatMutation object of the builder.
 func (cuo *ChatUpdateOne) Mutation() *ChatMutation {
 	return cuo.mutation
@@ -376,6 +519,27 @@ func (cuo *ChatUpdateOne) ClearOwner() *ChatUpdateOne {
 	return cuo
 }
 
+// ClearTags clears all "tags" edges to the Tag entity.
+func (cuo *ChatUpdateOne) ClearTags() *ChatUpdateOne {
+	cuo.mutation.ClearTags()
+	return cuo
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (cuo *ChatUpdateOne) RemoveTagIDs(ids ...uuid.UUID) *ChatUpdateOne {
+	cuo.mutation.RemoveTagIDs(ids...)
+	return cuo
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (cuo *ChatUpdateOne) RemoveTags(t ...*Tag) *ChatUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return cuo.RemoveTagIDs(ids...)
+}
+
 // Where appends a list predicates to the ChatUpdate builder.
 func (cuo *ChatUpdateOne) Where(ps ...predicate.Chat) *ChatUpdateOne {
 	cuo.mutation.Where(ps...)
@@ -469,14 +633,6 @@ func (cuo *ChatUpdateOne) sqlSave(ctx context.Context) (_node *Chat, err error)
 			sqljson.Append(u, chat.FieldModels, value)
 		})
 	}
-	if value, ok := cuo.mutation.Tags(); ok {
-		_spec.SetField(chat.FieldTags, field.TypeJSON, value)
-	}
-	if value, ok := cuo.mutation.AppendedTags(); ok {
-		_spec.AddModifier(func(u *sql.UpdateBuilder) {
-			sqljson.Append(u, chat.FieldTags, value)
-		})
-	}
 	if value, ok := cuo.mutation.History(); ok {
 		_spec.SetField(chat.FieldHistory, field.TypeJSON, value)
 	}
@@ -488,6 +644,15 @@ func (cuo *ChatUpdateOne) sqlSave(ctx context.Context) (_node *Chat, err error)
 			sqljson.Append(u, chat.FieldMessages, value)
 		})
 	}
+	if value, ok := cuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(chat.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := cuo.mutation.DeletedAt(); ok {
+		_spec.SetField(chat.FieldDeletedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(chat.FieldDeletedAt, field.TypeTime)
+	}
 	if cuo.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -517,6 +682,51 @@ func (cuo *ChatUpdateOne) sqlSave(ctx context.Context) (_node *Chat, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if cuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   chat.TagsTable,
+			Columns: chat.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedTagsIDs(); len(nodes) > 0 && !cuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   chat.TagsTable,
+			Columns: chat.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   chat.TagsTable,
+			Columns: chat.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Chat{config: cuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
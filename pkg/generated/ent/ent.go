@@ -26,9 +26,22 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/apikey"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/auditevent"
 	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/chat"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/group"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/invitation"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/loginfailure"
 	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/modelfile"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/passwordreset"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/permission"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/quota"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/role"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/session"
 	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/setting"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/signingkey"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/tag"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/usagerecord"
 	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
 )
 
@@ -90,10 +103,23 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			chat.Table:      chat.ValidColumn,
-			modelfile.Table: modelfile.ValidColumn,
-			setting.Table:   setting.ValidColumn,
-			user.Table:      user.ValidColumn,
+			apikey.Table:        apikey.ValidColumn,
+			auditevent.Table:    auditevent.ValidColumn,
+			chat.Table:          chat.ValidColumn,
+			group.Table:         group.ValidColumn,
+			invitation.Table:    invitation.ValidColumn,
+			loginfailure.Table:  loginfailure.ValidColumn,
+			modelfile.Table:     modelfile.ValidColumn,
+			passwordreset.Table: passwordreset.ValidColumn,
+			permission.Table:    permission.ValidColumn,
+			quota.Table:         quota.ValidColumn,
+			role.Table:          role.ValidColumn,
+			session.Table:       session.ValidColumn,
+			setting.Table:       setting.ValidColumn,
+			signingkey.Table:    signingkey.ValidColumn,
+			tag.Table:           tag.ValidColumn,
+			usagerecord.Table:   usagerecord.ValidColumn,
+			user.Table:          user.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
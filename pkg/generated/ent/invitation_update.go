@@ -0,0 +1,604 @@
+/*
+Copyright YEAR 1block.ai.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/invitation"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/predicate"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+)
+
+// InvitationUpdate is the builder for updating Invitation entities.
+type InvitationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InvitationMutation
+}
+
+// Where appends a list predicates to the InvitationUpdate builder.
+func (iu *InvitationUpdate) Where(ps ...predicate.Invitation) *InvitationUpdate {
+	iu.mutation.Where(ps...)
+	return iu
+}
+
+// SetEmail sets the "email" field.
+func (iu *InvitationUpdate) SetEmail(s string) *InvitationUpdate {
+	iu.mutation.SetEmail(s)
+	return iu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableEmail(s *string) *InvitationUpdate {
+	if s != nil {
+		iu.SetEmail(*s)
+	}
+	return iu
+}
+
+// SetRole sets the "role" field.
+func (iu *InvitationUpdate) SetRole(i invitation.Role) *InvitationUpdate {
+	iu.mutation.SetRole(i)
+	return iu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableRole(i *invitation.Role) *InvitationUpdate {
+	if i != nil {
+		iu.SetRole(*i)
+	}
+	return iu
+}
+
+// SetToken sets the "token" field.
+func (iu *InvitationUpdate) SetToken(s string) *InvitationUpdate {
+	iu.mutation.SetToken(s)
+	return iu
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableToken(s *string) *InvitationUpdate {
+	if s != nil {
+		iu.SetToken(*s)
+	}
+	return iu
+}
+
+// SetCreatedBy sets the "createdBy" field.
+func (iu *InvitationUpdate) SetCreatedBy(u uuid.UUID) *InvitationUpdate {
+	iu.mutation.SetCreatedBy(u)
+	return iu
+}
+
+// SetNillableCreatedBy sets the "createdBy" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableCreatedBy(u *uuid.UUID) *InvitationUpdate {
+	if u != nil {
+		iu.SetCreatedBy(*u)
+	}
+	return iu
+}
+
+// SetExpiresAt sets the "expiresAt" field.
+func (iu *InvitationUpdate) SetExpiresAt(t time.Time) *InvitationUpdate {
+	iu.mutation.SetExpiresAt(t)
+	return iu
+}
+
+// SetNillableExpiresAt sets the "expiresAt" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableExpiresAt(t *time.Time) *InvitationUpdate {
+	if t != nil {
+		iu.SetExpiresAt(*t)
+	}
+	return iu
+}
+
+// SetUsedAt sets the "usedAt" field.
+func (iu *InvitationUpdate) SetUsedAt(t time.Time) *InvitationUpdate {
+	iu.mutation.SetUsedAt(t)
+	return iu
+}
+
+// SetNillableUsedAt sets the "usedAt" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableUsedAt(t *time.Time) *InvitationUpdate {
+	if t != nil {
+		iu.SetUsedAt(*t)
+	}
+	return iu
+}
+
+// ClearUsedAt clears the value of the "usedAt" field.
+func (iu *InvitationUpdate) ClearUsedAt() *InvitationUpdate {
+	iu.mutation.ClearUsedAt()
+	return iu
+}
+
+// SetRevokedAt sets the "revokedAt" field.
+func (iu *InvitationUpdate) SetRevokedAt(t time.Time) *InvitationUpdate {
+	iu.mutation.SetRevokedAt(t)
+	return iu
+}
+
+// SetNillableRevokedAt sets the "revokedAt" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableRevokedAt(t *time.Time) *InvitationUpdate {
+	if t != nil {
+		iu.SetRevokedAt(*t)
+	}
+	return iu
+}
+
+// ClearRevokedAt clears the value of the "revokedAt" field.
+func (iu *InvitationUpdate) ClearRevokedAt() *InvitationUpdate {
+	iu.mutation.ClearRevokedAt()
+	return iu
+}
+
+// SetInviterID sets the "inviter" edge to the User entity by ID.
+func (iu *InvitationUpdate) SetInviterID(id uuid.UUID) *InvitationUpdate {
+	iu.mutation.SetInviterID(id)
+	return iu
+}
+
+// SetInviter sets the "inviter" edge to the User entity.
+func (iu *InvitationUpdate) SetInviter(u *User) *InvitationUpdate {
+	return iu.SetInviterID(u.ID)
+}
+
+// Mutation returns the InvitationMutation object of the builder.
+func (iu *InvitationUpdate) Mutation() *InvitationMutation {
+	return iu.mutation
+}
+
+// ClearInviter clears the "inviter" edge to the User entity.
+func (iu *InvitationUpdate) ClearInviter() *InvitationUpdate {
+	iu.mutation.ClearInviter()
+	return iu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iu *InvitationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, iu.sqlSave, iu.mutation, iu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iu *InvitationUpdate) SaveX(ctx context.Context) int {
+	affected, err := iu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iu *InvitationUpdate) Exec(ctx context.Context) error {
+	_, err := iu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iu *InvitationUpdate) ExecX(ctx context.Context) {
+	if err := iu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iu *InvitationUpdate) check() error {
+	if v, ok := iu.mutation.Email(); ok {
+		if err := invitation.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Invitation.email": %w`, err)}
+		}
+	}
+	if v, ok := iu.mutation.Role(); ok {
+		if err := invitation.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Invitation.role": %w`, err)}
+		}
+	}
+	if v, ok := iu.mutation.Token(); ok {
+		if err := invitation.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "Invitation.token": %w`, err)}
+		}
+	}
+	if _, ok := iu.mutation.InviterID(); iu.mutation.InviterCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Invitation.inviter"`)
+	}
+	return nil
+}
+
+func (iu *InvitationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := iu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invitation.Table, invitation.Columns, sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeUUID))
+	if ps := iu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iu.mutation.Email(); ok {
+		_spec.SetField(invitation.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := iu.mutation.Role(); ok {
+		_spec.SetField(invitation.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := iu.mutation.Token(); ok {
+		_spec.SetField(invitation.FieldToken, field.TypeString, value)
+	}
+	if value, ok := iu.mutation.ExpiresAt(); ok {
+		_spec.SetField(invitation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := iu.mutation.UsedAt(); ok {
+		_spec.SetField(invitation.FieldUsedAt, field.TypeTime, value)
+	}
+	if iu.mutation.UsedAtCleared() {
+		_spec.ClearField(invitation.FieldUsedAt, field.TypeTime)
+	}
+	if value, ok := iu.mutation.RevokedAt(); ok {
+		_spec.SetField(invitation.FieldRevokedAt, field.TypeTime, value)
+	}
+	if iu.mutation.RevokedAtCleared() {
+		_spec.ClearField(invitation.FieldRevokedAt, field.TypeTime)
+	}
+	if iu.mutation.InviterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.InviterTable,
+			Columns: []string{invitation.InviterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.InviterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.InviterTable,
+			Columns: []string{invitation.InviterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iu.mutation.done = true
+	return n, nil
+}
+
+// InvitationUpdateOne is the builder for updating a single Invitation entity.
+type InvitationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InvitationMutation
+}
+
+// SetEmail sets the "email" field.
+func (iuo *InvitationUpdateOne) SetEmail(s string) *InvitationUpdateOne {
+	iuo.mutation.SetEmail(s)
+	return iuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableEmail(s *string) *InvitationUpdateOne {
+	if s != nil {
+		iuo.SetEmail(*s)
+	}
+	return iuo
+}
+
+// SetRole sets the "role" field.
+func (iuo *InvitationUpdateOne) SetRole(i invitation.Role) *InvitationUpdateOne {
+	iuo.mutation.SetRole(i)
+	return iuo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableRole(i *invitation.Role) *InvitationUpdateOne {
+	if i != nil {
+		iuo.SetRole(*i)
+	}
+	return iuo
+}
+
+// SetToken sets the "token" field.
+func (iuo *InvitationUpdateOne) SetToken(s string) *InvitationUpdateOne {
+	iuo.mutation.SetToken(s)
+	return iuo
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableToken(s *string) *InvitationUpdateOne {
+	if s != nil {
+		iuo.SetToken(*s)
+	}
+	return iuo
+}
+
+// SetCreatedBy sets the "createdBy" field.
+func (iuo *InvitationUpdateOne) SetCreatedBy(u uuid.UUID) *InvitationUpdateOne {
+	iuo.mutation.SetCreatedBy(u)
+	return iuo
+}
+
+// SetNillableCreatedBy sets the "createdBy" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableCreatedBy(u *uuid.UUID) *InvitationUpdateOne {
+	if u != nil {
+		iuo.SetCreatedBy(*u)
+	}
+	return iuo
+}
+
+// SetExpiresAt sets the "expiresAt" field.
+func (iuo *InvitationUpdateOne) SetExpiresAt(t time.Time) *InvitationUpdateOne {
+	iuo.mutation.SetExpiresAt(t)
+	return iuo
+}
+
+// SetNillableExpiresAt sets the "expiresAt" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableExpiresAt(t *time.Time) *InvitationUpdateOne {
+	if t != nil {
+		iuo.SetExpiresAt(*t)
+	}
+	return iuo
+}
+
+// SetUsedAt sets the "usedAt" field.
+func (iuo *InvitationUpdateOne) SetUsedAt(t time.Time) *InvitationUpdateOne {
+	iuo.mutation.SetUsedAt(t)
+	return iuo
+}
+
+// SetNillableUsedAt sets the "usedAt" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableUsedAt(t *time.Time) *InvitationUpdateOne {
+	if t != nil {
+		iuo.SetUsedAt(*t)
+	}
+	return iuo
+}
+
+// ClearUsedAt clears the value of the "usedAt" field.
+func (iuo *InvitationUpdateOne) ClearUsedAt() *InvitationUpdateOne {
+	iuo.mutation.ClearUsedAt()
+	return iuo
+}
+
+// SetRevokedAt sets the "revokedAt" field.
+func (iuo *InvitationUpdateOne) SetRevokedAt(t time.Time) *InvitationUpdateOne {
+	iuo.mutation.SetRevokedAt(t)
+	return iuo
+}
+
+// SetNillableRevokedAt sets the "revokedAt" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableRevokedAt(t *time.Time) *InvitationUpdateOne {
+	if t != nil {
+		iuo.SetRevokedAt(*t)
+	}
+	return iuo
+}
+
+// ClearRevokedAt clears the value of the "revokedAt" field.
+func (iuo *InvitationUpdateOne) ClearRevokedAt() *InvitationUpdateOne {
+	iuo.mutation.ClearRevokedAt()
+	return iuo
+}
+
+// SetInviterID sets the "inviter" edge to the User entity by ID.
+func (iuo *InvitationUpdateOne) SetInviterID(id uuid.UUID) *InvitationUpdateOne {
+	iuo.mutation.SetInviterID(id)
+	return iuo
+}
+
+// SetInviter sets the "inviter" edge to the User entity.
+func (iuo *InvitationUpdateOne) SetInviter(u *User) *InvitationUpdateOne {
+	return iuo.SetInviterID(u.ID)
+}
+
+// Mutation returns the InvitationMutation object of the builder.
+func (iuo *InvitationUpdateOne) Mutation() *InvitationMutation {
+	return iuo.mutation
+}
+
+// ClearInviter clears the "inviter" edge to the User entity.
+func (iuo *InvitationUpdateOne) ClearInviter() *InvitationUpdateOne {
+	iuo.mutation.ClearInviter()
+	return iuo
+}
+
+// Where appends a list predicates to the InvitationUpdate builder.
+func (iuo *InvitationUpdateOne) Where(ps ...predicate.Invitation) *InvitationUpdateOne {
+	iuo.mutation.Where(ps...)
+	return iuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iuo *InvitationUpdateOne) Select(field string, fields ...string) *InvitationUpdateOne {
+	iuo.fields = append([]string{field}, fields...)
+	return iuo
+}
+
+// Save executes the query and returns the updated Invitation entity.
+func (iuo *InvitationUpdateOne) Save(ctx context.Context) (*Invitation, error) {
+	return withHooks(ctx, iuo.sqlSave, iuo.mutation, iuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iuo *InvitationUpdateOne) SaveX(ctx context.Context) *Invitation {
+	node, err := iuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iuo *InvitationUpdateOne) Exec(ctx context.Context) error {
+	_, err := iuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iuo *InvitationUpdateOne) ExecX(ctx context.Context) {
+	if err := iuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iuo *InvitationUpdateOne) check() error {
+	if v, ok := iuo.mutation.Email(); ok {
+		if err := invitation.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Invitation.email": %w`, err)}
+		}
+	}
+	if v, ok := iuo.mutation.Role(); ok {
+		if err := invitation.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Invitation.role": %w`, err)}
+		}
+	}
+	if v, ok := iuo.mutation.Token(); ok {
+		if err := invitation.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "Invitation.token": %w`, err)}
+		}
+	}
+	if _, ok := iuo.mutation.InviterID(); iuo.mutation.InviterCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Invitation.inviter"`)
+	}
+	return nil
+}
+
+func (iuo *InvitationUpdateOne) sqlSave(ctx context.Context) (_node *Invitation, err error) {
+	if err := iuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invitation.Table, invitation.Columns, sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeUUID))
+	id, ok := iuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Invitation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invitation.FieldID)
+		for _, f := range fields {
+			if !invitation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != invitation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iuo.mutation.Email(); ok {
+		_spec.SetField(invitation.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := iuo.mutation.Role(); ok {
+		_spec.SetField(invitation.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := iuo.mutation.Token(); ok {
+		_spec.SetField(invitation.FieldToken, field.TypeString, value)
+	}
+	if value, ok := iuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(invitation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := iuo.mutation.UsedAt(); ok {
+		_spec.SetField(invitation.FieldUsedAt, field.TypeTime, value)
+	}
+	if iuo.mutation.UsedAtCleared() {
+		_spec.ClearField(invitation.FieldUsedAt, field.TypeTime)
+	}
+	if value, ok := iuo.mutation.RevokedAt(); ok {
+		_spec.SetField(invitation.FieldRevokedAt, field.TypeTime, value)
+	}
+	if iuo.mutation.RevokedAtCleared() {
+		_spec.ClearField(invitation.FieldRevokedAt, field.TypeTime)
+	}
+	if iuo.mutation.InviterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.InviterTable,
+			Columns: []string{invitation.InviterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.InviterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.InviterTable,
+			Columns: []string{invitation.InviterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Invitation{config: iuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,164 @@
+/*
+Copyright YEAR 1block.ai.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/loginfailure"
+)
+
+// LoginFailure is the model entity for the LoginFailure schema.
+type LoginFailure struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// IP holds the value of the "ip" field.
+	IP string `json:"ip,omitempty"`
+	// UserAgent holds the value of the "userAgent" field.
+	UserAgent string `json:"userAgent,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// CreatedAt holds the value of the "createdAt" field.
+	CreatedAt    time.Time `json:"createdAt,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LoginFailure) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case loginfailure.FieldID:
+			values[i] = new(sql.NullInt64)
+		case loginfailure.FieldEmail, loginfailure.FieldIP, loginfailure.FieldUserAgent, loginfailure.FieldReason:
+			values[i] = new(sql.NullString)
+		case loginfailure.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LoginFailure fields.
+func (lf *LoginFailure) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case loginfailure.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			lf.ID = int(value.Int64)
+		case loginfailure.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				lf.Email = value.String
+			}
+		case loginfailure.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				lf.IP = value.String
+			}
+		case loginfailure.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field userAgent", values[i])
+			} else if value.Valid {
+				lf.UserAgent = value.String
+			}
+		case loginfailure.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				lf.Reason = value.String
+			}
+		case loginfailure.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field createdAt", values[i])
+			} else if value.Valid {
+				lf.CreatedAt = value.Time
+			}
+		default:
+			lf.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LoginFailure.
+// This includes values selected through modifiers, order, etc.
+func (lf *LoginFailure) Value(name string) (ent.Value, error) {
+	return lf.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LoginFailure.
+// Note that you need to call LoginFailure.Unwrap() before calling this method if this LoginFailure
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (lf *LoginFailure) Update() *LoginFailureUpdateOne {
+	return NewLoginFailureClient(lf.config).UpdateOne(lf)
+}
+
+// Unwrap unwraps the LoginFailure entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (lf *LoginFailure) Unwrap() *LoginFailure {
+	_tx, ok := lf.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LoginFailure is not a transactional entity")
+	}
+	lf.config.driver = _tx.drv
+	return lf
+}
+
+// String implements the fmt.Stringer.
+func (lf *LoginFailure) String() string {
+	var builder strings.Builder
+	builder.WriteString("LoginFailure(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", lf.ID))
+	builder.WriteString("email=")
+	builder.WriteString(lf.Email)
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(lf.IP)
+	builder.WriteString(", ")
+	builder.WriteString("userAgent=")
+	builder.WriteString(lf.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(lf.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("createdAt=")
+	builder.WriteString(lf.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LoginFailures is a parsable slice of LoginFailure.
+type LoginFailures []*LoginFailure
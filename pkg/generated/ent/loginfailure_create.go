@@ -0,0 +1,712 @@
+/*
+Copyright YEAR 1block.ai.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/loginfailure"
+)
+
+// LoginFailureCreate is the builder for creating a LoginFailure entity.
+type LoginFailureCreate struct {
+	config
+	mutation *LoginFailureMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetEmail sets the "email" field.
+func (lfc *LoginFailureCreate) SetEmail(s string) *LoginFailureCreate {
+	lfc.mutation.SetEmail(s)
+	return lfc
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (lfc *LoginFailureCreate) SetNillableEmail(s *string) *LoginFailureCreate {
+	if s != nil {
+		lfc.SetEmail(*s)
+	}
+	return lfc
+}
+
+// SetIP sets the "ip" field.
+func (lfc *LoginFailureCreate) SetIP(s string) *LoginFailureCreate {
+	lfc.mutation.SetIP(s)
+	return lfc
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (lfc *LoginFailureCreate) SetNillableIP(s *string) *LoginFailureCreate {
+	if s != nil {
+		lfc.SetIP(*s)
+	}
+	return lfc
+}
+
+// SetUserAgent sets the "userAgent" field.
+func (lfc *LoginFailureCreate) SetUserAgent(s string) *LoginFailureCreate {
+	lfc.mutation.SetUserAgent(s)
+	return lfc
+}
+
+// SetNillableUserAgent sets the "userAgent" field if the given value is not nil.
+func (lfc *LoginFailureCreate) SetNillableUserAgent(s *string) *LoginFailureCreate {
+	if s != nil {
+		lfc.SetUserAgent(*s)
+	}
+	return lfc
+}
+
+// SetReason sets the "reason" field.
+func (lfc *LoginFailureCreate) SetReason(s string) *LoginFailureCreate {
+	lfc.mutation.SetReason(s)
+	return lfc
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (lfc *LoginFailureCreate) SetCreatedAt(t time.Time) *LoginFailureCreate {
+	lfc.mutation.SetCreatedAt(t)
+	return lfc
+}
+
+// SetNillableCreatedAt sets the "createdAt" field if the given value is not nil.
+func (lfc *LoginFailureCreate) SetNillableCreatedAt(t *time.Time) *LoginFailureCreate {
+	if t != nil {
+		lfc.SetCreatedAt(*t)
+	}
+	return lfc
+}
+
+// Mutation returns the LoginFailureMutation object of the builder.
+func (lfc *LoginFailureCreate) Mutation() *LoginFailureMutation {
+	return lfc.mutation
+}
+
+// Save creates the LoginFailure in the database.
+func (lfc *LoginFailureCreate) Save(ctx context.Context) (*LoginFailure, error) {
+	lfc.defaults()
+	return withHooks(ctx, lfc.sqlSave, lfc.mutation, lfc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lfc *LoginFailureCreate) SaveX(ctx context.Context) *LoginFailure {
+	v, err := lfc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lfc *LoginFailureCreate) Exec(ctx context.Context) error {
+	_, err := lfc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lfc *LoginFailureCreate) ExecX(ctx context.Context) {
+	if err := lfc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lfc *LoginFailureCreate) defaults() {
+	if _, ok := lfc.mutation.Email(); !ok {
+		v := loginfailure.DefaultEmail
+		lfc.mutation.SetEmail(v)
+	}
+	if _, ok := lfc.mutation.IP(); !ok {
+		v := loginfailure.DefaultIP
+		lfc.mutation.SetIP(v)
+	}
+	if _, ok := lfc.mutation.UserAgent(); !ok {
+		v := loginfailure.DefaultUserAgent
+		lfc.mutation.SetUserAgent(v)
+	}
+	if _, ok := lfc.mutation.CreatedAt(); !ok {
+		v := loginfailure.DefaultCreatedAt()
+		lfc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lfc *LoginFailureCreate) check() error {
+	if _, ok := lfc.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "LoginFailure.email"`)}
+	}
+	if _, ok := lfc.mutation.IP(); !ok {
+		return &ValidationError{Name: "ip", err: errors.New(`ent: missing required field "LoginFailure.ip"`)}
+	}
+	if _, ok := lfc.mutation.UserAgent(); !ok {
+		return &ValidationError{Name: "userAgent", err: errors.New(`ent: missing required field "LoginFailure.userAgent"`)}
+	}
+	if _, ok := lfc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "LoginFailure.reason"`)}
+	}
+	if v, ok := lfc.mutation.Reason(); ok {
+		if err := loginfailure.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "LoginFailure.reason": %w`, err)}
+		}
+	}
+	if _, ok := lfc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "createdAt", err: errors.New(`ent: missing required field "LoginFailure.createdAt"`)}
+	}
+	return nil
+}
+
+func (lfc *LoginFailureCreate) sqlSave(ctx context.Context) (*LoginFailure, error) {
+	if err := lfc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lfc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lfc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	lfc.mutation.id = &_node.ID
+	lfc.mutation.done = true
+	return _node, nil
+}
+
+func (lfc *LoginFailureCreate) createSpec() (*LoginFailure, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LoginFailure{config: lfc.config}
+		_spec = sqlgraph.NewCreateSpec(loginfailure.Table, sqlgraph.NewFieldSpec(loginfailure.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = lfc.conflict
+	if value, ok := lfc.mutation.Email(); ok {
+		_spec.SetField(loginfailure.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := lfc.mutation.IP(); ok {
+		_spec.SetField(loginfailure.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := lfc.mutation.UserAgent(); ok {
+		_spec.SetField(loginfailure.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := lfc.mutation.Reason(); ok {
+		_spec.SetField(loginfailure.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := lfc.mutation.CreatedAt(); ok {
+		_spec.SetField(loginfailure.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LoginFailure.Create().
+//		SetEmail(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LoginFailureUpsert) {
+//			SetEmail(v+v).
+//		}).
+//		Exec(ctx)
+func (lfc *LoginFailureCreate) OnConflict(opts ...sql.ConflictOption) *LoginFailureUpsertOne {
+	lfc.conflict = opts
+	return &LoginFailureUpsertOne{
+		create: lfc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LoginFailure.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lfc *LoginFailureCreate) OnConflictColumns(columns ...string) *LoginFailureUpsertOne {
+	lfc.conflict = append(lfc.conflict, sql.ConflictColumns(columns...))
+	return &LoginFailureUpsertOne{
+		create: lfc,
+	}
+}
+
+type (
+	// LoginFailureUpsertOne is the builder for "upsert"-ing
+	//  one LoginFailure node.
+	LoginFailureUpsertOne struct {
+		create *LoginFailureCreate
+	}
+
+	// LoginFailureUpsert is the "OnConflict" setter.
+	LoginFailureUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetEmail sets the "email" field.
+func (u *LoginFailureUpsert) SetEmail(v string) *LoginFailureUpsert {
+	u.Set(loginfailure.FieldEmail, v)
+	return u
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *LoginFailureUpsert) UpdateEmail() *LoginFailureUpsert {
+	u.SetExcluded(loginfailure.FieldEmail)
+	return u
+}
+
+// SetIP sets the "ip" field.
+func (u *LoginFailureUpsert) SetIP(v string) *LoginFailureUpsert {
+	u.Set(loginfailure.FieldIP, v)
+	return u
+}
+
+// UpdateIP sets the "ip" field to the value that was provided on create.
+func (u *LoginFailureUpsert) UpdateIP() *LoginFailureUpsert {
+	u.SetExcluded(loginfailure.FieldIP)
+	return u
+}
+
+// SetUserAgent sets the "userAgent" field.
+func (u *LoginFailureUpsert) SetUserAgent(v string) *LoginFailureUpsert {
+	u.Set(loginfailure.FieldUserAgent, v)
+	return u
+}
+
+// UpdateUserAgent sets the "userAgent" field to the value that was provided on create.
+func (u *LoginFailureUpsert) UpdateUserAgent() *LoginFailureUpsert {
+	u.SetExcluded(loginfailure.FieldUserAgent)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *LoginFailureUpsert) SetReason(v string) *LoginFailureUpsert {
+	u.Set(loginfailure.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *LoginFailureUpsert) UpdateReason() *LoginFailureUpsert {
+	u.SetExcluded(loginfailure.FieldReason)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.LoginFailure.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *LoginFailureUpsertOne) UpdateNewValues() *LoginFailureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(loginfailure.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LoginFailure.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LoginFailureUpsertOne) Ignore() *LoginFailureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LoginFailureUpsertOne) DoNothing() *LoginFailureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LoginFailureCreate.OnConflict
+// documentation for more info.
+func (u *LoginFailureUpsertOne) Update(set func(*LoginFailureUpsert)) *LoginFailureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LoginFailureUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *LoginFailureUpsertOne) SetEmail(v string) *LoginFailureUpsertOne {
+	return u.Update(func(s *LoginFailureUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *LoginFailureUpsertOne) UpdateEmail() *LoginFailureUpsertOne {
+	return u.Update(func(s *LoginFailureUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetIP sets the "ip" field.
+func (u *LoginFailureUpsertOne) SetIP(v string) *LoginFailureUpsertOne {
+	return u.Update(func(s *LoginFailureUpsert) {
+		s.SetIP(v)
+	})
+}
+
+// UpdateIP sets the "ip" field to the value that was provided on create.
+func (u *LoginFailureUpsertOne) UpdateIP() *LoginFailureUpsertOne {
+	return u.Update(func(s *LoginFailureUpsert) {
+		s.UpdateIP()
+	})
+}
+
+// SetUserAgent sets the "userAgent" field.
+func (u *LoginFailureUpsertOne) SetUserAgent(v string) *LoginFailureUpsertOne {
+	return u.Update(func(s *LoginFailureUpsert) {
+		s.SetUserAgent(v)
+	})
+}
+
+// UpdateUserAgent sets the "userAgent" field to the value that was provided on create.
+func (u *LoginFailureUpsertOne) UpdateUserAgent() *LoginFailureUpsertOne {
+	return u.Update(func(s *LoginFailureUpsert) {
+		s.UpdateUserAgent()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *LoginFailureUpsertOne) SetReason(v string) *LoginFailureUpsertOne {
+	return u.Update(func(s *LoginFailureUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *LoginFailureUpsertOne) UpdateReason() *LoginFailureUpsertOne {
+	return u.Update(func(s *LoginFailureUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// Exec executes the query.
+func (u *LoginFailureUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LoginFailureCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LoginFailureUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LoginFailureUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LoginFailureUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LoginFailureCreateBulk is the builder for creating many LoginFailure entities in bulk.
+type LoginFailureCreateBulk struct {
+	config
+	err      error
+	builders []*LoginFailureCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LoginFailure entities in the database.
+func (lfcb *LoginFailureCreateBulk) Save(ctx context.Context) ([]*LoginFailure, error) {
+	if lfcb.err != nil {
+		return nil, lfcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lfcb.builders))
+	nodes := make([]*LoginFailure, len(lfcb.builders))
+	mutators := make([]Mutator, len(lfcb.builders))
+	for i := range lfcb.builders {
+		func(i int, root context.Context) {
+			builder := lfcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LoginFailureMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lfcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = lfcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lfcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lfcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lfcb *LoginFailureCreateBulk) SaveX(ctx context.Context) []*LoginFailure {
+	v, err := lfcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lfcb *LoginFailureCreateBulk) Exec(ctx context.Context) error {
+	_, err := lfcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lfcb *LoginFailureCreateBulk) ExecX(ctx context.Context) {
+	if err := lfcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LoginFailure.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LoginFailureUpsert) {
+//			SetEmail(v+v).
+//		}).
+//		Exec(ctx)
+func (lfcb *LoginFailureCreateBulk) OnConflict(opts ...sql.ConflictOption) *LoginFailureUpsertBulk {
+	lfcb.conflict = opts
+	return &LoginFailureUpsertBulk{
+		create: lfcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LoginFailure.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lfcb *LoginFailureCreateBulk) OnConflictColumns(columns ...string) *LoginFailureUpsertBulk {
+	lfcb.conflict = append(lfcb.conflict, sql.ConflictColumns(columns...))
+	return &LoginFailureUpsertBulk{
+		create: lfcb,
+	}
+}
+
+// LoginFailureUpsertBulk is the builder for "upsert"-ing
+// a bulk of LoginFailure nodes.
+type LoginFailureUpsertBulk struct {
+	create *LoginFailureCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LoginFailure.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *LoginFailureUpsertBulk) UpdateNewValues() *LoginFailureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(loginfailure.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LoginFailure.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LoginFailureUpsertBulk) Ignore() *LoginFailureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LoginFailureUpsertBulk) DoNothing() *LoginFailureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LoginFailureCreateBulk.OnConflict
+// documentation for more info.
+func (u *LoginFailureUpsertBulk) Update(set func(*LoginFailureUpsert)) *LoginFailureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LoginFailureUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *LoginFailureUpsertBulk) SetEmail(v string) *LoginFailureUpsertBulk {
+	return u.Update(func(s *LoginFailureUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *LoginFailureUpsertBulk) UpdateEmail() *LoginFailureUpsertBulk {
+	return u.Update(func(s *LoginFailureUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetIP sets the "ip" field.
+func (u *LoginFailureUpsertBulk) SetIP(v string) *LoginFailureUpsertBulk {
+	return u.Update(func(s *LoginFailureUpsert) {
+		s.SetIP(v)
+	})
+}
+
+// UpdateIP sets the "ip" field to the value that was provided on create.
+func (u *LoginFailureUpsertBulk) UpdateIP() *LoginFailureUpsertBulk {
+	return u.Update(func(s *LoginFailureUpsert) {
+		s.UpdateIP()
+	})
+}
+
+// SetUserAgent sets the "userAgent" field.
+func (u *LoginFailureUpsertBulk) SetUserAgent(v string) *LoginFailureUpsertBulk {
+	return u.Update(func(s *LoginFailureUpsert) {
+		s.SetUserAgent(v)
+	})
+}
+
+// UpdateUserAgent sets the "userAgent" field to the value that was provided on create.
+func (u *LoginFailureUpsertBulk) UpdateUserAgent() *LoginFailureUpsertBulk {
+	return u.Update(func(s *LoginFailureUpsert) {
+		s.UpdateUserAgent()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *LoginFailureUpsertBulk) SetReason(v string) *LoginFailureUpsertBulk {
+	return u.Update(func(s *LoginFailureUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *LoginFailureUpsertBulk) UpdateReason() *LoginFailureUpsertBulk {
+	return u.Update(func(s *LoginFailureUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// Exec executes the query.
+func (u *LoginFailureUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LoginFailureCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LoginFailureCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LoginFailureUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright YEAR 1block.ai.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/loginfailure"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/predicate"
+)
+
+// LoginFailureDelete is the builder for deleting a LoginFailure entity.
+type LoginFailureDelete struct {
+	config
+	hooks    []Hook
+	mutation *LoginFailureMutation
+}
+
+// Where appends a list predicates to the LoginFailureDelete builder.
+func (lfd *LoginFailureDelete) Where(ps ...predicate.LoginFailure) *LoginFailureDelete {
+	lfd.mutation.Where(ps...)
+	return lfd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (lfd *LoginFailureDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, lfd.sqlExec, lfd.mutation, lfd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lfd *LoginFailureDelete) ExecX(ctx context.Context) int {
+	n, err := lfd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (lfd *LoginFailureDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(loginfailure.Table, sqlgraph.NewFieldSpec(loginfailure.FieldID, field.TypeInt))
+	if ps := lfd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, lfd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	lfd.mutation.done = true
+	return affected, err
+}
+
+// LoginFailureDeleteOne is the builder for deleting a single LoginFailure entity.
+type LoginFailureDeleteOne struct {
+	lfd *LoginFailureDelete
+}
+
+// Where appends a list predicates to the LoginFailureDelete builder.
+func (lfdo *LoginFailureDeleteOne) Where(ps ...predicate.LoginFailure) *LoginFailureDeleteOne {
+	lfdo.lfd.mutation.Where(ps...)
+	return lfdo
+}
+
+// Exec executes the deletion query.
+func (lfdo *LoginFailureDeleteOne) Exec(ctx context.Context) error {
+	n, err := lfdo.lfd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{loginfailure.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lfdo *LoginFailureDeleteOne) ExecX(ctx context.Context) {
+	if err := lfdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,540 @@
+/*
+Copyright YEAR 1block.ai.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/loginfailure"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/predicate"
+)
+
+// LoginFailureQuery is the builder for querying LoginFailure entities.
+type LoginFailureQuery struct {
+	config
+	ctx        *QueryContext
+	order      []loginfailure.OrderOption
+	inters     []Interceptor
+	predicates []predicate.LoginFailure
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LoginFailureQuery builder.
+func (lfq *LoginFailureQuery) Where(ps ...predicate.LoginFailure) *LoginFailureQuery {
+	lfq.predicates = append(lfq.predicates, ps...)
+	return lfq
+}
+
+// Limit the number of records to be returned by this query.
+func (lfq *LoginFailureQuery) Limit(limit int) *LoginFailureQuery {
+	lfq.ctx.Limit = &limit
+	return lfq
+}
+
+// Offset to start from.
+func (lfq *LoginFailureQuery) Offset(offset int) *LoginFailureQuery {
+	lfq.ctx.Offset = &offset
+	return lfq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (lfq *LoginFailureQuery) Unique(unique bool) *LoginFailureQuery {
+	lfq.ctx.Unique = &unique
+	return lfq
+}
+
+// Order specifies how the records should be ordered.
+func (lfq *LoginFailureQuery) Order(o ...loginfailure.OrderOption) *LoginFailureQuery {
+	lfq.order = append(lfq.order, o...)
+	return lfq
+}
+
+// First returns the first LoginFailure entity from the query.
+// Returns a *NotFoundError when no LoginFailure was found.
+func (lfq *LoginFailureQuery) First(ctx context.Context) (*LoginFailure, error) {
+	nodes, err := lfq.Limit(1).All(setContextOp(ctx, lfq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{loginfailure.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (lfq *LoginFailureQuery) FirstX(ctx context.Context) *LoginFailure {
+	node, err := lfq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first LoginFailure ID from the query.
+// Returns a *NotFoundError when no LoginFailure ID was found.
+func (lfq *LoginFailureQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = lfq.Limit(1).IDs(setContextOp(ctx, lfq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{loginfailure.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (lfq *LoginFailureQuery) FirstIDX(ctx context.Context) int {
+	id, err := lfq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single LoginFailure entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one LoginFailure entity is found.
+// Returns a *NotFoundError when no LoginFailure entities are found.
+func (lfq *LoginFailureQuery) Only(ctx context.Context) (*LoginFailure, error) {
+	nodes, err := lfq.Limit(2).All(setContextOp(ctx, lfq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{loginfailure.Label}
+	default:
+		return nil, &NotSingularError{loginfailure.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (lfq *LoginFailureQuery) OnlyX(ctx context.Context) *LoginFailure {
+	node, err := lfq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only LoginFailure ID in the query.
+// Returns a *NotSingularError when more than one LoginFailure ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (lfq *LoginFailureQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = lfq.Limit(2).IDs(setContextOp(ctx, lfq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{loginfailure.Label}
+	default:
+		err = &NotSingularError{loginfailure.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (lfq *LoginFailureQuery) OnlyIDX(ctx context.Context) int {
+	id, err := lfq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of LoginFailures.
+func (lfq *LoginFailureQuery) All(ctx context.Context) ([]*LoginFailure, error) {
+	ctx = setContextOp(ctx, lfq.ctx, "All")
+	if err := lfq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*LoginFailure, *LoginFailureQuery]()
+	return withInterceptors[[]*LoginFailure](ctx, lfq, qr, lfq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (lfq *LoginFailureQuery) AllX(ctx context.Context) []*LoginFailure {
+	nodes, err := lfq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of LoginFailure IDs.
+func (lfq *LoginFailureQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if lfq.ctx.Unique == nil && lfq.path != nil {
+		lfq.Unique(true)
+	}
+	ctx = setContextOp(ctx, lfq.ctx, "IDs")
+	if err = lfq.Select(loginfailure.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (lfq *LoginFailureQuery) IDsX(ctx context.Context) []int {
+	ids, err := lfq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (lfq *LoginFailureQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, lfq.ctx, "Count")
+	if err := lfq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, lfq, querierCount[*LoginFailureQuery](), lfq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (lfq *LoginFailureQuery) CountX(ctx context.Context) int {
+	count, err := lfq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (lfq *LoginFailureQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, lfq.ctx, "Exist")
+	switch _, err := lfq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (lfq *LoginFailureQuery) ExistX(ctx context.Context) bool {
+	exist, err := lfq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LoginFailureQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (lfq *LoginFailureQuery) Clone() *LoginFailureQuery {
+	if lfq == nil {
+		return nil
+	}
+	return &LoginFailureQuery{
+		config:     lfq.config,
+		ctx:        lfq.ctx.Clone(),
+		order:      append([]loginfailure.OrderOption{}, lfq.order...),
+		inters:     append([]Interceptor{}, lfq.inters...),
+		predicates: append([]predicate.LoginFailure{}, lfq.predicates...),
+		// clone intermediate query.
+		sql:  lfq.sql.Clone(),
+		path: lfq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Email string `json:"email,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.LoginFailure.Query().
+//		GroupBy(loginfailure.FieldEmail).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (lfq *LoginFailureQuery) GroupBy(field string, fields ...string) *LoginFailureGroupBy {
+	lfq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &LoginFailureGroupBy{build: lfq}
+	grbuild.flds = &lfq.ctx.Fields
+	grbuild.label = loginfailure.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Email string `json:"email,omitempty"`
+//	}
+//
+//	client.LoginFailure.Query().
+//		Select(loginfailure.FieldEmail).
+//		Scan(ctx, &v)
+func (lfq *LoginFailureQuery) Select(fields ...string) *LoginFailureSelect {
+	lfq.ctx.Fields = append(lfq.ctx.Fields, fields...)
+	sbuild := &LoginFailureSelect{LoginFailureQuery: lfq}
+	sbuild.label = loginfailure.Label
+	sbuild.flds, sbuild.scan = &lfq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a LoginFailureSelect configured with the given aggregations.
+func (lfq *LoginFailureQuery) Aggregate(fns ...AggregateFunc) *LoginFailureSelect {
+	return lfq.Select().Aggregate(fns...)
+}
+
+func (lfq *LoginFailureQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range lfq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, lfq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range lfq.ctx.Fields {
+		if !loginfailure.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if lfq.path != nil {
+		prev, err := lfq.path(ctx)
+		if err != nil {
+			return err
+		}
+		lfq.sql = prev
+	}
+	return nil
+}
+
+func (lfq *LoginFailureQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*LoginFailure, error) {
+	var (
+		nodes = []*LoginFailure{}
+		_spec = lfq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*LoginFailure).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &LoginFailure{config: lfq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, lfq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (lfq *LoginFailureQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := lfq.querySpec()
+	_spec.Node.Columns = lfq.ctx.Fields
+	if len(lfq.ctx.Fields) > 0 {
+		_spec.Unique = lfq.ctx.Unique != nil && *lfq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, lfq.driver, _spec)
+}
+
+func (lfq *LoginFailureQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(loginfailure.Table, loginfailure.Columns, sqlgraph.NewFieldSpec(loginfailure.FieldID, field.TypeInt))
+	_spec.From = lfq.sql
+	if unique := lfq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if lfq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := lfq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loginfailure.FieldID)
+		for i := range fields {
+			if fields[i] != loginfailure.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := lfq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := lfq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := lfq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := lfq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (lfq *LoginFailureQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(lfq.driver.Dialect())
+	t1 := builder.Table(loginfailure.Table)
+	columns := lfq.ctx.Fields
+	if len(columns) == 0 {
+		columns = loginfailure.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if lfq.sql != nil {
+		selector = lfq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if lfq.ctx.Unique != nil && *lfq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range lfq.predicates {
+		p(selector)
+	}
+	for _, p := range lfq.order {
+		p(selector)
+	}
+	if offset := lfq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := lfq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// LoginFailureGroupBy is the group-by builder for LoginFailure entities.
+type LoginFailureGroupBy struct {
+	selector
+	build *LoginFailureQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (lfgb *LoginFailureGroupBy) Aggregate(fns ...AggregateFunc) *LoginFailureGroupBy {
+	lfgb.fns = append(lfgb.fns, fns...)
+	return lfgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lfgb *LoginFailureGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lfgb.build.ctx, "GroupBy")
+	if err := lfgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LoginFailureQuery, *LoginFailureGroupBy](ctx, lfgb.build, lfgb, lfgb.build.inters, v)
+}
+
+func (lfgb *LoginFailureGroupBy) sqlScan(ctx context.Context, root *LoginFailureQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(lfgb.fns))
+	for _, fn := range lfgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*lfgb.flds)+len(lfgb.fns))
+		for _, f := range *lfgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*lfgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lfgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// LoginFailureSelect is the builder for selecting fields of LoginFailure entities.
+type LoginFailureSelect struct {
+	*LoginFailureQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (lfs *LoginFailureSelect) Aggregate(fns ...AggregateFunc) *LoginFailureSelect {
+	lfs.fns = append(lfs.fns, fns...)
+	return lfs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lfs *LoginFailureSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lfs.ctx, "Select")
+	if err := lfs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LoginFailureQuery, *LoginFailureSelect](ctx, lfs.LoginFailureQuery, lfs, lfs.inters, v)
+}
+
+func (lfs *LoginFailureSelect) sqlScan(ctx context.Context, root *LoginFailureQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(lfs.fns))
+	for _, fn := range lfs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*lfs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lfs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
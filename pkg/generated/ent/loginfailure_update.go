@@ -0,0 +1,351 @@
+/*
+Copyright YEAR 1block.ai.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/loginfailure"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/predicate"
+)
+
+// LoginFailureUpdate is the builder for updating LoginFailure entities.
+type LoginFailureUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LoginFailureMutation
+}
+
+// Where appends a list predicates to the LoginFailureUpdate builder.
+func (lfu *LoginFailureUpdate) Where(ps ...predicate.LoginFailure) *LoginFailureUpdate {
+	lfu.mutation.Where(ps...)
+	return lfu
+}
+
+// SetEmail sets the "email" field.
+func (lfu *LoginFailureUpdate) SetEmail(s string) *LoginFailureUpdate {
+	lfu.mutation.SetEmail(s)
+	return lfu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (lfu *LoginFailureUpdate) SetNillableEmail(s *string) *LoginFailureUpdate {
+	if s != nil {
+		lfu.SetEmail(*s)
+	}
+	return lfu
+}
+
+// SetIP sets the "ip" field.
+func (lfu *LoginFailureUpdate) SetIP(s string) *LoginFailureUpdate {
+	lfu.mutation.SetIP(s)
+	return lfu
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (lfu *LoginFailureUpdate) SetNillableIP(s *string) *LoginFailureUpdate {
+	if s != nil {
+		lfu.SetIP(*s)
+	}
+	return lfu
+}
+
+// SetUserAgent sets the "userAgent" field.
+func (lfu *LoginFailureUpdate) SetUserAgent(s string) *LoginFailureUpdate {
+	lfu.mutation.SetUserAgent(s)
+	return lfu
+}
+
+// SetNillableUserAgent sets the "userAgent" field if the given value is not nil.
+func (lfu *LoginFailureUpdate) SetNillableUserAgent(s *string) *LoginFailureUpdate {
+	if s != nil {
+		lfu.SetUserAgent(*s)
+	}
+	return lfu
+}
+
+// SetReason sets the "reason" field.
+func (lfu *LoginFailureUpdate) SetReason(s string) *LoginFailureUpdate {
+	lfu.mutation.SetReason(s)
+	return lfu
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (lfu *LoginFailureUpdate) SetNillableReason(s *string) *LoginFailureUpdate {
+	if s != nil {
+		lfu.SetReason(*s)
+	}
+	return lfu
+}
+
+// Mutation returns the LoginFailureMutation object of the builder.
+func (lfu *LoginFailureUpdate) Mutation() *LoginFailureMutation {
+	return lfu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lfu *LoginFailureUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, lfu.sqlSave, lfu.mutation, lfu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lfu *LoginFailureUpdate) SaveX(ctx context.Context) int {
+	affected, err := lfu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lfu *LoginFailureUpdate) Exec(ctx context.Context) error {
+	_, err := lfu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lfu *LoginFailureUpdate) ExecX(ctx context.Context) {
+	if err := lfu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lfu *LoginFailureUpdate) check() error {
+	if v, ok := lfu.mutation.Reason(); ok {
+		if err := loginfailure.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "LoginFailure.reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (lfu *LoginFailureUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := lfu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loginfailure.Table, loginfailure.Columns, sqlgraph.NewFieldSpec(loginfailure.FieldID, field.TypeInt))
+	if ps := lfu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lfu.mutation.Email(); ok {
+		_spec.SetField(loginfailure.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := lfu.mutation.IP(); ok {
+		_spec.SetField(loginfailure.FieldIP, field.TypeString, value)
+	}
+	if value, ok := lfu.mutation.UserAgent(); ok {
+		_spec.SetField(loginfailure.FieldUserAgent, field.TypeString, value)
+	}
+	if value, ok := lfu.mutation.Reason(); ok {
+		_spec.SetField(loginfailure.FieldReason, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lfu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginfailure.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lfu.mutation.done = true
+	return n, nil
+}
+
+// LoginFailureUpdateOne is the builder for updating a single LoginFailure entity.
+type LoginFailureUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LoginFailureMutation
+}
+
+// SetEmail sets the "email" field.
+func (lfuo *LoginFailureUpdateOne) SetEmail(s string) *LoginFailureUpdateOne {
+	lfuo.mutation.SetEmail(s)
+	return lfuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (lfuo *LoginFailureUpdateOne) SetNillableEmail(s *string) *LoginFailureUpdateOne {
+	if s != nil {
+		lfuo.SetEmail(*s)
+	}
+	return lfuo
+}
+
+// SetIP sets the "ip" field.
+func (lfuo *LoginFailureUpdateOne) SetIP(s string) *LoginFailureUpdateOne {
+	lfuo.mutation.SetIP(s)
+	return lfuo
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (lfuo *LoginFailureUpdateOne) SetNillableIP(s *string) *LoginFailureUpdateOne {
+	if s != nil {
+		lfuo.SetIP(*s)
+	}
+	return lfuo
+}
+
+// SetUserAgent sets the "userAgent" field.
+func (lfuo *LoginFailureUpdateOne) SetUserAgent(s string) *LoginFailureUpdateOne {
+	lfuo.mutation.SetUserAgent(s)
+	return lfuo
+}
+
+// SetNillableUserAgent sets the "userAgent" field if the given value is not nil.
+func (lfuo *LoginFailureUpdateOne) SetNillableUserAgent(s *string) *LoginFailureUpdateOne {
+	if s != nil {
+		lfuo.SetUserAgent(*s)
+	}
+	return lfuo
+}
+
+// SetReason sets the "reason" field.
+func (lfuo *LoginFailureUpdateOne) SetReason(s string) *LoginFailureUpdateOne {
+	lfuo.mutation.SetReason(s)
+	return lfuo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (lfuo *LoginFailureUpdateOne) SetNillableReason(s *string) *LoginFailureUpdateOne {
+	if s != nil {
+		lfuo.SetReason(*s)
+	}
+	return lfuo
+}
+
+// Mutation returns the LoginFailureMutation object of the builder.
+func (lfuo *LoginFailureUpdateOne) Mutation() *LoginFailureMutation {
+	return lfuo.mutation
+}
+
+// Where appends a list predicates to the LoginFailureUpdate builder.
+func (lfuo *LoginFailureUpdateOne) Where(ps ...predicate.LoginFailure) *LoginFailureUpdateOne {
+	lfuo.mutation.Where(ps...)
+	return lfuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (lfuo *LoginFailureUpdateOne) Select(field string, fields ...string) *LoginFailureUpdateOne {
+	lfuo.fields = append([]string{field}, fields...)
+	return lfuo
+}
+
+// Save executes the query and returns the updated LoginFailure entity.
+func (lfuo *LoginFailureUpdateOne) Save(ctx context.Context) (*LoginFailure, error) {
+	return withHooks(ctx, lfuo.sqlSave, lfuo.mutation, lfuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lfuo *LoginFailureUpdateOne) SaveX(ctx context.Context) *LoginFailure {
+	node, err := lfuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (lfuo *LoginFailureUpdateOne) Exec(ctx context.Context) error {
+	_, err := lfuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lfuo *LoginFailureUpdateOne) ExecX(ctx context.Context) {
+	if err := lfuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lfuo *LoginFailureUpdateOne) check() error {
+	if v, ok := lfuo.mutation.Reason(); ok {
+		if err := loginfailure.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "LoginFailure.reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (lfuo *LoginFailureUpdateOne) sqlSave(ctx context.Context) (_node *LoginFailure, err error) {
+	if err := lfuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loginfailure.Table, loginfailure.Columns, sqlgraph.NewFieldSpec(loginfailure.FieldID, field.TypeInt))
+	id, ok := lfuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LoginFailure.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := lfuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loginfailure.FieldID)
+		for _, f := range fields {
+			if !loginfailure.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != loginfailure.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := lfuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lfuo.mutation.Email(); ok {
+		_spec.SetField(loginfailure.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := lfuo.mutation.IP(); ok {
+		_spec.SetField(loginfailure.FieldIP, field.TypeString, value)
+	}
+	if value, ok := lfuo.mutation.UserAgent(); ok {
+		_spec.SetField(loginfailure.FieldUserAgent, field.TypeString, value)
+	}
+	if value, ok := lfuo.mutation.Reason(); ok {
+		_spec.SetField(loginfailure.FieldReason, field.TypeString, value)
+	}
+	_node = &LoginFailure{config: lfuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, lfuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginfailure.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	lfuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,771 @@
+/*
+Copyright YEAR 1block.ai.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/passwordreset"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+)
+
+// PasswordResetCreate is the builder for creating a PasswordReset entity.
+type PasswordResetCreate struct {
+	config
+	mutation *PasswordResetMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserId sets the "userId" field.
+func (prc *PasswordResetCreate) SetUserId(u uuid.UUID) *PasswordResetCreate {
+	prc.mutation.SetUserId(u)
+	return prc
+}
+
+// SetToken sets the "token" field.
+func (prc *PasswordResetCreate) SetToken(s string) *PasswordResetCreate {
+	prc.mutation.SetToken(s)
+	return prc
+}
+
+// SetExpiresAt sets the "expiresAt" field.
+func (prc *PasswordResetCreate) SetExpiresAt(t time.Time) *PasswordResetCreate {
+	prc.mutation.SetExpiresAt(t)
+	return prc
+}
+
+// SetUsedAt sets the "usedAt" field.
+func (prc *PasswordResetCreate) SetUsedAt(t time.Time) *PasswordResetCreate {
+	prc.mutation.SetUsedAt(t)
+	return prc
+}
+
+// SetNillableUsedAt sets the "usedAt" field if the given value is not nil.
+func (prc *PasswordResetCreate) SetNillableUsedAt(t *time.Time) *PasswordResetCreate {
+	if t != nil {
+		prc.SetUsedAt(*t)
+	}
+	return prc
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (prc *PasswordResetCreate) SetCreatedAt(t time.Time) *PasswordResetCreate {
+	prc.mutation.SetCreatedAt(t)
+	return prc
+}
+
+// SetNillableCreatedAt sets the "createdAt" field if the given value is not nil.
+func (prc *PasswordResetCreate) SetNillableCreatedAt(t *time.Time) *PasswordResetCreate {
+	if t != nil {
+		prc.SetCreatedAt(*t)
+	}
+	return prc
+}
+
+// SetID sets the "id" field.
+func (prc *PasswordResetCreate) SetID(u uuid.UUID) *PasswordResetCreate {
+	prc.mutation.SetID(u)
+	return prc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (prc *PasswordResetCreate) SetNillableID(u *uuid.UUID) *PasswordResetCreate {
+	if u != nil {
+		prc.SetID(*u)
+	}
+	return prc
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (prc *PasswordResetCreate) SetOwnerID(id uuid.UUID) *PasswordResetCreate {
+	prc.mutation.SetOwnerID(id)
+	return prc
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (prc *PasswordResetCreate) SetOwner(u *User) *PasswordResetCreate {
+	return prc.SetOwnerID(u.ID)
+}
+
+// Mutation returns the PasswordResetMutation object of the builder.
+func (prc *PasswordResetCreate) Mutation() *PasswordResetMutation {
+	return prc.mutation
+}
+
+// Save creates the PasswordReset in the database.
+func (prc *PasswordResetCreate) Save(ctx context.Context) (*PasswordReset, error) {
+	prc.defaults()
+	return withHooks(ctx, prc.sqlSave, prc.mutation, prc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (prc *PasswordResetCreate) SaveX(ctx context.Context) *PasswordReset {
+	v, err := prc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prc *PasswordResetCreate) Exec(ctx context.Context) error {
+	_, err := prc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prc *PasswordResetCreate) ExecX(ctx context.Context) {
+	if err := prc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (prc *PasswordResetCreate) defaults() {
+	if _, ok := prc.mutation.CreatedAt(); !ok {
+		v := passwordreset.DefaultCreatedAt()
+		prc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := prc.mutation.ID(); !ok {
+		v := passwordreset.DefaultID()
+		prc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (prc *PasswordResetCreate) check() error {
+	if _, ok := prc.mutation.UserId(); !ok {
+		return &ValidationError{Name: "userId", err: errors.New(`ent: missing required field "PasswordReset.userId"`)}
+	}
+	if _, ok := prc.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "PasswordReset.token"`)}
+	}
+	if v, ok := prc.mutation.Token(); ok {
+		if err := passwordreset.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "PasswordReset.token": %w`, err)}
+		}
+	}
+	if _, ok := prc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expiresAt", err: errors.New(`ent: missing required field "PasswordReset.expiresAt"`)}
+	}
+	if _, ok := prc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "createdAt", err: errors.New(`ent: missing required field "PasswordReset.createdAt"`)}
+	}
+	if _, ok := prc.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "PasswordReset.owner"`)}
+	}
+	return nil
+}
+
+func (prc *PasswordResetCreate) sqlSave(ctx context.Context) (*PasswordReset, error) {
+	if err := prc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := prc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, prc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	prc.mutation.id = &_node.ID
+	prc.mutation.done = true
+	return _node, nil
+}
+
+func (prc *PasswordResetCreate) createSpec() (*PasswordReset, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PasswordReset{config: prc.config}
+		_spec = sqlgraph.NewCreateSpec(passwordreset.Table, sqlgraph.NewFieldSpec(passwordreset.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = prc.conflict
+	if id, ok := prc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := prc.mutation.Token(); ok {
+		_spec.SetField(passwordreset.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := prc.mutation.ExpiresAt(); ok {
+		_spec.SetField(passwordreset.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := prc.mutation.UsedAt(); ok {
+		_spec.SetField(passwordreset.FieldUsedAt, field.TypeTime, value)
+		_node.UsedAt = &value
+	}
+	if value, ok := prc.mutation.CreatedAt(); ok {
+		_spec.SetField(passwordreset.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := prc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passwordreset.OwnerTable,
+			Columns: []string{passwordreset.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserId = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PasswordReset.Create().
+//		SetUserId(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PasswordResetUpsert) {
+//			SetUserId(v+v).
+//		}).
+//		Exec(ctx)
+func (prc *PasswordResetCreate) OnConflict(opts ...sql.ConflictOption) *PasswordResetUpsertOne {
+	prc.conflict = opts
+	return &PasswordResetUpsertOne{
+		create: prc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PasswordReset.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (prc *PasswordResetCreate) OnConflictColumns(columns ...string) *PasswordResetUpsertOne {
+	prc.conflict = append(prc.conflict, sql.ConflictColumns(columns...))
+	return &PasswordResetUpsertOne{
+		create: prc,
+	}
+}
+
+type (
+	// PasswordResetUpsertOne is the builder for "upsert"-ing
+	//  one PasswordReset node.
+	PasswordResetUpsertOne struct {
+		create *PasswordResetCreate
+	}
+
+	// PasswordResetUpsert is the "OnConflict" setter.
+	PasswordResetUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserId sets the "userId" field.
+func (u *PasswordResetUpsert) SetUserId(v uuid.UUID) *PasswordResetUpsert {
+	u.Set(passwordreset.FieldUserId, v)
+	return u
+}
+
+// UpdateUserId sets the "userId" field to the value that was provided on create.
+func (u *PasswordResetUpsert) UpdateUserId() *PasswordResetUpsert {
+	u.SetExcluded(passwordreset.FieldUserId)
+	return u
+}
+
+// SetToken sets the "token" field.
+func (u *PasswordResetUpsert) SetToken(v string) *PasswordResetUpsert {
+	u.Set(passwordreset.FieldToken, v)
+	return u
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *PasswordResetUpsert) UpdateToken() *PasswordResetUpsert {
+	u.SetExcluded(passwordreset.FieldToken)
+	return u
+}
+
+// SetExpiresAt sets the "expiresAt" field.
+func (u *PasswordResetUpsert) SetExpiresAt(v time.Time) *PasswordResetUpsert {
+	u.Set(passwordreset.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expiresAt" field to the value that was provided on create.
+func (u *PasswordResetUpsert) UpdateExpiresAt() *PasswordResetUpsert {
+	u.SetExcluded(passwordreset.FieldExpiresAt)
+	return u
+}
+
+// SetUsedAt sets the "usedAt" field.
+func (u *PasswordResetUpsert) SetUsedAt(v time.Time) *PasswordResetUpsert {
+	u.Set(passwordreset.FieldUsedAt, v)
+	return u
+}
+
+// UpdateUsedAt sets the "usedAt" field to the value that was provided on create.
+func (u *PasswordResetUpsert) UpdateUsedAt() *PasswordResetUpsert {
+	u.SetExcluded(passwordreset.FieldUsedAt)
+	return u
+}
+
+// ClearUsedAt clears the value of the "usedAt" field.
+func (u *PasswordResetUpsert) ClearUsedAt() *PasswordResetUpsert {
+	u.SetNull(passwordreset.FieldUsedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PasswordReset.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(passwordreset.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PasswordResetUpsertOne) UpdateNewValues() *PasswordResetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(passwordreset.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(passwordreset.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PasswordReset.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PasswordResetUpsertOne) Ignore() *PasswordResetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PasswordResetUpsertOne) DoNothing() *PasswordResetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PasswordResetCreate.OnConflict
+// documentation for more info.
+func (u *PasswordResetUpsertOne) Update(set func(*PasswordResetUpsert)) *PasswordResetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PasswordResetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserId sets the "userId" field.
+func (u *PasswordResetUpsertOne) SetUserId(v uuid.UUID) *PasswordResetUpsertOne {
+	return u.Update(func(s *PasswordResetUpsert) {
+		s.SetUserId(v)
+	})
+}
+
+// UpdateUserId sets the "userId" field to the value that was provided on create.
+func (u *PasswordResetUpsertOne) UpdateUserId() *PasswordResetUpsertOne {
+	return u.Update(func(s *PasswordResetUpsert) {
+		s.UpdateUserId()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *PasswordResetUpsertOne) SetToken(v string) *PasswordResetUpsertOne {
+	return u.Update(func(s *PasswordResetUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *PasswordResetUpsertOne) UpdateToken() *PasswordResetUpsertOne {
+	return u.Update(func(s *PasswordResetUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetExpiresAt sets the "expiresAt" field.
+func (u *PasswordResetUpsertOne) SetExpiresAt(v time.Time) *PasswordResetUpsertOne {
+	return u.Update(func(s *PasswordResetUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expiresAt" field to the value that was provided on create.
+func (u *PasswordResetUpsertOne) UpdateExpiresAt() *PasswordResetUpsertOne {
+	return u.Update(func(s *PasswordResetUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetUsedAt sets the "usedAt" field.
+func (u *PasswordResetUpsertOne) SetUsedAt(v time.Time) *PasswordResetUpsertOne {
+	return u.Update(func(s *PasswordResetUpsert) {
+		s.SetUsedAt(v)
+	})
+}
+
+// UpdateUsedAt sets the "usedAt" field to the value that was provided on create.
+func (u *PasswordResetUpsertOne) UpdateUsedAt() *PasswordResetUpsertOne {
+	return u.Update(func(s *PasswordResetUpsert) {
+		s.UpdateUsedAt()
+	})
+}
+
+// ClearUsedAt clears the value of the "usedAt" field.
+func (u *PasswordResetUpsertOne) ClearUsedAt() *PasswordResetUpsertOne {
+	return u.Update(func(s *PasswordResetUpsert) {
+		s.ClearUsedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PasswordResetUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PasswordResetCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PasswordResetUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PasswordResetUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PasswordResetUpsertOne.ID is not supported by MySQL driver. Use PasswordResetUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PasswordResetUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PasswordResetCreateBulk is the builder for creating many PasswordReset entities in bulk.
+type PasswordResetCreateBulk struct {
+	config
+	err      error
+	builders []*PasswordResetCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PasswordReset entities in the database.
+func (prcb *PasswordResetCreateBulk) Save(ctx context.Context) ([]*PasswordReset, error) {
+	if prcb.err != nil {
+		return nil, prcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(prcb.builders))
+	nodes := make([]*PasswordReset, len(prcb.builders))
+	mutators := make([]Mutator, len(prcb.builders))
+	for i := range prcb.builders {
+		func(i int, root context.Context) {
+			builder := prcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PasswordResetMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, prcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = prcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, prcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, prcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (prcb *PasswordResetCreateBulk) SaveX(ctx context.Context) []*PasswordReset {
+	v, err := prcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prcb *PasswordResetCreateBulk) Exec(ctx context.Context) error {
+	_, err := prcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prcb *PasswordResetCreateBulk) ExecX(ctx context.Context) {
+	if err := prcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PasswordReset.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PasswordResetUpsert) {
+//			SetUserId(v+v).
+//		}).
+//		Exec(ctx)
+func (prcb *PasswordResetCreateBulk) OnConflict(opts ...sql.ConflictOption) *PasswordResetUpsertBulk {
+	prcb.conflict = opts
+	return &PasswordResetUpsertBulk{
+		create: prcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PasswordReset.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (prcb *PasswordResetCreateBulk) OnConflictColumns(columns ...string) *PasswordResetUpsertBulk {
+	prcb.conflict = append(prcb.conflict, sql.ConflictColumns(columns...))
+	return &PasswordResetUpsertBulk{
+		create: prcb,
+	}
+}
+
+// PasswordResetUpsertBulk is the builder for "upsert"-ing
+// a bulk of PasswordReset nodes.
+type PasswordResetUpsertBulk struct {
+	create *PasswordResetCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PasswordReset.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(passwordreset.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PasswordResetUpsertBulk) UpdateNewValues() *PasswordResetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(passwordreset.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(passwordreset.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PasswordReset.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PasswordResetUpsertBulk) Ignore() *PasswordResetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PasswordResetUpsertBulk) DoNothing() *PasswordResetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PasswordResetCreateBulk.OnConflict
+// documentation for more info.
+func (u *PasswordResetUpsertBulk) Update(set func(*PasswordResetUpsert)) *PasswordResetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PasswordResetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserId sets the "userId" field.
+func (u *PasswordResetUpsertBulk) SetUserId(v uuid.UUID) *PasswordResetUpsertBulk {
+	return u.Update(func(s *PasswordResetUpsert) {
+		s.SetUserId(v)
+	})
+}
+
+// UpdateUserId sets the "userId" field to the value that was provided on create.
+func (u *PasswordResetUpsertBulk) UpdateUserId() *PasswordResetUpsertBulk {
+	return u.Update(func(s *PasswordResetUpsert) {
+		s.UpdateUserId()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *PasswordResetUpsertBulk) SetToken(v string) *PasswordResetUpsertBulk {
+	return u.Update(func(s *PasswordResetUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *PasswordResetUpsertBulk) UpdateToken() *PasswordResetUpsertBulk {
+	return u.Update(func(s *PasswordResetUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetExpiresAt sets the "expiresAt" field.
+func (u *PasswordResetUpsertBulk) SetExpiresAt(v time.Time) *PasswordResetUpsertBulk {
+	return u.Update(func(s *PasswordResetUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expiresAt" field to the value that was provided on create.
+func (u *PasswordResetUpsertBulk) UpdateExpiresAt() *PasswordResetUpsertBulk {
+	return u.Update(func(s *PasswordResetUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetUsedAt sets the "usedAt" field.
+func (u *PasswordResetUpsertBulk) SetUsedAt(v time.Time) *PasswordResetUpsertBulk {
+	return u.Update(func(s *PasswordResetUpsert) {
+		s.SetUsedAt(v)
+	})
+}
+
+// UpdateUsedAt sets the "usedAt" field to the value that was provided on create.
+func (u *PasswordResetUpsertBulk) UpdateUsedAt() *PasswordResetUpsertBulk {
+	return u.Update(func(s *PasswordResetUpsert) {
+		s.UpdateUsedAt()
+	})
+}
+
+// ClearUsedAt clears the value of the "usedAt" field.
+func (u *PasswordResetUpsertBulk) ClearUsedAt() *PasswordResetUpsertBulk {
+	return u.Update(func(s *PasswordResetUpsert) {
+		s.ClearUsedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PasswordResetUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PasswordResetCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PasswordResetCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PasswordResetUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
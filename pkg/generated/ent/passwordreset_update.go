@@ -0,0 +1,464 @@
+/*
+Copyright YEAR 1block.ai.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/passwordreset"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/predicate"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+)
+
+// PasswordResetUpdate is the builder for updating PasswordReset entities.
+type PasswordResetUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PasswordResetMutation
+}
+
+// Where appends a list predicates to the PasswordResetUpdate builder.
+func (pru *PasswordResetUpdate) Where(ps ...predicate.PasswordReset) *PasswordResetUpdate {
+	pru.mutation.Where(ps...)
+	return pru
+}
+
+// SetUserId sets the "userId" field.
+func (pru *PasswordResetUpdate) SetUserId(u uuid.UUID) *PasswordResetUpdate {
+	pru.mutation.SetUserId(u)
+	return pru
+}
+
+// SetNillableUserId sets the "userId" field if the given value is not nil.
+func (pru *PasswordResetUpdate) SetNillableUserId(u *uuid.UUID) *PasswordResetUpdate {
+	if u != nil {
+		pru.SetUserId(*u)
+	}
+	return pru
+}
+
+// SetToken sets the "token" field.
+func (pru *PasswordResetUpdate) SetToken(s string) *PasswordResetUpdate {
+	pru.mutation.SetToken(s)
+	return pru
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (pru *PasswordResetUpdate) SetNillableToken(s *string) *PasswordResetUpdate {
+	if s != nil {
+		pru.SetToken(*s)
+	}
+	return pru
+}
+
+// SetExpiresAt sets the "expiresAt" field.
+func (pru *PasswordResetUpdate) SetExpiresAt(t time.Time) *PasswordResetUpdate {
+	pru.mutation.SetExpiresAt(t)
+	return pru
+}
+
+// SetNillableExpiresAt sets the "expiresAt" field if the given value is not nil.
+func (pru *PasswordResetUpdate) SetNillableExpiresAt(t *time.Time) *PasswordResetUpdate {
+	if t != nil {
+		pru.SetExpiresAt(*t)
+	}
+	return pru
+}
+
+// SetUsedAt sets the "usedAt" field.
+func (pru *PasswordResetUpdate) SetUsedAt(t time.Time) *PasswordResetUpdate {
+	pru.mutation.SetUsedAt(t)
+	return pru
+}
+
+// SetNillableUsedAt sets the "usedAt" field if the given value is not nil.
+func (pru *PasswordResetUpdate) SetNillableUsedAt(t *time.Time) *PasswordResetUpdate {
+	if t != nil {
+		pru.SetUsedAt(*t)
+	}
+	return pru
+}
+
+// ClearUsedAt clears the value of the "usedAt" field.
+func (pru *PasswordResetUpdate) ClearUsedAt() *PasswordResetUpdate {
+	pru.mutation.ClearUsedAt()
+	return pru
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (pru *PasswordResetUpdate) SetOwnerID(id uuid.UUID) *PasswordResetUpdate {
+	pru.mutation.SetOwnerID(id)
+	return pru
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (pru *PasswordResetUpdate) SetOwner(u *User) *PasswordResetUpdate {
+	return pru.SetOwnerID(u.ID)
+}
+
+// Mutation returns the PasswordResetMutation object of the builder.
+func (pru *PasswordResetUpdate) Mutation() *PasswordResetMutation {
+	return pru.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (pru *PasswordResetUpdate) ClearOwner() *PasswordResetUpdate {
+	pru.mutation.ClearOwner()
+	return pru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pru *PasswordResetUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pru.sqlSave, pru.mutation, pru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pru *PasswordResetUpdate) SaveX(ctx context.Context) int {
+	affected, err := pru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pru *PasswordResetUpdate) Exec(ctx context.Context) error {
+	_, err := pru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pru *PasswordResetUpdate) ExecX(ctx context.Context) {
+	if err := pru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pru *PasswordResetUpdate) check() error {
+	if v, ok := pru.mutation.Token(); ok {
+		if err := passwordreset.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "PasswordReset.token": %w`, err)}
+		}
+	}
+	if _, ok := pru.mutation.OwnerID(); pru.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "PasswordReset.owner"`)
+	}
+	return nil
+}
+
+func (pru *PasswordResetUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(passwordreset.Table, passwordreset.Columns, sqlgraph.NewFieldSpec(passwordreset.FieldID, field.TypeUUID))
+	if ps := pru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pru.mutation.Token(); ok {
+		_spec.SetField(passwordreset.FieldToken, field.TypeString, value)
+	}
+	if value, ok := pru.mutation.ExpiresAt(); ok {
+		_spec.SetField(passwordreset.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := pru.mutation.UsedAt(); ok {
+		_spec.SetField(passwordreset.FieldUsedAt, field.TypeTime, value)
+	}
+	if pru.mutation.UsedAtCleared() {
+		_spec.ClearField(passwordreset.FieldUsedAt, field.TypeTime)
+	}
+	if pru.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passwordreset.OwnerTable,
+			Columns: []string{passwordreset.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pru.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passwordreset.OwnerTable,
+			Columns: []string{passwordreset.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{passwordreset.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pru.mutation.done = true
+	return n, nil
+}
+
+// PasswordResetUpdateOne is the builder for updating a single PasswordReset entity.
+type PasswordResetUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PasswordResetMutation
+}
+
+// SetUserId sets the "userId" field.
+func (pruo *PasswordResetUpdateOne) SetUserId(u uuid.UUID) *PasswordResetUpdateOne {
+	pruo.mutation.SetUserId(u)
+	return pruo
+}
+
+// SetNillableUserId sets the "userId" field if the given value is not nil.
+func (pruo *PasswordResetUpdateOne) SetNillableUserId(u *uuid.UUID) *PasswordResetUpdateOne {
+	if u != nil {
+		pruo.SetUserId(*u)
+	}
+	return pruo
+}
+
+// SetToken sets the "token" field.
+func (pruo *PasswordResetUpdateOne) SetToken(s string) *PasswordResetUpdateOne {
+	pruo.mutation.SetToken(s)
+	return pruo
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (pruo *PasswordResetUpdateOne) SetNillableToken(s *string) *PasswordResetUpdateOne {
+	if s != nil {
+		pruo.SetToken(*s)
+	}
+	return pruo
+}
+
+// SetExpiresAt sets the "expiresAt" field.
+func (pruo *PasswordResetUpdateOne) SetExpiresAt(t time.Time) *PasswordResetUpdateOne {
+	pruo.mutation.SetExpiresAt(t)
+	return pruo
+}
+
+// SetNillableExpiresAt sets the "expiresAt" field if the given value is not nil.
+func (pruo *PasswordResetUpdateOne) SetNillableExpiresAt(t *time.Time) *PasswordResetUpdateOne {
+	if t != nil {
+		pruo.SetExpiresAt(*t)
+	}
+	return pruo
+}
+
+// SetUsedAt sets the "usedAt" field.
+func (pruo *PasswordResetUpdateOne) SetUsedAt(t time.Time) *PasswordResetUpdateOne {
+	pruo.mutation.SetUsedAt(t)
+	return pruo
+}
+
+// SetNillableUsedAt sets the "usedAt" field if the given value is not nil.
+func (pruo *PasswordResetUpdateOne) SetNillableUsedAt(t *time.Time) *PasswordResetUpdateOne {
+	if t != nil {
+		pruo.SetUsedAt(*t)
+	}
+	return pruo
+}
+
+// ClearUsedAt clears the value of the "usedAt" field.
+func (pruo *PasswordResetUpdateOne) ClearUsedAt() *PasswordResetUpdateOne {
+	pruo.mutation.ClearUsedAt()
+	return pruo
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (pruo *PasswordResetUpdateOne) SetOwnerID(id uuid.UUID) *PasswordResetUpdateOne {
+	pruo.mutation.SetOwnerID(id)
+	return pruo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (pruo *PasswordResetUpdateOne) SetOwner(u *User) *PasswordResetUpdateOne {
+	return pruo.SetOwnerID(u.ID)
+}
+
+// Mutation returns the PasswordResetMutation object of the builder.
+func (pruo *PasswordResetUpdateOne) Mutation() *PasswordResetMutation {
+	return pruo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (pruo *PasswordResetUpdateOne) ClearOwner() *PasswordResetUpdateOne {
+	pruo.mutation.ClearOwner()
+	return pruo
+}
+
+// Where appends a list predicates to the PasswordResetUpdate builder.
+func (pruo *PasswordResetUpdateOne) Where(ps ...predicate.PasswordReset) *PasswordResetUpdateOne {
+	pruo.mutation.Where(ps...)
+	return pruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pruo *PasswordResetUpdateOne) Select(field string, fields ...string) *PasswordResetUpdateOne {
+	pruo.fields = append([]string{field}, fields...)
+	return pruo
+}
+
+// Save executes the query and returns the updated PasswordReset entity.
+func (pruo *PasswordResetUpdateOne) Save(ctx context.Context) (*PasswordReset, error) {
+	return withHooks(ctx, pruo.sqlSave, pruo.mutation, pruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pruo *PasswordResetUpdateOne) SaveX(ctx context.Context) *PasswordReset {
+	node, err := pruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pruo *PasswordResetUpdateOne) Exec(ctx context.Context) error {
+	_, err := pruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pruo *PasswordResetUpdateOne) ExecX(ctx context.Context) {
+	if err := pruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pruo *PasswordResetUpdateOne) check() error {
+	if v, ok := pruo.mutation.Token(); ok {
+		if err := passwordreset.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "PasswordReset.token": %w`, err)}
+		}
+	}
+	if _, ok := pruo.mutation.OwnerID(); pruo.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "PasswordReset.owner"`)
+	}
+	return nil
+}
+
+func (pruo *PasswordResetUpdateOne) sqlSave(ctx context.Context) (_node *PasswordReset, err error) {
+	if err := pruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(passwordreset.Table, passwordreset.Columns, sqlgraph.NewFieldSpec(passwordreset.FieldID, field.TypeUUID))
+	id, ok := pruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PasswordReset.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, passwordreset.FieldID)
+		for _, f := range fields {
+			if !passwordreset.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != passwordreset.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pruo.mutation.Token(); ok {
+		_spec.SetField(passwordreset.FieldToken, field.TypeString, value)
+	}
+	if value, ok := pruo.mutation.ExpiresAt(); ok {
+		_spec.SetField(passwordreset.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := pruo.mutation.UsedAt(); ok {
+		_spec.SetField(passwordreset.FieldUsedAt, field.TypeTime, value)
+	}
+	if pruo.mutation.UsedAtCleared() {
+		_spec.ClearField(passwordreset.FieldUsedAt, field.TypeTime)
+	}
+	if pruo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passwordreset.OwnerTable,
+			Columns: []string{passwordreset.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pruo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passwordreset.OwnerTable,
+			Columns: []string{passwordreset.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PasswordReset{config: pruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{passwordreset.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pruo.mutation.done = true
+	return _node, nil
+}
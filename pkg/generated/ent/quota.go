@@ -0,0 +1,274 @@
+/*
+Copyright YEAR 1block.ai.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/group"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/quota"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/role"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+)
+
+// Quota is the model entity for the Quota schema.
+type Quota struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserId holds the value of the "userId" field.
+	UserId *uuid.UUID `json:"userId,omitempty"`
+	// GroupId holds the value of the "groupId" field.
+	GroupId *uuid.UUID `json:"groupId,omitempty"`
+	// RoleId holds the value of the "roleId" field.
+	RoleId *uuid.UUID `json:"roleId,omitempty"`
+	// RequestsPerMinute holds the value of the "requestsPerMinute" field.
+	RequestsPerMinute *int `json:"requestsPerMinute,omitempty"`
+	// ConcurrentStreams holds the value of the "concurrentStreams" field.
+	ConcurrentStreams *int `json:"concurrentStreams,omitempty"`
+	// DailyTokens holds the value of the "dailyTokens" field.
+	DailyTokens *int `json:"dailyTokens,omitempty"`
+	// CreatedAt holds the value of the "createdAt" field.
+	CreatedAt time.Time `json:"createdAt,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the QuotaQuery when eager-loading is set.
+	Edges        QuotaEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// QuotaEdges holds the relations/edges for other nodes in the graph.
+type QuotaEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Group holds the value of the group edge.
+	Group *Group `json:"group,omitempty"`
+	// Role holds the value of the role edge.
+	Role *Role `json:"role,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuotaEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// GroupOrErr returns the Group value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuotaEdges) GroupOrErr() (*Group, error) {
+	if e.Group != nil {
+		return e.Group, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: group.Label}
+	}
+	return nil, &NotLoadedError{edge: "group"}
+}
+
+// RoleOrErr returns the Role value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuotaEdges) RoleOrErr() (*Role, error) {
+	if e.Role != nil {
+		return e.Role, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: role.Label}
+	}
+	return nil, &NotLoadedError{edge: "role"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Quota) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case quota.FieldUserId, quota.FieldGroupId, quota.FieldRoleId:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case quota.FieldRequestsPerMinute, quota.FieldConcurrentStreams, quota.FieldDailyTokens:
+			values[i] = new(sql.NullInt64)
+		case quota.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case quota.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Quota fields.
+func (q *Quota) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case quota.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				q.ID = *value
+			}
+		case quota.FieldUserId:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field userId", values[i])
+			} else if value.Valid {
+				q.UserId = new(uuid.UUID)
+				*q.UserId = *value.S.(*uuid.UUID)
+			}
+		case quota.FieldGroupId:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field groupId", values[i])
+			} else if value.Valid {
+				q.GroupId = new(uuid.UUID)
+				*q.GroupId = *value.S.(*uuid.UUID)
+			}
+		case quota.FieldRoleId:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field roleId", values[i])
+			} else if value.Valid {
+				q.RoleId = new(uuid.UUID)
+				*q.RoleId = *value.S.(*uuid.UUID)
+			}
+		case quota.FieldRequestsPerMinute:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field requestsPerMinute", values[i])
+			} else if value.Valid {
+				q.RequestsPerMinute = new(int)
+				*q.RequestsPerMinute = int(value.Int64)
+			}
+		case quota.FieldConcurrentStreams:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field concurrentStreams", values[i])
+			} else if value.Valid {
+				q.ConcurrentStreams = new(int)
+				*q.ConcurrentStreams = int(value.Int64)
+			}
+		case quota.FieldDailyTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field dailyTokens", values[i])
+			} else if value.Valid {
+				q.DailyTokens = new(int)
+				*q.DailyTokens = int(value.Int64)
+			}
+		case quota.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field createdAt", values[i])
+			} else if value.Valid {
+				q.CreatedAt = value.Time
+			}
+		default:
+			q.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Quota.
+// This includes values selected through modifiers, order, etc.
+func (q *Quota) Value(name string) (ent.Value, error) {
+	return q.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Quota entity.
+func (q *Quota) QueryUser() *UserQuery {
+	return NewQuotaClient(q.config).QueryUser(q)
+}
+
+// QueryGroup queries the "group" edge of the Quota entity.
+func (q *Quota) QueryGroup() *GroupQuery {
+	return NewQuotaClient(q.config).QueryGroup(q)
+}
+
+// QueryRole queries the "role" edge of the Quota entity.
+func (q *Quota) QueryRole() *RoleQuery {
+	return NewQuotaClient(q.config).QueryRole(q)
+}
+
+// Update returns a builder for updating this Quota.
+// Note that you need to call Quota.Unwrap() before calling this method if this Quota
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (q *Quota) Update() *QuotaUpdateOne {
+	return NewQuotaClient(q.config).UpdateOne(q)
+}
+
+// Unwrap unwraps the Quota entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (q *Quota) Unwrap() *Quota {
+	_tx, ok := q.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Quota is not a transactional entity")
+	}
+	q.config.driver = _tx.drv
+	return q
+}
+
+// String implements the fmt.Stringer.
+func (q *Quota) String() string {
+	var builder strings.Builder
+	builder.WriteString("Quota(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", q.ID))
+	if v := q.UserId; v != nil {
+		builder.WriteString("userId=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := q.GroupId; v != nil {
+		builder.WriteString("groupId=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := q.RoleId; v != nil {
+		builder.WriteString("roleId=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := q.RequestsPerMinute; v != nil {
+		builder.WriteString("requestsPerMinute=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := q.ConcurrentStreams; v != nil {
+		builder.WriteString("concurrentStreams=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := q.DailyTokens; v != nil {
+		builder.WriteString("dailyTokens=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("createdAt=")
+	builder.WriteString(q.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// QuotaSlice is a parsable slice of Quota.
+type QuotaSlice []*Quota
@@ -0,0 +1,195 @@
+/*
+Copyright YEAR 1block.ai.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the quota type in the database.
+	Label = "quota"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserId holds the string denoting the userid field in the database.
+	FieldUserId = "user_id"
+	// FieldGroupId holds the string denoting the groupid field in the database.
+	FieldGroupId = "group_id"
+	// FieldRoleId holds the string denoting the roleid field in the database.
+	FieldRoleId = "role_id"
+	// FieldRequestsPerMinute holds the string denoting the requestsperminute field in the database.
+	FieldRequestsPerMinute = "requests_per_minute"
+	// FieldConcurrentStreams holds the string denoting the concurrentstreams field in the database.
+	FieldConcurrentStreams = "concurrent_streams"
+	// FieldDailyTokens holds the string denoting the dailytokens field in the database.
+	FieldDailyTokens = "daily_tokens"
+	// FieldCreatedAt holds the string denoting the createdat field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgeGroup holds the string denoting the group edge name in mutations.
+	EdgeGroup = "group"
+	// EdgeRole holds the string denoting the role edge name in mutations.
+	EdgeRole = "role"
+	// Table holds the table name of the quota in the database.
+	Table = "quota"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "quota"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+	// GroupTable is the table that holds the group relation/edge.
+	GroupTable = "quota"
+	// GroupInverseTable is the table name for the Group entity.
+	// It exists in this package in order to avoid circular dependency with the "group" package.
+	GroupInverseTable = "groups"
+	// GroupColumn is the table column denoting the group relation/edge.
+	GroupColumn = "group_id"
+	// RoleTable is the table that holds the role relation/edge.
+	RoleTable = "quota"
+	// RoleInverseTable is the table name for the Role entity.
+	// It exists in this package in order to avoid circular dependency with the "role" package.
+	RoleInverseTable = "roles"
+	// RoleColumn is the table column denoting the role relation/edge.
+	RoleColumn = "role_id"
+)
+
+// Columns holds all SQL columns for quota fields.
+var Columns = []string{
+	FieldID,
+	FieldUserId,
+	FieldGroupId,
+	FieldRoleId,
+	FieldRequestsPerMinute,
+	FieldConcurrentStreams,
+	FieldDailyTokens,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// RequestsPerMinuteValidator is a validator for the "requestsPerMinute" field. It is called by the builders before save.
+	RequestsPerMinuteValidator func(int) error
+	// ConcurrentStreamsValidator is a validator for the "concurrentStreams" field. It is called by the builders before save.
+	ConcurrentStreamsValidator func(int) error
+	// DailyTokensValidator is a validator for the "dailyTokens" field. It is called by the builders before save.
+	DailyTokensValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "createdAt" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the Quota queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserId orders the results by the userId field.
+func ByUserId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserId, opts...).ToFunc()
+}
+
+// ByGroupId orders the results by the groupId field.
+func ByGroupId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupId, opts...).ToFunc()
+}
+
+// ByRoleId orders the results by the roleId field.
+func ByRoleId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRoleId, opts...).ToFunc()
+}
+
+// ByRequestsPerMinute orders the results by the requestsPerMinute field.
+func ByRequestsPerMinute(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequestsPerMinute, opts...).ToFunc()
+}
+
+// ByConcurrentStreams orders the results by the concurrentStreams field.
+func ByConcurrentStreams(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConcurrentStreams, opts...).ToFunc()
+}
+
+// ByDailyTokens orders the results by the dailyTokens field.
+func ByDailyTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDailyTokens, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the createdAt field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByGroupField orders the results by group field.
+func ByGroupField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGroupStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRoleField orders the results by role field.
+func ByRoleField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRoleStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, UserTable, UserColumn),
+	)
+}
+func newGroupStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GroupInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, GroupTable, GroupColumn),
+	)
+}
+func newRoleStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RoleInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, RoleTable, RoleColumn),
+	)
+}
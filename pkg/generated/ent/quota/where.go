@@ -0,0 +1,470 @@
+/*
+Copyright YEAR 1block.ai.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Quota {
+	return predicate.Quota(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Quota {
+	return predicate.Quota(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Quota {
+	return predicate.Quota(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Quota {
+	return predicate.Quota(sql.FieldLTE(FieldID, id))
+}
+
+// UserId applies equality check predicate on the "userId" field. It's identical to UserIdEQ.
+func UserId(v uuid.UUID) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldUserId, v))
+}
+
+// GroupId applies equality check predicate on the "groupId" field. It's identical to GroupIdEQ.
+func GroupId(v uuid.UUID) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldGroupId, v))
+}
+
+// RoleId applies equality check predicate on the "roleId" field. It's identical to RoleIdEQ.
+func RoleId(v uuid.UUID) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldRoleId, v))
+}
+
+// RequestsPerMinute applies equality check predicate on the "requestsPerMinute" field. It's identical to RequestsPerMinuteEQ.
+func RequestsPerMinute(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldRequestsPerMinute, v))
+}
+
+// ConcurrentStreams applies equality check predicate on the "concurrentStreams" field. It's identical to ConcurrentStreamsEQ.
+func ConcurrentStreams(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldConcurrentStreams, v))
+}
+
+// DailyTokens applies equality check predicate on the "dailyTokens" field. It's identical to DailyTokensEQ.
+func DailyTokens(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldDailyTokens, v))
+}
+
+// CreatedAt applies equality check predicate on the "createdAt" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIdEQ applies the EQ predicate on the "userId" field.
+func UserIdEQ(v uuid.UUID) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldUserId, v))
+}
+
+// UserIdNEQ applies the NEQ predicate on the "userId" field.
+func UserIdNEQ(v uuid.UUID) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldUserId, v))
+}
+
+// UserIdIn applies the In predicate on the "userId" field.
+func UserIdIn(vs ...uuid.UUID) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldUserId, vs...))
+}
+
+// UserIdNotIn applies the NotIn predicate on the "userId" field.
+func UserIdNotIn(vs ...uuid.UUID) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldUserId, vs...))
+}
+
+// UserIdIsNil applies the IsNil predicate on the "userId" field.
+func UserIdIsNil() predicate.Quota {
+	return predicate.Quota(sql.FieldIsNull(FieldUserId))
+}
+
+// UserIdNotNil applies the NotNil predicate on the "userId" field.
+func UserIdNotNil() predicate.Quota {
+	return predicate.Quota(sql.FieldNotNull(FieldUserId))
+}
+
+// GroupIdEQ applies the EQ predicate on the "groupId" field.
+func GroupIdEQ(v uuid.UUID) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldGroupId, v))
+}
+
+// GroupIdNEQ applies the NEQ predicate on the "groupId" field.
+func GroupIdNEQ(v uuid.UUID) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldGroupId, v))
+}
+
+// GroupIdIn applies the In predicate on the "groupId" field.
+func GroupIdIn(vs ...uuid.UUID) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldGroupId, vs...))
+}
+
+// GroupIdNotIn applies the NotIn predicate on the "groupId" field.
+func GroupIdNotIn(vs ...uuid.UUID) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldGroupId, vs...))
+}
+
+// GroupIdIsNil applies the IsNil predicate on the "groupId" field.
+func GroupIdIsNil() predicate.Quota {
+	return predicate.Quota(sql.FieldIsNull(FieldGroupId))
+}
+
+// GroupIdNotNil applies the NotNil predicate on the "groupId" field.
+func GroupIdNotNil() predicate.Quota {
+	return predicate.Quota(sql.FieldNotNull(FieldGroupId))
+}
+
+// RoleIdEQ applies the EQ predicate on the "roleId" field.
+func RoleIdEQ(v uuid.UUID) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldRoleId, v))
+}
+
+// RoleIdNEQ applies the NEQ predicate on the "roleId" field.
+func RoleIdNEQ(v uuid.UUID) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldRoleId, v))
+}
+
+// RoleIdIn applies the In predicate on the "roleId" field.
+func RoleIdIn(vs ...uuid.UUID) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldRoleId, vs...))
+}
+
+// RoleIdNotIn applies the NotIn predicate on the "roleId" field.
+func RoleIdNotIn(vs ...uuid.UUID) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldRoleId, vs...))
+}
+
+// RoleIdIsNil applies the IsNil predicate on the "roleId" field.
+func RoleIdIsNil() predicate.Quota {
+	return predicate.Quota(sql.FieldIsNull(FieldRoleId))
+}
+
+// RoleIdNotNil applies the NotNil predicate on the "roleId" field.
+func RoleIdNotNil() predicate.Quota {
+	return predicate.Quota(sql.FieldNotNull(FieldRoleId))
+}
+
+// RequestsPerMinuteEQ applies the EQ predicate on the "requestsPerMinute" field.
+func RequestsPerMinuteEQ(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldRequestsPerMinute, v))
+}
+
+// RequestsPerMinuteNEQ applies the NEQ predicate on the "requestsPerMinute" field.
+func RequestsPerMinuteNEQ(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldRequestsPerMinute, v))
+}
+
+// RequestsPerMinuteIn applies the In predicate on the "requestsPerMinute" field.
+func RequestsPerMinuteIn(vs ...int) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldRequestsPerMinute, vs...))
+}
+
+// RequestsPerMinuteNotIn applies the NotIn predicate on the "requestsPerMinute" field.
+func RequestsPerMinuteNotIn(vs ...int) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldRequestsPerMinute, vs...))
+}
+
+// RequestsPerMinuteGT applies the GT predicate on the "requestsPerMinute" field.
+func RequestsPerMinuteGT(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldGT(FieldRequestsPerMinute, v))
+}
+
+// RequestsPerMinuteGTE applies the GTE predicate on the "requestsPerMinute" field.
+func RequestsPerMinuteGTE(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldGTE(FieldRequestsPerMinute, v))
+}
+
+// RequestsPerMinuteLT applies the LT predicate on the "requestsPerMinute" field.
+func RequestsPerMinuteLT(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldLT(FieldRequestsPerMinute, v))
+}
+
+// RequestsPerMinuteLTE applies the LTE predicate on the "requestsPerMinute" field.
+func RequestsPerMinuteLTE(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldLTE(FieldRequestsPerMinute, v))
+}
+
+// RequestsPerMinuteIsNil applies the IsNil predicate on the "requestsPerMinute" field.
+func RequestsPerMinuteIsNil() predicate.Quota {
+	return predicate.Quota(sql.FieldIsNull(FieldRequestsPerMinute))
+}
+
+// RequestsPerMinuteNotNil applies the NotNil predicate on the "requestsPerMinute" field.
+func RequestsPerMinuteNotNil() predicate.Quota {
+	return predicate.Quota(sql.FieldNotNull(FieldRequestsPerMinute))
+}
+
+// ConcurrentStreamsEQ applies the EQ predicate on the "concurrentStreams" field.
+func ConcurrentStreamsEQ(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldConcurrentStreams, v))
+}
+
+// ConcurrentStreamsNEQ applies the NEQ predicate on the "concurrentStreams" field.
+func ConcurrentStreamsNEQ(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldConcurrentStreams, v))
+}
+
+// ConcurrentStreamsIn applies the In predicate on the "concurrentStreams" field.
+func ConcurrentStreamsIn(vs ...int) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldConcurrentStreams, vs...))
+}
+
+// ConcurrentStreamsNotIn applies the NotIn predicate on the "concurrentStreams" field.
+func ConcurrentStreamsNotIn(vs ...int) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldConcurrentStreams, vs...))
+}
+
+// ConcurrentStreamsGT applies the GT predicate on the "concurrentStreams" field.
+func ConcurrentStreamsGT(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldGT(FieldConcurrentStreams, v))
+}
+
+// ConcurrentStreamsGTE applies the GTE predicate on the "concurrentStreams" field.
+func ConcurrentStreamsGTE(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldGTE(FieldConcurrentStreams, v))
+}
+
+// ConcurrentStreamsLT applies the LT predicate on the "concurrentStreams" field.
+func ConcurrentStreamsLT(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldLT(FieldConcurrentStreams, v))
+}
+
+// ConcurrentStreamsLTE applies the LTE predicate on the "concurrentStreams" field.
+func ConcurrentStreamsLTE(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldLTE(FieldConcurrentStreams, v))
+}
+
+// ConcurrentStreamsIsNil applies the IsNil predicate on the "concurrentStreams" field.
+func ConcurrentStreamsIsNil() predicate.Quota {
+	return predicate.Quota(sql.FieldIsNull(FieldConcurrentStreams))
+}
+
+// ConcurrentStreamsNotNil applies the NotNil predicate on the "concurrentStreams" field.
+func ConcurrentStreamsNotNil() predicate.Quota {
+	return predicate.Quota(sql.FieldNotNull(FieldConcurrentStreams))
+}
+
+// DailyTokensEQ applies the EQ predicate on the "dailyTokens" field.
+func DailyTokensEQ(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldDailyTokens, v))
+}
+
+// DailyTokensNEQ applies the NEQ predicate on the "dailyTokens" field.
+func DailyTokensNEQ(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldDailyTokens, v))
+}
+
+// DailyTokensIn applies the In predicate on the "dailyTokens" field.
+func DailyTokensIn(vs ...int) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldDailyTokens, vs...))
+}
+
+// DailyTokensNotIn applies the NotIn predicate on the "dailyTokens" field.
+func DailyTokensNotIn(vs ...int) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldDailyTokens, vs...))
+}
+
+// DailyTokensGT applies the GT predicate on the "dailyTokens" field.
+func DailyTokensGT(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldGT(FieldDailyTokens, v))
+}
+
+// DailyTokensGTE applies the GTE predicate on the "dailyTokens" field.
+func DailyTokensGTE(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldGTE(FieldDailyTokens, v))
+}
+
+// DailyTokensLT applies the LT predicate on the "dailyTokens" field.
+func DailyTokensLT(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldLT(FieldDailyTokens, v))
+}
+
+// DailyTokensLTE applies the LTE predicate on the "dailyTokens" field.
+func DailyTokensLTE(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldLTE(FieldDailyTokens, v))
+}
+
+// DailyTokensIsNil applies the IsNil predicate on the "dailyTokens" field.
+func DailyTokensIsNil() predicate.Quota {
+	return predicate.Quota(sql.FieldIsNull(FieldDailyTokens))
+}
+
+// DailyTokensNotNil applies the NotNil predicate on the "dailyTokens" field.
+func DailyTokensNotNil() predicate.Quota {
+	return predicate.Quota(sql.FieldNotNull(FieldDailyTokens))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "createdAt" field.
+func CreatedAtEQ(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "createdAt" field.
+func CreatedAtNEQ(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "createdAt" field.
+func CreatedAtIn(vs ...time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "createdAt" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "createdAt" field.
+func CreatedAtGT(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "createdAt" field.
+func CreatedAtGTE(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "createdAt" field.
+func CreatedAtLT(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "createdAt" field.
+func CreatedAtLTE(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Quota {
+	return predicate.Quota(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Quota {
+	return predicate.Quota(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGroup applies the HasEdge predicate on the "group" edge.
+func HasGroup() predicate.Quota {
+	return predicate.Quota(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, GroupTable, GroupColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGroupWith applies the HasEdge predicate on the "group" edge with a given conditions (other predicates).
+func HasGroupWith(preds ...predicate.Group) predicate.Quota {
+	return predicate.Quota(func(s *sql.Selector) {
+		step := newGroupStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRole applies the HasEdge predicate on the "role" edge.
+func HasRole() predicate.Quota {
+	return predicate.Quota(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, RoleTable, RoleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRoleWith applies the HasEdge predicate on the "role" edge with a given conditions (other predicates).
+func HasRoleWith(preds ...predicate.Role) predicate.Quota {
+	return predicate.Quota(func(s *sql.Selector) {
+		step := newRoleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Quota) predicate.Quota {
+	return predicate.Quota(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Quota) predicate.Quota {
+	return predicate.Quota(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Quota) predicate.Quota {
+	return predicate.Quota(sql.NotPredicates(p))
+}
@@ -0,0 +1,1143 @@
+/*
+Copyright YEAR 1block.ai.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/group"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/quota"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/role"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+)
+
+// QuotaCreate is the builder for creating a Quota entity.
+type QuotaCreate struct {
+	config
+	mutation *QuotaMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserId sets the "userId" field.
+func (qc *QuotaCreate) SetUserId(u uuid.UUID) *QuotaCreate {
+	qc.mutation.SetUserId(u)
+	return qc
+}
+
+// SetNillableUserId sets the "userId" field if the given value is not nil.
+func (qc *QuotaCreate) SetNillableUserId(u *uuid.UUID) *QuotaCreate {
+	if u != nil {
+		qc.SetUserId(*u)
+	}
+	return qc
+}
+
+// SetGroupId sets the "groupId" field.
+func (qc *QuotaCreate) SetGroupId(u uuid.UUID) *QuotaCreate {
+	qc.mutation.SetGroupId(u)
+	return qc
+}
+
+// SetNillableGroupId sets the "groupId" field if the given value is not nil.
+func (qc *QuotaCreate) SetNillableGroupId(u *uuid.UUID) *QuotaCreate {
+	if u != nil {
+		qc.SetGroupId(*u)
+	}
+	return qc
+}
+
+// SetRoleId sets the "roleId" field.
+func (qc *QuotaCreate) SetRoleId(u uuid.UUID) *QuotaCreate {
+	qc.mutation.SetRoleId(u)
+	return qc
+}
+
+// SetNillableRoleId sets the "roleId" field if the given value is not nil.
+func (qc *QuotaCreate) SetNillableRoleId(u *uuid.UUID) *QuotaCreate {
+	if u != nil {
+		qc.SetRoleId(*u)
+	}
+	return qc
+}
+
+// SetRequestsPerMinute sets the "requestsPerMinute" field.
+func (qc *QuotaCreate) SetRequestsPerMinute(i int) *QuotaCreate {
+	qc.mutation.SetRequestsPerMinute(i)
+	return qc
+}
+
+// SetNillableRequestsPerMinute sets the "requestsPerMinute" field if the given value is not nil.
+func (qc *QuotaCreate) SetNillableRequestsPerMinute(i *int) *QuotaCreate {
+	if i != nil {
+		qc.SetRequestsPerMinute(*i)
+	}
+	return qc
+}
+
+// SetConcurrentStreams sets the "concurrentStreams" field.
+func (qc *QuotaCreate) SetConcurrentStreams(i int) *QuotaCreate {
+	qc.mutation.SetConcurrentStreams(i)
+	return qc
+}
+
+// SetNillableConcurrentStreams sets the "concurrentStreams" field if the given value is not nil.
+func (qc *QuotaCreate) SetNillableConcurrentStreams(i *int) *QuotaCreate {
+	if i != nil {
+		qc.SetConcurrentStreams(*i)
+	}
+	return qc
+}
+
+// SetDailyTokens sets the "dailyTokens" field.
+func (qc *QuotaCreate) SetDailyTokens(i int) *QuotaCreate {
+	qc.mutation.SetDailyTokens(i)
+	return qc
+}
+
+// SetNillableDailyTokens sets the "dailyTokens" field if the given value is not nil.
+func (qc *QuotaCreate) SetNillableDailyTokens(i *int) *QuotaCreate {
+	if i != nil {
+		qc.SetDailyTokens(*i)
+	}
+	return qc
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (qc *QuotaCreate) SetCreatedAt(t time.Time) *QuotaCreate {
+	qc.mutation.SetCreatedAt(t)
+	return qc
+}
+
+// SetNillableCreatedAt sets the "createdAt" field if the given value is not nil.
+func (qc *QuotaCreate) SetNillableCreatedAt(t *time.Time) *QuotaCreate {
+	if t != nil {
+		qc.SetCreatedAt(*t)
+	}
+	return qc
+}
+
+// SetID sets the "id" field.
+func (qc *QuotaCreate) SetID(u uuid.UUID) *QuotaCreate {
+	qc.mutation.SetID(u)
+	return qc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (qc *QuotaCreate) SetNillableID(u *uuid.UUID) *QuotaCreate {
+	if u != nil {
+		qc.SetID(*u)
+	}
+	return qc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (qc *QuotaCreate) SetUserID(id uuid.UUID) *QuotaCreate {
+	qc.mutation.SetUserID(id)
+	return qc
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (qc *QuotaCreate) SetNillableUserID(id *uuid.UUID) *QuotaCreate {
+	if id != nil {
+		qc = qc.SetUserID(*id)
+	}
+	return qc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (qc *QuotaCreate) SetUser(u *User) *QuotaCreate {
+	return qc.SetUserID(u.ID)
+}
+
+// SetGroupID sets the "group" edge to the Group entity by ID.
+func (qc *QuotaCreate) SetGroupID(id uuid.UUID) *QuotaCreate {
+	qc.mutation.SetGroupID(id)
+	return qc
+}
+
+// SetNillableGroupID sets the "group" edge to the Group entity by ID if the given value is not nil.
+func (qc *QuotaCreate) SetNillableGroupID(id *uuid.UUID) *QuotaCreate {
+	if id != nil {
+		qc = qc.SetGroupID(*id)
+	}
+	return qc
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (qc *QuotaCreate) SetGroup(g *Group) *QuotaCreate {
+	return qc.SetGroupID(g.ID)
+}
+
+// SetRoleID sets the "role" edge to the Role entity by ID.
+func (qc *QuotaCreate) SetRoleID(id uuid.UUID) *QuotaCreate {
+	qc.mutation.SetRoleID(id)
+	return qc
+}
+
+// SetNillableRoleID sets the "role" edge to the Role entity by ID if the given value is not nil.
+func (qc *QuotaCreate) SetNillableRoleID(id *uuid.UUID) *QuotaCreate {
+	if id != nil {
+		qc = qc.SetRoleID(*id)
+	}
+	return qc
+}
+
+// SetRole sets the "role" edge to the Role entity.
+func (qc *QuotaCreate) SetRole(r *Role) *QuotaCreate {
+	return qc.SetRoleID(r.ID)
+}
+
+// Mutation returns the QuotaMutation object of the builder.
+func (qc *QuotaCreate) Mutation() *QuotaMutation {
+	return qc.mutation
+}
+
+// Save creates the Quota in the database.
+func (qc *QuotaCreate) Save(ctx context.Context) (*Quota, error) {
+	qc.defaults()
+	return withHooks(ctx, qc.sqlSave, qc.mutation, qc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (qc *QuotaCreate) SaveX(ctx context.Context) *Quota {
+	v, err := qc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qc *QuotaCreate) Exec(ctx context.Context) error {
+	_, err := qc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qc *QuotaCreate) ExecX(ctx context.Context) {
+	if err := qc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qc *QuotaCreate) defaults() {
+	if _, ok := qc.mutation.CreatedAt(); !ok {
+		v := quota.DefaultCreatedAt()
+		qc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := qc.mutation.ID(); !ok {
+		v := quota.DefaultID()
+		qc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qc *QuotaCreate) check() error {
+	if v, ok := qc.mutation.RequestsPerMinute(); ok {
+		if err := quota.RequestsPerMinuteValidator(v); err != nil {
+			return &ValidationError{Name: "requestsPerMinute", err: fmt.Errorf(`ent: validator failed for field "Quota.requestsPerMinute": %w`, err)}
+		}
+	}
+	if v, ok := qc.mutation.ConcurrentStreams(); ok {
+		if err := quota.ConcurrentStreamsValidator(v); err != nil {
+			return &ValidationError{Name: "concurrentStreams", err: fmt.Errorf(`ent: validator failed for field "Quota.concurrentStreams": %w`, err)}
+		}
+	}
+	if v, ok := qc.mutation.DailyTokens(); ok {
+		if err := quota.DailyTokensValidator(v); err != nil {
+			return &ValidationError{Name: "dailyTokens", err: fmt.Errorf(`ent: validator failed for field "Quota.dailyTokens": %w`, err)}
+		}
+	}
+	if _, ok := qc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "createdAt", err: errors.New(`ent: missing required field "Quota.createdAt"`)}
+	}
+	return nil
+}
+
+func (qc *QuotaCreate) sqlSave(ctx context.Context) (*Quota, error) {
+	if err := qc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := qc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, qc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	qc.mutation.id = &_node.ID
+	qc.mutation.done = true
+	return _node, nil
+}
+
+func (qc *QuotaCreate) createSpec() (*Quota, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Quota{config: qc.config}
+		_spec = sqlgraph.NewCreateSpec(quota.Table, sqlgraph.NewFieldSpec(quota.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = qc.conflict
+	if id, ok := qc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := qc.mutation.RequestsPerMinute(); ok {
+		_spec.SetField(quota.FieldRequestsPerMinute, field.TypeInt, value)
+		_node.RequestsPerMinute = &value
+	}
+	if value, ok := qc.mutation.ConcurrentStreams(); ok {
+		_spec.SetField(quota.FieldConcurrentStreams, field.TypeInt, value)
+		_node.ConcurrentStreams = &value
+	}
+	if value, ok := qc.mutation.DailyTokens(); ok {
+		_spec.SetField(quota.FieldDailyTokens, field.TypeInt, value)
+		_node.DailyTokens = &value
+	}
+	if value, ok := qc.mutation.CreatedAt(); ok {
+		_spec.SetField(quota.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := qc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   quota.UserTable,
+			Columns: []string{quota.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserId = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := qc.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   quota.GroupTable,
+			Columns: []string{quota.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GroupId = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := qc.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   quota.RoleTable,
+			Columns: []string{quota.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RoleId = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Quota.Create().
+//		SetUserId(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QuotaUpsert) {
+//			SetUserId(v+v).
+//		}).
+//		Exec(ctx)
+func (qc *QuotaCreate) OnConflict(opts ...sql.ConflictOption) *QuotaUpsertOne {
+	qc.conflict = opts
+	return &QuotaUpsertOne{
+		create: qc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Quota.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (qc *QuotaCreate) OnConflictColumns(columns ...string) *QuotaUpsertOne {
+	qc.conflict = append(qc.conflict, sql.ConflictColumns(columns...))
+	return &QuotaUpsertOne{
+		create: qc,
+	}
+}
+
+type (
+	// QuotaUpsertOne is the builder for "upsert"-ing
+	//  one Quota node.
+	QuotaUpsertOne struct {
+		create *QuotaCreate
+	}
+
+	// QuotaUpsert is the "OnConflict" setter.
+	QuotaUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserId sets the "userId" field.
+func (u *QuotaUpsert) SetUserId(v uuid.UUID) *QuotaUpsert {
+	u.Set(quota.FieldUserId, v)
+	return u
+}
+
+// UpdateUserId sets the "userId" field to the value that was provided on create.
+func (u *QuotaUpsert) UpdateUserId() *QuotaUpsert {
+	u.SetExcluded(quota.FieldUserId)
+	return u
+}
+
+// ClearUserId clears the value of the "userId" field.
+func (u *QuotaUpsert) ClearUserId() *QuotaUpsert {
+	u.SetNull(quota.FieldUserId)
+	return u
+}
+
+// SetGroupId sets the "groupId" field.
+func (u *QuotaUpsert) SetGroupId(v uuid.UUID) *QuotaUpsert {
+	u.Set(quota.FieldGroupId, v)
+	return u
+}
+
+// UpdateGroupId sets the "groupId" field to the value that was provided on create.
+func (u *QuotaUpsert) UpdateGroupId() *QuotaUpsert {
+	u.SetExcluded(quota.FieldGroupId)
+	return u
+}
+
+// ClearGroupId clears the value of the "groupId" field.
+func (u *QuotaUpsert) ClearGroupId() *QuotaUpsert {
+	u.SetNull(quota.FieldGroupId)
+	return u
+}
+
+// SetRoleId sets the "roleId" field.
+func (u *QuotaUpsert) SetRoleId(v uuid.UUID) *QuotaUpsert {
+	u.Set(quota.FieldRoleId, v)
+	return u
+}
+
+// UpdateRoleId sets the "roleId" field to the value that was provided on create.
+func (u *QuotaUpsert) UpdateRoleId() *QuotaUpsert {
+	u.SetExcluded(quota.FieldRoleId)
+	return u
+}
+
+// ClearRoleId clears the value of the "roleId" field.
+func (u *QuotaUpsert) ClearRoleId() *QuotaUpsert {
+	u.SetNull(quota.FieldRoleId)
+	return u
+}
+
+// SetRequestsPerMinute sets the "requestsPerMinute" field.
+func (u *QuotaUpsert) SetRequestsPerMinute(v int) *QuotaUpsert {
+	u.Set(quota.FieldRequestsPerMinute, v)
+	return u
+}
+
+// UpdateRequestsPerMinute sets the "requestsPerMinute" field to the value that was provided on create.
+func (u *QuotaUpsert) UpdateRequestsPerMinute() *QuotaUpsert {
+	u.SetExcluded(quota.FieldRequestsPerMinute)
+	return u
+}
+
+// AddRequestsPerMinute adds v to the "requestsPerMinute" field.
+func (u *QuotaUpsert) AddRequestsPerMinute(v int) *QuotaUpsert {
+	u.Add(quota.FieldRequestsPerMinute, v)
+	return u
+}
+
+// ClearRequestsPerMinute clears the value of the "requestsPerMinute" field.
+func (u *QuotaUpsert) ClearRequestsPerMinute() *QuotaUpsert {
+	u.SetNull(quota.FieldRequestsPerMinute)
+	return u
+}
+
+// SetConcurrentStreams sets the "concurrentStreams" field.
+func (u *QuotaUpsert) SetConcurrentStreams(v int) *QuotaUpsert {
+	u.Set(quota.FieldConcurrentStreams, v)
+	return u
+}
+
+// UpdateConcurrentStreams sets the "concurrentStreams" field to the value that was provided on create.
+func (u *QuotaUpsert) UpdateConcurrentStreams() *QuotaUpsert {
+	u.SetExcluded(quota.FieldConcurrentStreams)
+	return u
+}
+
+// AddConcurrentStreams adds v to the "concurrentStreams" field.
+func (u *QuotaUpsert) AddConcurrentStreams(v int) *QuotaUpsert {
+	u.Add(quota.FieldConcurrentStreams, v)
+	return u
+}
+
+// ClearConcurrentStreams clears the value of the "concurrentStreams" field.
+func (u *QuotaUpsert) ClearConcurrentStreams() *QuotaUpsert {
+	u.SetNull(quota.FieldConcurrentStreams)
+	return u
+}
+
+// SetDailyTokens sets the "dailyTokens" field.
+func (u *QuotaUpsert) SetDailyTokens(v int) *QuotaUpsert {
+	u.Set(quota.FieldDailyTokens, v)
+	return u
+}
+
+// UpdateDailyTokens sets the "dailyTokens" field to the value that was provided on create.
+func (u *QuotaUpsert) UpdateDailyTokens() *QuotaUpsert {
+	u.SetExcluded(quota.FieldDailyTokens)
+	return u
+}
+
+// AddDailyTokens adds v to the "dailyTokens" field.
+func (u *QuotaUpsert) AddDailyTokens(v int) *QuotaUpsert {
+	u.Add(quota.FieldDailyTokens, v)
+	return u
+}
+
+// ClearDailyTokens clears the value of the "dailyTokens" field.
+func (u *QuotaUpsert) ClearDailyTokens() *QuotaUpsert {
+	u.SetNull(quota.FieldDailyTokens)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Quota.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(quota.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *QuotaUpsertOne) UpdateNewValues() *QuotaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(quota.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(quota.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Quota.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *QuotaUpsertOne) Ignore() *QuotaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QuotaUpsertOne) DoNothing() *QuotaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QuotaCreate.OnConflict
+// documentation for more info.
+func (u *QuotaUpsertOne) Update(set func(*QuotaUpsert)) *QuotaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QuotaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserId sets the "userId" field.
+func (u *QuotaUpsertOne) SetUserId(v uuid.UUID) *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.SetUserId(v)
+	})
+}
+
+// UpdateUserId sets the "userId" field to the value that was provided on create.
+func (u *QuotaUpsertOne) UpdateUserId() *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.UpdateUserId()
+	})
+}
+
+// ClearUserId clears the value of the "userId" field.
+func (u *QuotaUpsertOne) ClearUserId() *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.ClearUserId()
+	})
+}
+
+// SetGroupId sets the "groupId" field.
+func (u *QuotaUpsertOne) SetGroupId(v uuid.UUID) *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.SetGroupId(v)
+	})
+}
+
+// UpdateGroupId sets the "groupId" field to the value that was provided on create.
+func (u *QuotaUpsertOne) UpdateGroupId() *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.UpdateGroupId()
+	})
+}
+
+// ClearGroupId clears the value of the "groupId" field.
+func (u *QuotaUpsertOne) ClearGroupId() *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.ClearGroupId()
+	})
+}
+
+// SetRoleId sets the "roleId" field.
+func (u *QuotaUpsertOne) SetRoleId(v uuid.UUID) *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.SetRoleId(v)
+	})
+}
+
+// UpdateRoleId sets the "roleId" field to the value that was provided on create.
+func (u *QuotaUpsertOne) UpdateRoleId() *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.UpdateRoleId()
+	})
+}
+
+// ClearRoleId clears the value of the "roleId" field.
+func (u *QuotaUpsertOne) ClearRoleId() *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.ClearRoleId()
+	})
+}
+
+// SetRequestsPerMinute sets the "requestsPerMinute" field.
+func (u *QuotaUpsertOne) SetRequestsPerMinute(v int) *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.SetRequestsPerMinute(v)
+	})
+}
+
+// AddRequestsPerMinute adds v to the "requestsPerMinute" field.
+func (u *QuotaUpsertOne) AddRequestsPerMinute(v int) *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.AddRequestsPerMinute(v)
+	})
+}
+
+// UpdateRequestsPerMinute sets the "requestsPerMinute" field to the value that was provided on create.
+func (u *QuotaUpsertOne) UpdateRequestsPerMinute() *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.UpdateRequestsPerMinute()
+	})
+}
+
+// ClearRequestsPerMinute clears the value of the "requestsPerMinute" field.
+func (u *QuotaUpsertOne) ClearRequestsPerMinute() *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.ClearRequestsPerMinute()
+	})
+}
+
+// SetConcurrentStreams sets the "concurrentStreams" field.
+func (u *QuotaUpsertOne) SetConcurrentStreams(v int) *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.SetConcurrentStreams(v)
+	})
+}
+
+// AddConcurrentStreams adds v to the "concurrentStreams" field.
+func (u *QuotaUpsertOne) AddConcurrentStreams(v int) *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.AddConcurrentStreams(v)
+	})
+}
+
+// UpdateConcurrentStreams sets the "concurrentStreams" field to the value that was provided on create.
+func (u *QuotaUpsertOne) UpdateConcurrentStreams() *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.UpdateConcurrentStreams()
+	})
+}
+
+// ClearConcurrentStreams clears the value of the "concurrentStreams" field.
+func (u *QuotaUpsertOne) ClearConcurrentStreams() *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.ClearConcurrentStreams()
+	})
+}
+
+// SetDailyTokens sets the "dailyTokens" field.
+func (u *QuotaUpsertOne) SetDailyTokens(v int) *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.SetDailyTokens(v)
+	})
+}
+
+// AddDailyTokens adds v to the "dailyTokens" field.
+func (u *QuotaUpsertOne) AddDailyTokens(v int) *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.AddDailyTokens(v)
+	})
+}
+
+// UpdateDailyTokens sets the "dailyTokens" field to the value that was provided on create.
+func (u *QuotaUpsertOne) UpdateDailyTokens() *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.UpdateDailyTokens()
+	})
+}
+
+// ClearDailyTokens clears the value of the "dailyTokens" field.
+func (u *QuotaUpsertOne) ClearDailyTokens() *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.ClearDailyTokens()
+	})
+}
+
+// Exec executes the query.
+func (u *QuotaUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for QuotaCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QuotaUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *QuotaUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: QuotaUpsertOne.ID is not supported by MySQL driver. Use QuotaUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *QuotaUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// QuotaCreateBulk is the builder for creating many Quota entities in bulk.
+type QuotaCreateBulk struct {
+	config
+	err      error
+	builders []*QuotaCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Quota entities in the database.
+func (qcb *QuotaCreateBulk) Save(ctx context.Context) ([]*Quota, error) {
+	if qcb.err != nil {
+		return nil, qcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(qcb.builders))
+	nodes := make([]*Quota, len(qcb.builders))
+	mutators := make([]Mutator, len(qcb.builders))
+	for i := range qcb.builders {
+		func(i int, root context.Context) {
+			builder := qcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QuotaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, qcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = qcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, qcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, qcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qcb *QuotaCreateBulk) SaveX(ctx context.Context) []*Quota {
+	v, err := qcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qcb *QuotaCreateBulk) Exec(ctx context.Context) error {
+	_, err := qcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qcb *QuotaCreateBulk) ExecX(ctx context.Context) {
+	if err := qcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Quota.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QuotaUpsert) {
+//			SetUserId(v+v).
+//		}).
+//		Exec(ctx)
+func (qcb *QuotaCreateBulk) OnConflict(opts ...sql.ConflictOption) *QuotaUpsertBulk {
+	qcb.conflict = opts
+	return &QuotaUpsertBulk{
+		create: qcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Quota.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (qcb *QuotaCreateBulk) OnConflictColumns(columns ...string) *QuotaUpsertBulk {
+	qcb.conflict = append(qcb.conflict, sql.ConflictColumns(columns...))
+	return &QuotaUpsertBulk{
+		create: qcb,
+	}
+}
+
+// QuotaUpsertBulk is the builder for "upsert"-ing
+// a bulk of Quota nodes.
+type QuotaUpsertBulk struct {
+	create *QuotaCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Quota.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(quota.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *QuotaUpsertBulk) UpdateNewValues() *QuotaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(quota.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(quota.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Quota.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *QuotaUpsertBulk) Ignore() *QuotaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QuotaUpsertBulk) DoNothing() *QuotaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QuotaCreateBulk.OnConflict
+// documentation for more info.
+func (u *QuotaUpsertBulk) Update(set func(*QuotaUpsert)) *QuotaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QuotaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserId sets the "userId" field.
+func (u *QuotaUpsertBulk) SetUserId(v uuid.UUID) *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.SetUserId(v)
+	})
+}
+
+// UpdateUserId sets the "userId" field to the value that was provided on create.
+func (u *QuotaUpsertBulk) UpdateUserId() *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.UpdateUserId()
+	})
+}
+
+// ClearUserId clears the value of the "userId" field.
+func (u *QuotaUpsertBulk) ClearUserId() *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.ClearUserId()
+	})
+}
+
+// SetGroupId sets the "groupId" field.
+func (u *QuotaUpsertBulk) SetGroupId(v uuid.UUID) *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.SetGroupId(v)
+	})
+}
+
+// UpdateGroupId sets the "groupId" field to the value that was provided on create.
+func (u *QuotaUpsertBulk) UpdateGroupId() *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.UpdateGroupId()
+	})
+}
+
+// ClearGroupId clears the value of the "groupId" field.
+func (u *QuotaUpsertBulk) ClearGroupId() *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.ClearGroupId()
+	})
+}
+
+// SetRoleId sets the "roleId" field.
+func (u *QuotaUpsertBulk) SetRoleId(v uuid.UUID) *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.SetRoleId(v)
+	})
+}
+
+// UpdateRoleId sets the "roleId" field to the value that was provided on create.
+func (u *QuotaUpsertBulk) UpdateRoleId() *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.UpdateRoleId()
+	})
+}
+
+// ClearRoleId clears the value of the "roleId" field.
+func (u *QuotaUpsertBulk) ClearRoleId() *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.ClearRoleId()
+	})
+}
+
+// SetRequestsPerMinute sets the "requestsPerMinute" field.
+func (u *QuotaUpsertBulk) SetRequestsPerMinute(v int) *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.SetRequestsPerMinute(v)
+	})
+}
+
+// AddRequestsPerMinute adds v to the "requestsPerMinute" field.
+func (u *QuotaUpsertBulk) AddRequestsPerMinute(v int) *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.AddRequestsPerMinute(v)
+	})
+}
+
+// UpdateRequestsPerMinute sets the "requestsPerMinute" field to the value that was provided on create.
+func (u *QuotaUpsertBulk) UpdateRequestsPerMinute() *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.UpdateRequestsPerMinute()
+	})
+}
+
+// ClearRequestsPerMinute clears the value of the "requestsPerMinute" field.
+func (u *QuotaUpsertBulk) ClearRequestsPerMinute() *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.ClearRequestsPerMinute()
+	})
+}
+
+// SetConcurrentStreams sets the "concurrentStreams" field.
+func (u *QuotaUpsertBulk) SetConcurrentStreams(v int) *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.SetConcurrentStreams(v)
+	})
+}
+
+// AddConcurrentStreams adds v to the "concurrentStreams" field.
+func (u *QuotaUpsertBulk) AddConcurrentStreams(v int) *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.AddConcurrentStreams(v)
+	})
+}
+
+// UpdateConcurrentStreams sets the "concurrentStreams" field to the value that was provided on create.
+func (u *QuotaUpsertBulk) UpdateConcurrentStreams() *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.UpdateConcurrentStreams()
+	})
+}
+
+// ClearConcurrentStreams clears the value of the "concurrentStreams" field.
+func (u *QuotaUpsertBulk) ClearConcurrentStreams() *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.ClearConcurrentStreams()
+	})
+}
+
+// SetDailyTokens sets the "dailyTokens" field.
+func (u *QuotaUpsertBulk) SetDailyTokens(v int) *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.SetDailyTokens(v)
+	})
+}
+
+// AddDailyTokens adds v to the "dailyTokens" field.
+func (u *QuotaUpsertBulk) AddDailyTokens(v int) *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.AddDailyTokens(v)
+	})
+}
+
+// UpdateDailyTokens sets the "dailyTokens" field to the value that was provided on create.
+func (u *QuotaUpsertBulk) UpdateDailyTokens() *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.UpdateDailyTokens()
+	})
+}
+
+// ClearDailyTokens clears the value of the "dailyTokens" field.
+func (u *QuotaUpsertBulk) ClearDailyTokens() *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.ClearDailyTokens()
+	})
+}
+
+// Exec executes the query.
+func (u *QuotaUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the QuotaCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for QuotaCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QuotaUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
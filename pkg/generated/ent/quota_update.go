@@ -0,0 +1,899 @@
+/*
+Copyright YEAR 1block.ai.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/group"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/predicate"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/quota"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/role"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+)
+
+// QuotaUpdate is the builder for updating Quota entities.
+type QuotaUpdate struct {
+	config
+	hooks    []Hook
+	mutation *QuotaMutation
+}
+
+// Where appends a list predicates to the QuotaUpdate builder.
+func (qu *QuotaUpdate) Where(ps ...predicate.Quota) *QuotaUpdate {
+	qu.mutation.Where(ps...)
+	return qu
+}
+
+// SetUserId sets the "userId" field.
+func (qu *QuotaUpdate) SetUserId(u uuid.UUID) *QuotaUpdate {
+	qu.mutation.SetUserId(u)
+	return qu
+}
+
+// SetNillableUserId sets the "userId" field if the given value is not nil.
+func (qu *QuotaUpdate) SetNillableUserId(u *uuid.UUID) *QuotaUpdate {
+	if u != nil {
+		qu.SetUserId(*u)
+	}
+	return qu
+}
+
+// ClearUserId clears the value of the "userId" field.
+func (qu *QuotaUpdate) ClearUserId() *QuotaUpdate {
+	qu.mutation.ClearUserId()
+	return qu
+}
+
+// SetGroupId sets the "groupId" field.
+func (qu *QuotaUpdate) SetGroupId(u uuid.UUID) *QuotaUpdate {
+	qu.mutation.SetGroupId(u)
+	return qu
+}
+
+// SetNillableGroupId sets the "groupId" field if the given value is not nil.
+func (qu *QuotaUpdate) SetNillableGroupId(u *uuid.UUID) *QuotaUpdate {
+	if u != nil {
+		qu.SetGroupId(*u)
+	}
+	return qu
+}
+
+// ClearGroupId clears the value of the "groupId" field.
+func (qu *QuotaUpdate) ClearGroupId() *QuotaUpdate {
+	qu.mutation.ClearGroupId()
+	return qu
+}
+
+// SetRoleId sets the "roleId" field.
+func (qu *QuotaUpdate) SetRoleId(u uuid.UUID) *QuotaUpdate {
+	qu.mutation.SetRoleId(u)
+	return qu
+}
+
+// SetNillableRoleId sets the "roleId" field if the given value is not nil.
+func (qu *QuotaUpdate) SetNillableRoleId(u *uuid.UUID) *QuotaUpdate {
+	if u != nil {
+		qu.SetRoleId(*u)
+	}
+	return qu
+}
+
+// ClearRoleId clears the value of the "roleId" field.
+func (qu *QuotaUpdate) ClearRoleId() *QuotaUpdate {
+	qu.mutation.ClearRoleId()
+	return qu
+}
+
+// SetRequestsPerMinute sets the "requestsPerMinute" field.
+func (qu *QuotaUpdate) SetRequestsPerMinute(i int) *QuotaUpdate {
+	qu.mutation.ResetRequestsPerMinute()
+	qu.mutation.SetRequestsPerMinute(i)
+	return qu
+}
+
+// SetNillableRequestsPerMinute sets the "requestsPerMinute" field if the given value is not nil.
+func (qu *QuotaUpdate) SetNillableRequestsPerMinute(i *int) *QuotaUpdate {
+	if i != nil {
+		qu.SetRequestsPerMinute(*i)
+	}
+	return qu
+}
+
+// AddRequestsPerMinute adds i to the "requestsPerMinute" field.
+func (qu *QuotaUpdate) AddRequestsPerMinute(i int) *QuotaUpdate {
+	qu.mutation.AddRequestsPerMinute(i)
+	return qu
+}
+
+// ClearRequestsPerMinute clears the value of the "requestsPerMinute" field.
+func (qu *QuotaUpdate) ClearRequestsPerMinute() *QuotaUpdate {
+	qu.mutation.ClearRequestsPerMinute()
+	return qu
+}
+
+// SetConcurrentStreams sets the "concurrentStreams" field.
+func (qu *QuotaUpdate) SetConcurrentStreams(i int) *QuotaUpdate {
+	qu.mutation.ResetConcurrentStreams()
+	qu.mutation.SetConcurrentStreams(i)
+	return qu
+}
+
+// SetNillableConcurrentStreams sets the "concurrentStreams" field if the given value is not nil.
+func (qu *QuotaUpdate) SetNillableConcurrentStreams(i *int) *QuotaUpdate {
+	if i != nil {
+		qu.SetConcurrentStreams(*i)
+	}
+	return qu
+}
+
+// AddConcurrentStreams adds i to the "concurrentStreams" field.
+func (qu *QuotaUpdate) AddConcurrentStreams(i int) *QuotaUpdate {
+	qu.mutation.AddConcurrentStreams(i)
+	return qu
+}
+
+// ClearConcurrentStreams clears the value of the "concurrentStreams" field.
+func (qu *QuotaUpdate) ClearConcurrentStreams() *QuotaUpdate {
+	qu.mutation.ClearConcurrentStreams()
+	return qu
+}
+
+// SetDailyTokens sets the "dailyTokens" field.
+func (qu *QuotaUpdate) SetDailyTokens(i int) *QuotaUpdate {
+	qu.mutation.ResetDailyTokens()
+	qu.mutation.SetDailyTokens(i)
+	return qu
+}
+
+// SetNillableDailyTokens sets the "dailyTokens" field if the given value is not nil.
+func (qu *QuotaUpdate) SetNillableDailyTokens(i *int) *QuotaUpdate {
+	if i != nil {
+		qu.SetDailyTokens(*i)
+	}
+	return qu
+}
+
+// AddDailyTokens adds i to the "dailyTokens" field.
+func (qu *QuotaUpdate) AddDailyTokens(i int) *QuotaUpdate {
+	qu.mutation.AddDailyTokens(i)
+	return qu
+}
+
+// ClearDailyTokens clears the value of the "dailyTokens" field.
+func (qu *QuotaUpdate) ClearDailyTokens() *QuotaUpdate {
+	qu.mutation.ClearDailyTokens()
+	return qu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (qu *QuotaUpdate) SetUserID(id uuid.UUID) *QuotaUpdate {
+	qu.mutation.SetUserID(id)
+	return qu
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (qu *QuotaUpdate) SetNillableUserID(id *uuid.UUID) *QuotaUpdate {
+	if id != nil {
+		qu = qu.SetUserID(*id)
+	}
+	return qu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (qu *QuotaUpdate) SetUser(u *User) *QuotaUpdate {
+	return qu.SetUserID(u.ID)
+}
+
+// SetGroupID sets the "group" edge to the Group entity by ID.
+func (qu *QuotaUpdate) SetGroupID(id uuid.UUID) *QuotaUpdate {
+	qu.mutation.SetGroupID(id)
+	return qu
+}
+
+// SetNillableGroupID sets the "group" edge to the Group entity by ID if the given value is not nil.
+func (qu *QuotaUpdate) SetNillableGroupID(id *uuid.UUID) *QuotaUpdate {
+	if id != nil {
+		qu = qu.SetGroupID(*id)
+	}
+	return qu
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (qu *QuotaUpdate) SetGroup(g *Group) *QuotaUpdate {
+	return qu.SetGroupID(g.ID)
+}
+
+// SetRoleID sets the "role" edge to the Role entity by ID.
+func (qu *QuotaUpdate) SetRoleID(id uuid.UUID) *QuotaUpdate {
+	qu.mutation.SetRoleID(id)
+	return qu
+}
+
+// SetNillableRoleID sets the "role" edge to the Role entity by ID if the given value is not nil.
+func (qu *QuotaUpdate) SetNillableRoleID(id *uuid.UUID) *QuotaUpdate {
+	if id != nil {
+		qu = qu.SetRoleID(*id)
+	}
+	return qu
+}
+
+// SetRole sets the "role" edge to the Role entity.
+func (qu *QuotaUpdate) SetRole(r *Role) *QuotaUpdate {
+	return qu.SetRoleID(r.ID)
+}
+
+// Mutation returns the QuotaMutation object of the builder.
+func (qu *QuotaUpdate) Mutation() *QuotaMutation {
+	return qu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (qu *QuotaUpdate) ClearUser() *QuotaUpdate {
+	qu.mutation.ClearUser()
+	return qu
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (qu *QuotaUpdate) ClearGroup() *QuotaUpdate {
+	qu.mutation.ClearGroup()
+	return qu
+}
+
+// ClearRole clears the "role" edge to the Role entity.
+func (qu *QuotaUpdate) ClearRole() *QuotaUpdate {
+	qu.mutation.ClearRole()
+	return qu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (qu *QuotaUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, qu.sqlSave, qu.mutation, qu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qu *QuotaUpdate) SaveX(ctx context.Context) int {
+	affected, err := qu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (qu *QuotaUpdate) Exec(ctx context.Context) error {
+	_, err := qu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qu *QuotaUpdate) ExecX(ctx context.Context) {
+	if err := qu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qu *QuotaUpdate) check() error {
+	if v, ok := qu.mutation.RequestsPerMinute(); ok {
+		if err := quota.RequestsPerMinuteValidator(v); err != nil {
+			return &ValidationError{Name: "requestsPerMinute", err: fmt.Errorf(`ent: validator failed for field "Quota.requestsPerMinute": %w`, err)}
+		}
+	}
+	if v, ok := qu.mutation.ConcurrentStreams(); ok {
+		if err := quota.ConcurrentStreamsValidator(v); err != nil {
+			return &ValidationError{Name: "concurrentStreams", err: fmt.Errorf(`ent: validator failed for field "Quota.concurrentStreams": %w`, err)}
+		}
+	}
+	if v, ok := qu.mutation.DailyTokens(); ok {
+		if err := quota.DailyTokensValidator(v); err != nil {
+			return &ValidationError{Name: "dailyTokens", err: fmt.Errorf(`ent: validator failed for field "Quota.dailyTokens": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (qu *QuotaUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := qu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(quota.Table, quota.Columns, sqlgraph.NewFieldSpec(quota.FieldID, field.TypeUUID))
+	if ps := qu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qu.mutation.RequestsPerMinute(); ok {
+		_spec.SetField(quota.FieldRequestsPerMinute, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.AddedRequestsPerMinute(); ok {
+		_spec.AddField(quota.FieldRequestsPerMinute, field.TypeInt, value)
+	}
+	if qu.mutation.RequestsPerMinuteCleared() {
+		_spec.ClearField(quota.FieldRequestsPerMinute, field.TypeInt)
+	}
+	if value, ok := qu.mutation.ConcurrentStreams(); ok {
+		_spec.SetField(quota.FieldConcurrentStreams, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.AddedConcurrentStreams(); ok {
+		_spec.AddField(quota.FieldConcurrentStreams, field.TypeInt, value)
+	}
+	if qu.mutation.ConcurrentStreamsCleared() {
+		_spec.ClearField(quota.FieldConcurrentStreams, field.TypeInt)
+	}
+	if value, ok := qu.mutation.DailyTokens(); ok {
+		_spec.SetField(quota.FieldDailyTokens, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.AddedDailyTokens(); ok {
+		_spec.AddField(quota.FieldDailyTokens, field.TypeInt, value)
+	}
+	if qu.mutation.DailyTokensCleared() {
+		_spec.ClearField(quota.FieldDailyTokens, field.TypeInt)
+	}
+	if qu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   quota.UserTable,
+			Columns: []string{quota.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   quota.UserTable,
+			Columns: []string{quota.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if qu.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   quota.GroupTable,
+			Columns: []string{quota.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qu.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   quota.GroupTable,
+			Columns: []string{quota.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if qu.mutation.RoleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   quota.RoleTable,
+			Columns: []string{quota.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qu.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   quota.RoleTable,
+			Columns: []string{quota.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, qu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quota.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	qu.mutation.done = true
+	return n, nil
+}
+
+// QuotaUpdateOne is the builder for updating a single Quota entity.
+type QuotaUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *QuotaMutation
+}
+
+// SetUserId sets the "userId" field.
+func (quo *QuotaUpdateOne) SetUserId(u uuid.UUID) *QuotaUpdateOne {
+	quo.mutation.SetUserId(u)
+	return quo
+}
+
+// SetNillableUserId sets the "userId" field if the given value is not nil.
+func (quo *QuotaUpdateOne) SetNillableUserId(u *uuid.UUID) *QuotaUpdateOne {
+	if u != nil {
+		quo.SetUserId(*u)
+	}
+	return quo
+}
+
+// ClearUserId clears the value of the "userId" field.
+func (quo *QuotaUpdateOne) ClearUserId() *QuotaUpdateOne {
+	quo.mutation.ClearUserId()
+	return quo
+}
+
+// SetGroupId sets the "groupId" field.
+func (quo *QuotaUpdateOne) SetGroupId(u uuid.UUID) *QuotaUpdateOne {
+	quo.mutation.SetGroupId(u)
+	return quo
+}
+
+// SetNillableGroupId sets the "groupId" field if the given value is not nil.
+func (quo *QuotaUpdateOne) SetNillableGroupId(u *uuid.UUID) *QuotaUpdateOne {
+	if u != nil {
+		quo.SetGroupId(*u)
+	}
+	return quo
+}
+
+// ClearGroupId clears the value of the "groupId" field.
+func (quo *QuotaUpdateOne) ClearGroupId() *QuotaUpdateOne {
+	quo.mutation.ClearGroupId()
+	return quo
+}
+
+// SetRoleId sets the "roleId" field.
+func (quo *QuotaUpdateOne) SetRoleId(u uuid.UUID) *QuotaUpdateOne {
+	quo.mutation.SetRoleId(u)
+	return quo
+}
+
+// SetNillableRoleId sets the "roleId" field if the given value is not nil.
+func (quo *QuotaUpdateOne) SetNillableRoleId(u *uuid.UUID) *QuotaUpdateOne {
+	if u != nil {
+		quo.SetRoleId(*u)
+	}
+	return quo
+}
+
+// ClearRoleId clears the value of the "roleId" field.
+func (quo *QuotaUpdateOne) ClearRoleId() *QuotaUpdateOne {
+	quo.mutation.ClearRoleId()
+	return quo
+}
+
+// SetRequestsPerMinute sets the "requestsPerMinute" field.
+func (quo *QuotaUpdateOne) SetRequestsPerMinute(i int) *QuotaUpdateOne {
+	quo.mutation.ResetRequestsPerMinute()
+	quo.mutation.SetRequestsPerMinute(i)
+	return quo
+}
+
+// SetNillableRequestsPerMinute sets the "requestsPerMinute" field if the given value is not nil.
+func (quo *QuotaUpdateOne) SetNillableRequestsPerMinute(i *int) *QuotaUpdateOne {
+	if i != nil {
+		quo.SetRequestsPerMinute(*i)
+	}
+	return quo
+}
+
+// AddRequestsPerMinute adds i to the "requestsPerMinute" field.
+func (quo *QuotaUpdateOne) AddRequestsPerMinute(i int) *QuotaUpdateOne {
+	quo.mutation.AddRequestsPerMinute(i)
+	return quo
+}
+
+// ClearRequestsPerMinute clears the value of the "requestsPerMinute" field.
+func (quo *QuotaUpdateOne) ClearRequestsPerMinute() *QuotaUpdateOne {
+	quo.mutation.ClearRequestsPerMinute()
+	return quo
+}
+
+// SetConcurrentStreams sets the "concurrentStreams" field.
+func (quo *QuotaUpdateOne) SetConcurrentStreams(i int) *QuotaUpdateOne {
+	quo.mutation.ResetConcurrentStreams()
+	quo.mutation.SetConcurrentStreams(i)
+	return quo
+}
+
+// SetNillableConcurrentStreams sets the "concurrentStreams" field if the given value is not nil.
+func (quo *QuotaUpdateOne) SetNillableConcurrentStreams(i *int) *QuotaUpdateOne {
+	if i != nil {
+		quo.SetConcurrentStreams(*i)
+	}
+	return quo
+}
+
+// AddConcurrentStreams adds i to the "concurrentStreams" field.
+func (quo *QuotaUpdateOne) AddConcurrentStreams(i int) *QuotaUpdateOne {
+	quo.mutation.AddConcurrentStreams(i)
+	return quo
+}
+
+// ClearConcurrentStreams clears the value of the "concurrentStreams" field.
+func (quo *QuotaUpdateOne) ClearConcurrentStreams() *QuotaUpdateOne {
+	quo.mutation.ClearConcurrentStreams()
+	return quo
+}
+
+// SetDailyTokens sets the "dailyTokens" field.
+func (quo *QuotaUpdateOne) SetDailyTokens(i int) *QuotaUpdateOne {
+	quo.mutation.ResetDailyTokens()
+	quo.mutation.SetDailyTokens(i)
+	return quo
+}
+
+// SetNillableDailyTokens sets the "dailyTokens" field if the given value is not nil.
+func (quo *QuotaUpdateOne) SetNillableDailyTokens(i *int) *QuotaUpdateOne {
+	if i != nil {
+		quo.SetDailyTokens(*i)
+	}
+	return quo
+}
+
+// AddDailyTokens adds i to the "dailyTokens" field.
+func (quo *QuotaUpdateOne) AddDailyTokens(i int) *QuotaUpdateOne {
+	quo.mutation.AddDailyTokens(i)
+	return quo
+}
+
+// ClearDailyTokens clears the value of the "dailyTokens" field.
+func (quo *QuotaUpdateOne) ClearDailyTokens() *QuotaUpdateOne {
+	quo.mutation.ClearDailyTokens()
+	return quo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (quo *QuotaUpdateOne) SetUserID(id uuid.UUID) *QuotaUpdateOne {
+	quo.mutation.SetUserID(id)
+	return quo
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (quo *QuotaUpdateOne) SetNillableUserID(id *uuid.UUID) *QuotaUpdateOne {
+	if id != nil {
+		quo = quo.SetUserID(*id)
+	}
+	return quo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (quo *QuotaUpdateOne) SetUser(u *User) *QuotaUpdateOne {
+	return quo.SetUserID(u.ID)
+}
+
+// SetGroupID sets the "group" edge to the Group entity by ID.
+func (quo *QuotaUpdateOne) SetGroupID(id uuid.UUID) *QuotaUpdateOne {
+	quo.mutation.SetGroupID(id)
+	return quo
+}
+
+// SetNillableGroupID sets the "group" edge to the Group entity by ID if the given value is not nil.
+func (quo *QuotaUpdateOne) SetNillableGroupID(id *uuid.UUID) *QuotaUpdateOne {
+	if id != nil {
+		quo = quo.SetGroupID(*id)
+	}
+	return quo
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (quo *QuotaUpdateOne) SetGroup(g *Group) *QuotaUpdateOne {
+	return quo.SetGroupID(g.ID)
+}
+
+// SetRoleID sets the "role" edge to the Role entity by ID.
+func (quo *QuotaUpdateOne) SetRoleID(id uuid.UUID) *QuotaUpdateOne {
+	quo.mutation.SetRoleID(id)
+	return quo
+}
+
+// SetNillableRoleID sets the "role" edge to the Role entity by ID if the given value is not nil.
+func (quo *QuotaUpdateOne) SetNillableRoleID(id *uuid.UUID) *QuotaUpdateOne {
+	if id != nil {
+		quo = quo.SetRoleID(*id)
+	}
+	return quo
+}
+
+// SetRole sets the "role" edge to the Role entity.
+func (quo *QuotaUpdateOne) SetRole(r *Role) *QuotaUpdateOne {
+	return quo.SetRoleID(r.ID)
+}
+
+// Mutation returns the QuotaMutation object of the builder.
+func (quo *QuotaUpdateOne) Mutation() *QuotaMutation {
+	return quo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (quo *QuotaUpdateOne) ClearUser() *QuotaUpdateOne {
+	quo.mutation.ClearUser()
+	return quo
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (quo *QuotaUpdateOne) ClearGroup() *QuotaUpdateOne {
+	quo.mutation.ClearGroup()
+	return quo
+}
+
+// ClearRole clears the "role" edge to the Role entity.
+func (quo *QuotaUpdateOne) ClearRole() *QuotaUpdateOne {
+	quo.mutation.ClearRole()
+	return quo
+}
+
+// Where appends a list predicates to the QuotaUpdate builder.
+func (quo *QuotaUpdateOne) Where(ps ...predicate.Quota) *QuotaUpdateOne {
+	quo.mutation.Where(ps...)
+	return quo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (quo *QuotaUpdateOne) Select(field string, fields ...string) *QuotaUpdateOne {
+	quo.fields = append([]string{field}, fields...)
+	return quo
+}
+
+// Save executes the query and returns the updated Quota entity.
+func (quo *QuotaUpdateOne) Save(ctx context.Context) (*Quota, error) {
+	return withHooks(ctx, quo.sqlSave, quo.mutation, quo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (quo *QuotaUpdateOne) SaveX(ctx context.Context) *Quota {
+	node, err := quo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (quo *QuotaUpdateOne) Exec(ctx context.Context) error {
+	_, err := quo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (quo *QuotaUpdateOne) ExecX(ctx context.Context) {
+	if err := quo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (quo *QuotaUpdateOne) check() error {
+	if v, ok := quo.mutation.RequestsPerMinute(); ok {
+		if err := quota.RequestsPerMinuteValidator(v); err != nil {
+			return &ValidationError{Name: "requestsPerMinute", err: fmt.Errorf(`ent: validator failed for field "Quota.requestsPerMinute": %w`, err)}
+		}
+	}
+	if v, ok := quo.mutation.ConcurrentStreams(); ok {
+		if err := quota.ConcurrentStreamsValidator(v); err != nil {
+			return &ValidationError{Name: "concurrentStreams", err: fmt.Errorf(`ent: validator failed for field "Quota.concurrentStreams": %w`, err)}
+		}
+	}
+	if v, ok := quo.mutation.DailyTokens(); ok {
+		if err := quota.DailyTokensValidator(v); err != nil {
+			return &ValidationError{Name: "dailyTokens", err: fmt.Errorf(`ent: validator failed for field "Quota.dailyTokens": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (quo *QuotaUpdateOne) sqlSave(ctx context.Context) (_node *Quota, err error) {
+	if err := quo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(quota.Table, quota.Columns, sqlgraph.NewFieldSpec(quota.FieldID, field.TypeUUID))
+	id, ok := quo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Quota.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := quo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, quota.FieldID)
+		for _, f := range fields {
+			if !quota.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != quota.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := quo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := quo.mutation.RequestsPerMinute(); ok {
+		_spec.SetField(quota.FieldRequestsPerMinute, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.AddedRequestsPerMinute(); ok {
+		_spec.AddField(quota.FieldRequestsPerMinute, field.TypeInt, value)
+	}
+	if quo.mutation.RequestsPerMinuteCleared() {
+		_spec.ClearField(quota.FieldRequestsPerMinute, field.TypeInt)
+	}
+	if value, ok := quo.mutation.ConcurrentStreams(); ok {
+		_spec.SetField(quota.FieldConcurrentStreams, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.AddedConcurrentStreams(); ok {
+		_spec.AddField(quota.FieldConcurrentStreams, field.TypeInt, value)
+	}
+	if quo.mutation.ConcurrentStreamsCleared() {
+		_spec.ClearField(quota.FieldConcurrentStreams, field.TypeInt)
+	}
+	if value, ok := quo.mutation.DailyTokens(); ok {
+		_spec.SetField(quota.FieldDailyTokens, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.AddedDailyTokens(); ok {
+		_spec.AddField(quota.FieldDailyTokens, field.TypeInt, value)
+	}
+	if quo.mutation.DailyTokensCleared() {
+		_spec.ClearField(quota.FieldDailyTokens, field.TypeInt)
+	}
+	if quo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   quota.UserTable,
+			Columns: []string{quota.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := quo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   quota.UserTable,
+			Columns: []string{quota.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if quo.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   quota.GroupTable,
+			Columns: []string{quota.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := quo.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   quota.GroupTable,
+			Columns: []string{quota.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if quo.mutation.RoleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   quota.RoleTable,
+			Columns: []string{quota.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := quo.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   quota.RoleTable,
+			Columns: []string{quota.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Quota{config: quo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, quo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quota.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	quo.mutation.done = true
+	return _node, nil
+}
@@ -20,9 +20,22 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/apikey"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/auditevent"
 	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/chat"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/group"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/invitation"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/loginfailure"
 	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/modelfile"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/passwordreset"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/permission"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/quota"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/role"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/session"
 	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/setting"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/signingkey"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/tag"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/usagerecord"
 	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
 	v1 "github.com/llmos-ai/llmos-dashboard/pkg/types/v1"
 )
@@ -31,6 +44,62 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apikeyFields := v1.ApiKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescName is the schema descriptor for name field.
+	apikeyDescName := apikeyFields[2].Descriptor()
+	// apikey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apikey.NameValidator = apikeyDescName.Validators[0].(func(string) error)
+	// apikeyDescKey is the schema descriptor for key field.
+	apikeyDescKey := apikeyFields[3].Descriptor()
+	// apikey.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	apikey.KeyValidator = apikeyDescKey.Validators[0].(func(string) error)
+	// apikeyDescPrefix is the schema descriptor for prefix field.
+	apikeyDescPrefix := apikeyFields[4].Descriptor()
+	// apikey.DefaultPrefix holds the default value on creation for the prefix field.
+	apikey.DefaultPrefix = apikeyDescPrefix.Default.(string)
+	// apikeyDescCreatedAt is the schema descriptor for createdAt field.
+	apikeyDescCreatedAt := apikeyFields[7].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
+	// apikeyDescID is the schema descriptor for id field.
+	apikeyDescID := apikeyFields[0].Descriptor()
+	// apikey.DefaultID holds the default value on creation for the id field.
+	apikey.DefaultID = apikeyDescID.Default.(func() uuid.UUID)
+	auditeventFields := v1.AuditEvent{}.Fields()
+	_ = auditeventFields
+	// auditeventDescActorEmail is the schema descriptor for actorEmail field.
+	auditeventDescActorEmail := auditeventFields[2].Descriptor()
+	// auditevent.DefaultActorEmail holds the default value on creation for the actorEmail field.
+	auditevent.DefaultActorEmail = auditeventDescActorEmail.Default.(string)
+	// auditeventDescAction is the schema descriptor for action field.
+	auditeventDescAction := auditeventFields[4].Descriptor()
+	// auditevent.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	auditevent.ActionValidator = auditeventDescAction.Validators[0].(func(string) error)
+	// auditeventDescTargetType is the schema descriptor for targetType field.
+	auditeventDescTargetType := auditeventFields[5].Descriptor()
+	// auditevent.DefaultTargetType holds the default value on creation for the targetType field.
+	auditevent.DefaultTargetType = auditeventDescTargetType.Default.(string)
+	// auditeventDescTarget is the schema descriptor for target field.
+	auditeventDescTarget := auditeventFields[6].Descriptor()
+	// auditevent.DefaultTarget holds the default value on creation for the target field.
+	auditevent.DefaultTarget = auditeventDescTarget.Default.(string)
+	// auditeventDescIP is the schema descriptor for ip field.
+	auditeventDescIP := auditeventFields[7].Descriptor()
+	// auditevent.DefaultIP holds the default value on creation for the ip field.
+	auditevent.DefaultIP = auditeventDescIP.Default.(string)
+	// auditeventDescUserAgent is the schema descriptor for userAgent field.
+	auditeventDescUserAgent := auditeventFields[8].Descriptor()
+	// auditevent.DefaultUserAgent holds the default value on creation for the userAgent field.
+	auditevent.DefaultUserAgent = auditeventDescUserAgent.Default.(string)
+	// auditeventDescCreatedAt is the schema descriptor for createdAt field.
+	auditeventDescCreatedAt := auditeventFields[10].Descriptor()
+	// auditevent.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	auditevent.DefaultCreatedAt = auditeventDescCreatedAt.Default.(func() time.Time)
+	// auditeventDescID is the schema descriptor for id field.
+	auditeventDescID := auditeventFields[0].Descriptor()
+	// auditevent.DefaultID holds the default value on creation for the id field.
+	auditevent.DefaultID = auditeventDescID.Default.(func() uuid.UUID)
 	chatFields := v1.Chat{}.Fields()
 	_ = chatFields
 	// chatDescTitle is the schema descriptor for title field.
@@ -38,13 +107,79 @@ func init() {
 	// chat.TitleValidator is a validator for the "title" field. It is called by the builders before save.
 	chat.TitleValidator = chatDescTitle.Validators[0].(func(string) error)
 	// chatDescCreatedAt is the schema descriptor for createdAt field.
-	chatDescCreatedAt := chatFields[7].Descriptor()
+	chatDescCreatedAt := chatFields[6].Descriptor()
 	// chat.DefaultCreatedAt holds the default value on creation for the createdAt field.
-	chat.DefaultCreatedAt = chatDescCreatedAt.Default.(time.Time)
+	chat.DefaultCreatedAt = chatDescCreatedAt.Default.(func() time.Time)
+	// chatDescUpdatedAt is the schema descriptor for updatedAt field.
+	chatDescUpdatedAt := chatFields[7].Descriptor()
+	// chat.DefaultUpdatedAt holds the default value on creation for the updatedAt field.
+	chat.DefaultUpdatedAt = chatDescUpdatedAt.Default.(func() time.Time)
 	// chatDescID is the schema descriptor for id field.
 	chatDescID := chatFields[0].Descriptor()
 	// chat.DefaultID holds the default value on creation for the id field.
 	chat.DefaultID = chatDescID.Default.(func() uuid.UUID)
+	groupFields := v1.Group{}.Fields()
+	_ = groupFields
+	// groupDescName is the schema descriptor for name field.
+	groupDescName := groupFields[1].Descriptor()
+	// group.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	group.NameValidator = groupDescName.Validators[0].(func(string) error)
+	// groupDescDescription is the schema descriptor for description field.
+	groupDescDescription := groupFields[2].Descriptor()
+	// group.DefaultDescription holds the default value on creation for the description field.
+	group.DefaultDescription = groupDescDescription.Default.(string)
+	// groupDescModels is the schema descriptor for models field.
+	groupDescModels := groupFields[3].Descriptor()
+	// group.DefaultModels holds the default value on creation for the models field.
+	group.DefaultModels = groupDescModels.Default.([]string)
+	// groupDescCreatedAt is the schema descriptor for createdAt field.
+	groupDescCreatedAt := groupFields[4].Descriptor()
+	// group.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	group.DefaultCreatedAt = groupDescCreatedAt.Default.(func() time.Time)
+	// groupDescID is the schema descriptor for id field.
+	groupDescID := groupFields[0].Descriptor()
+	// group.DefaultID holds the default value on creation for the id field.
+	group.DefaultID = groupDescID.Default.(func() uuid.UUID)
+	invitationFields := v1.Invitation{}.Fields()
+	_ = invitationFields
+	// invitationDescEmail is the schema descriptor for email field.
+	invitationDescEmail := invitationFields[1].Descriptor()
+	// invitation.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	invitation.EmailValidator = invitationDescEmail.Validators[0].(func(string) error)
+	// invitationDescToken is the schema descriptor for token field.
+	invitationDescToken := invitationFields[3].Descriptor()
+	// invitation.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	invitation.TokenValidator = invitationDescToken.Validators[0].(func(string) error)
+	// invitationDescCreatedAt is the schema descriptor for createdAt field.
+	invitationDescCreatedAt := invitationFields[8].Descriptor()
+	// invitation.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	invitation.DefaultCreatedAt = invitationDescCreatedAt.Default.(func() time.Time)
+	// invitationDescID is the schema descriptor for id field.
+	invitationDescID := invitationFields[0].Descriptor()
+	// invitation.DefaultID holds the default value on creation for the id field.
+	invitation.DefaultID = invitationDescID.Default.(func() uuid.UUID)
+	loginfailureFields := v1.LoginFailure{}.Fields()
+	_ = loginfailureFields
+	// loginfailureDescEmail is the schema descriptor for email field.
+	loginfailureDescEmail := loginfailureFields[0].Descriptor()
+	// loginfailure.DefaultEmail holds the default value on creation for the email field.
+	loginfailure.DefaultEmail = loginfailureDescEmail.Default.(string)
+	// loginfailureDescIP is the schema descriptor for ip field.
+	loginfailureDescIP := loginfailureFields[1].Descriptor()
+	// loginfailure.DefaultIP holds the default value on creation for the ip field.
+	loginfailure.DefaultIP = loginfailureDescIP.Default.(string)
+	// loginfailureDescUserAgent is the schema descriptor for userAgent field.
+	loginfailureDescUserAgent := loginfailureFields[2].Descriptor()
+	// loginfailure.DefaultUserAgent holds the default value on creation for the userAgent field.
+	loginfailure.DefaultUserAgent = loginfailureDescUserAgent.Default.(string)
+	// loginfailureDescReason is the schema descriptor for reason field.
+	loginfailureDescReason := loginfailureFields[3].Descriptor()
+	// loginfailure.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	loginfailure.ReasonValidator = loginfailureDescReason.Validators[0].(func(string) error)
+	// loginfailureDescCreatedAt is the schema descriptor for createdAt field.
+	loginfailureDescCreatedAt := loginfailureFields[4].Descriptor()
+	// loginfailure.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	loginfailure.DefaultCreatedAt = loginfailureDescCreatedAt.Default.(func() time.Time)
 	modelfileFields := v1.Modelfile{}.Fields()
 	_ = modelfileFields
 	// modelfileDescTagName is the schema descriptor for tagName field.
@@ -65,6 +200,96 @@ func init() {
 	modelfileDescID := modelfileFields[0].Descriptor()
 	// modelfile.DefaultID holds the default value on creation for the id field.
 	modelfile.DefaultID = modelfileDescID.Default.(func() uuid.UUID)
+	passwordresetFields := v1.PasswordReset{}.Fields()
+	_ = passwordresetFields
+	// passwordresetDescToken is the schema descriptor for token field.
+	passwordresetDescToken := passwordresetFields[2].Descriptor()
+	// passwordreset.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	passwordreset.TokenValidator = passwordresetDescToken.Validators[0].(func(string) error)
+	// passwordresetDescCreatedAt is the schema descriptor for createdAt field.
+	passwordresetDescCreatedAt := passwordresetFields[5].Descriptor()
+	// passwordreset.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	passwordreset.DefaultCreatedAt = passwordresetDescCreatedAt.Default.(func() time.Time)
+	// passwordresetDescID is the schema descriptor for id field.
+	passwordresetDescID := passwordresetFields[0].Descriptor()
+	// passwordreset.DefaultID holds the default value on creation for the id field.
+	passwordreset.DefaultID = passwordresetDescID.Default.(func() uuid.UUID)
+	permissionFields := v1.Permission{}.Fields()
+	_ = permissionFields
+	// permissionDescName is the schema descriptor for name field.
+	permissionDescName := permissionFields[0].Descriptor()
+	// permission.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	permission.NameValidator = permissionDescName.Validators[0].(func(string) error)
+	// permissionDescDescription is the schema descriptor for description field.
+	permissionDescDescription := permissionFields[1].Descriptor()
+	// permission.DefaultDescription holds the default value on creation for the description field.
+	permission.DefaultDescription = permissionDescDescription.Default.(string)
+	quotaFields := v1.Quota{}.Fields()
+	_ = quotaFields
+	// quotaDescRequestsPerMinute is the schema descriptor for requestsPerMinute field.
+	quotaDescRequestsPerMinute := quotaFields[4].Descriptor()
+	// quota.RequestsPerMinuteValidator is a validator for the "requestsPerMinute" field. It is called by the builders before save.
+	quota.RequestsPerMinuteValidator = quotaDescRequestsPerMinute.Validators[0].(func(int) error)
+	// quotaDescConcurrentStreams is the schema descriptor for concurrentStreams field.
+	quotaDescConcurrentStreams := quotaFields[5].Descriptor()
+	// quota.ConcurrentStreamsValidator is a validator for the "concurrentStreams" field. It is called by the builders before save.
+	quota.ConcurrentStreamsValidator = quotaDescConcurrentStreams.Validators[0].(func(int) error)
+	// quotaDescDailyTokens is the schema descriptor for dailyTokens field.
+	quotaDescDailyTokens := quotaFields[6].Descriptor()
+	// quota.DailyTokensValidator is a validator for the "dailyTokens" field. It is called by the builders before save.
+	quota.DailyTokensValidator = quotaDescDailyTokens.Validators[0].(func(int) error)
+	// quotaDescCreatedAt is the schema descriptor for createdAt field.
+	quotaDescCreatedAt := quotaFields[7].Descriptor()
+	// quota.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	quota.DefaultCreatedAt = quotaDescCreatedAt.Default.(func() time.Time)
+	// quotaDescID is the schema descriptor for id field.
+	quotaDescID := quotaFields[0].Descriptor()
+	// quota.DefaultID holds the default value on creation for the id field.
+	quota.DefaultID = quotaDescID.Default.(func() uuid.UUID)
+	roleFields := v1.Role{}.Fields()
+	_ = roleFields
+	// roleDescName is the schema descriptor for name field.
+	roleDescName := roleFields[1].Descriptor()
+	// role.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	role.NameValidator = roleDescName.Validators[0].(func(string) error)
+	// roleDescDescription is the schema descriptor for description field.
+	roleDescDescription := roleFields[2].Descriptor()
+	// role.DefaultDescription holds the default value on creation for the description field.
+	role.DefaultDescription = roleDescDescription.Default.(string)
+	// roleDescBuiltin is the schema descriptor for builtin field.
+	roleDescBuiltin := roleFields[3].Descriptor()
+	// role.DefaultBuiltin holds the default value on creation for the builtin field.
+	role.DefaultBuiltin = roleDescBuiltin.Default.(bool)
+	// roleDescCreatedAt is the schema descriptor for createdAt field.
+	roleDescCreatedAt := roleFields[4].Descriptor()
+	// role.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	role.DefaultCreatedAt = roleDescCreatedAt.Default.(func() time.Time)
+	// roleDescID is the schema descriptor for id field.
+	roleDescID := roleFields[0].Descriptor()
+	// role.DefaultID holds the default value on creation for the id field.
+	role.DefaultID = roleDescID.Default.(func() uuid.UUID)
+	sessionFields := v1.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescRefreshToken is the schema descriptor for refreshToken field.
+	sessionDescRefreshToken := sessionFields[2].Descriptor()
+	// session.RefreshTokenValidator is a validator for the "refreshToken" field. It is called by the builders before save.
+	session.RefreshTokenValidator = sessionDescRefreshToken.Validators[0].(func(string) error)
+	// sessionDescUserAgent is the schema descriptor for userAgent field.
+	sessionDescUserAgent := sessionFields[3].Descriptor()
+	// session.DefaultUserAgent holds the default value on creation for the userAgent field.
+	session.DefaultUserAgent = sessionDescUserAgent.Default.(string)
+	// sessionDescIP is the schema descriptor for ip field.
+	sessionDescIP := sessionFields[4].Descriptor()
+	// session.DefaultIP holds the default value on creation for the ip field.
+	session.DefaultIP = sessionDescIP.Default.(string)
+	// sessionDescCreatedAt is the schema descriptor for createdAt field.
+	sessionDescCreatedAt := sessionFields[8].Descriptor()
+	// session.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	session.DefaultCreatedAt = sessionDescCreatedAt.Default.(func() time.Time)
+	// sessionDescID is the schema descriptor for id field.
+	sessionDescID := sessionFields[0].Descriptor()
+	// session.DefaultID holds the default value on creation for the id field.
+	session.DefaultID = sessionDescID.Default.(func() uuid.UUID)
 	settingFields := v1.Setting{}.Fields()
 	_ = settingFields
 	// settingDescName is the schema descriptor for name field.
@@ -83,6 +308,102 @@ func init() {
 	settingDescCreatedAt := settingFields[5].Descriptor()
 	// setting.DefaultCreatedAt holds the default value on creation for the createdAt field.
 	setting.DefaultCreatedAt = settingDescCreatedAt.Default.(time.Time)
+	signingkeyFields := v1.SigningKey{}.Fields()
+	_ = signingkeyFields
+	// signingkeyDescPrivateKey is the schema descriptor for privateKey field.
+	signingkeyDescPrivateKey := signingkeyFields[2].Descriptor()
+	// signingkey.PrivateKeyValidator is a validator for the "privateKey" field. It is called by the builders before save.
+	signingkey.PrivateKeyValidator = signingkeyDescPrivateKey.Validators[0].(func(string) error)
+	// signingkeyDescPublicKey is the schema descriptor for publicKey field.
+	signingkeyDescPublicKey := signingkeyFields[3].Descriptor()
+	// signingkey.DefaultPublicKey holds the default value on creation for the publicKey field.
+	signingkey.DefaultPublicKey = signingkeyDescPublicKey.Default.(string)
+	// signingkeyDescCreatedAt is the schema descriptor for createdAt field.
+	signingkeyDescCreatedAt := signingkeyFields[5].Descriptor()
+	// signingkey.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	signingkey.DefaultCreatedAt = signingkeyDescCreatedAt.Default.(func() time.Time)
+	// signingkeyDescID is the schema descriptor for id field.
+	signingkeyDescID := signingkeyFields[0].Descriptor()
+	// signingkey.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	signingkey.IDValidator = signingkeyDescID.Validators[0].(func(string) error)
+	tagFields := v1.Tag{}.Fields()
+	_ = tagFields
+	// tagDescName is the schema descriptor for name field.
+	tagDescName := tagFields[1].Descriptor()
+	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tag.NameValidator = func() func(string) error {
+		validators := tagDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// tagDescCreatedAt is the schema descriptor for createdAt field.
+	tagDescCreatedAt := tagFields[3].Descriptor()
+	// tag.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	tag.DefaultCreatedAt = tagDescCreatedAt.Default.(func() time.Time)
+	// tagDescID is the schema descriptor for id field.
+	tagDescID := tagFields[0].Descriptor()
+	// tag.DefaultID holds the default value on creation for the id field.
+	tag.DefaultID = tagDescID.Default.(func() uuid.UUID)
+	usagerecordFields := v1.UsageRecord{}.Fields()
+	_ = usagerecordFields
+	// usagerecordDescUserEmail is the schema descriptor for userEmail field.
+	usagerecordDescUserEmail := usagerecordFields[2].Descriptor()
+	// usagerecord.DefaultUserEmail holds the default value on creation for the userEmail field.
+	usagerecord.DefaultUserEmail = usagerecordDescUserEmail.Default.(string)
+	// usagerecordDescModel is the schema descriptor for model field.
+	usagerecordDescModel := usagerecordFields[3].Descriptor()
+	// usagerecord.DefaultModel holds the default value on creation for the model field.
+	usagerecord.DefaultModel = usagerecordDescModel.Default.(string)
+	// usagerecordDescEndpoint is the schema descriptor for endpoint field.
+	usagerecordDescEndpoint := usagerecordFields[4].Descriptor()
+	// usagerecord.DefaultEndpoint holds the default value on creation for the endpoint field.
+	usagerecord.DefaultEndpoint = usagerecordDescEndpoint.Default.(string)
+	// usagerecordDescPromptTokens is the schema descriptor for promptTokens field.
+	usagerecordDescPromptTokens := usagerecordFields[5].Descriptor()
+	// usagerecord.DefaultPromptTokens holds the default value on creation for the promptTokens field.
+	usagerecord.DefaultPromptTokens = usagerecordDescPromptTokens.Default.(int)
+	// usagerecordDescCompletionTokens is the schema descriptor for completionTokens field.
+	usagerecordDescCompletionTokens := usagerecordFields[6].Descriptor()
+	// usagerecord.DefaultCompletionTokens holds the default value on creation for the completionTokens field.
+	usagerecord.DefaultCompletionTokens = usagerecordDescCompletionTokens.Default.(int)
+	// usagerecordDescTotalDuration is the schema descriptor for totalDuration field.
+	usagerecordDescTotalDuration := usagerecordFields[7].Descriptor()
+	// usagerecord.DefaultTotalDuration holds the default value on creation for the totalDuration field.
+	usagerecord.DefaultTotalDuration = usagerecordDescTotalDuration.Default.(int64)
+	// usagerecordDescLoadDuration is the schema descriptor for loadDuration field.
+	usagerecordDescLoadDuration := usagerecordFields[8].Descriptor()
+	// usagerecord.DefaultLoadDuration holds the default value on creation for the loadDuration field.
+	usagerecord.DefaultLoadDuration = usagerecordDescLoadDuration.Default.(int64)
+	// usagerecordDescPromptEvalDuration is the schema descriptor for promptEvalDuration field.
+	usagerecordDescPromptEvalDuration := usagerecordFields[9].Descriptor()
+	// usagerecord.DefaultPromptEvalDuration holds the default value on creation for the promptEvalDuration field.
+	usagerecord.DefaultPromptEvalDuration = usagerecordDescPromptEvalDuration.Default.(int64)
+	// usagerecordDescEvalDuration is the schema descriptor for evalDuration field.
+	usagerecordDescEvalDuration := usagerecordFields[10].Descriptor()
+	// usagerecord.DefaultEvalDuration holds the default value on creation for the evalDuration field.
+	usagerecord.DefaultEvalDuration = usagerecordDescEvalDuration.Default.(int64)
+	// usagerecordDescLatency is the schema descriptor for latency field.
+	usagerecordDescLatency := usagerecordFields[11].Descriptor()
+	// usagerecord.DefaultLatency holds the default value on creation for the latency field.
+	usagerecord.DefaultLatency = usagerecordDescLatency.Default.(int64)
+	// usagerecordDescCreatedAt is the schema descriptor for createdAt field.
+	usagerecordDescCreatedAt := usagerecordFields[14].Descriptor()
+	// usagerecord.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	usagerecord.DefaultCreatedAt = usagerecordDescCreatedAt.Default.(func() time.Time)
+	// usagerecordDescID is the schema descriptor for id field.
+	usagerecordDescID := usagerecordFields[0].Descriptor()
+	// usagerecord.DefaultID holds the default value on creation for the id field.
+	usagerecord.DefaultID = usagerecordDescID.Default.(func() uuid.UUID)
 	userFields := v1.User{}.Fields()
 	_ = userFields
 	// userDescName is the schema descriptor for name field.
@@ -101,10 +422,22 @@ func init() {
 	userDescProfileImageUrl := userFields[5].Descriptor()
 	// user.DefaultProfileImageUrl holds the default value on creation for the profileImageUrl field.
 	user.DefaultProfileImageUrl = userDescProfileImageUrl.Default.(string)
+	// userDescTotpEnabled is the schema descriptor for totpEnabled field.
+	userDescTotpEnabled := userFields[7].Descriptor()
+	// user.DefaultTotpEnabled holds the default value on creation for the totpEnabled field.
+	user.DefaultTotpEnabled = userDescTotpEnabled.Default.(bool)
+	// userDescMfaChallengeAttempts is the schema descriptor for mfaChallengeAttempts field.
+	userDescMfaChallengeAttempts := userFields[11].Descriptor()
+	// user.DefaultMfaChallengeAttempts holds the default value on creation for the mfaChallengeAttempts field.
+	user.DefaultMfaChallengeAttempts = userDescMfaChallengeAttempts.Default.(int)
+	// userDescFailedLoginCount is the schema descriptor for failedLoginCount field.
+	userDescFailedLoginCount := userFields[12].Descriptor()
+	// user.DefaultFailedLoginCount holds the default value on creation for the failedLoginCount field.
+	user.DefaultFailedLoginCount = userDescFailedLoginCount.Default.(int)
 	// userDescCreatedAt is the schema descriptor for createdAt field.
-	userDescCreatedAt := userFields[6].Descriptor()
+	userDescCreatedAt := userFields[16].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the createdAt field.
-	user.DefaultCreatedAt = userDescCreatedAt.Default.(time.Time)
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescID is the schema descriptor for id field.
 	userDescID := userFields[0].Descriptor()
 	// user.DefaultID holds the default value on creation for the id field.
@@ -0,0 +1,164 @@
+/*
+Copyright YEAR 1block.ai.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/signingkey"
+)
+
+// SigningKey is the model entity for the SigningKey schema.
+type SigningKey struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Algorithm holds the value of the "algorithm" field.
+	Algorithm signingkey.Algorithm `json:"algorithm,omitempty"`
+	// PrivateKey holds the value of the "privateKey" field.
+	PrivateKey string `json:"-"`
+	// PublicKey holds the value of the "publicKey" field.
+	PublicKey string `json:"publicKey,omitempty"`
+	// ExpiresAt holds the value of the "expiresAt" field.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	// CreatedAt holds the value of the "createdAt" field.
+	CreatedAt    time.Time `json:"createdAt,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SigningKey) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case signingkey.FieldID, signingkey.FieldAlgorithm, signingkey.FieldPrivateKey, signingkey.FieldPublicKey:
+			values[i] = new(sql.NullString)
+		case signingkey.FieldExpiresAt, signingkey.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SigningKey fields.
+func (sk *SigningKey) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case signingkey.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				sk.ID = value.String
+			}
+		case signingkey.FieldAlgorithm:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field algorithm", values[i])
+			} else if value.Valid {
+				sk.Algorithm = signingkey.Algorithm(value.String)
+			}
+		case signingkey.FieldPrivateKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field privateKey", values[i])
+			} else if value.Valid {
+				sk.PrivateKey = value.String
+			}
+		case signingkey.FieldPublicKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field publicKey", values[i])
+			} else if value.Valid {
+				sk.PublicKey = value.String
+			}
+		case signingkey.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expiresAt", values[i])
+			} else if value.Valid {
+				sk.ExpiresAt = new(time.Time)
+				*sk.ExpiresAt = value.Time
+			}
+		case signingkey.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field createdAt", values[i])
+			} else if value.Valid {
+				sk.CreatedAt = value.Time
+			}
+		default:
+			sk.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SigningKey.
+// This includes values selected through modifiers, order, etc.
+func (sk *SigningKey) Value(name string) (ent.Value, error) {
+	return sk.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SigningKey.
+// Note that you need to call SigningKey.Unwrap() before calling this method if this SigningKey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sk *SigningKey) Update() *SigningKeyUpdateOne {
+	return NewSigningKeyClient(sk.config).UpdateOne(sk)
+}
+
+// Unwrap unwraps the SigningKey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sk *SigningKey) Unwrap() *SigningKey {
+	_tx, ok := sk.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SigningKey is not a transactional entity")
+	}
+	sk.config.driver = _tx.drv
+	return sk
+}
+
+// String implements the fmt.Stringer.
+func (sk *SigningKey) String() string {
+	var builder strings.Builder
+	builder.WriteString("SigningKey(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sk.ID))
+	builder.WriteString("algorithm=")
+	builder.WriteString(fmt.Sprintf("%v", sk.Algorithm))
+	builder.WriteString(", ")
+	builder.WriteString("privateKey=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("publicKey=")
+	builder.WriteString(sk.PublicKey)
+	builder.WriteString(", ")
+	if v := sk.ExpiresAt; v != nil {
+		builder.WriteString("expiresAt=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("createdAt=")
+	builder.WriteString(sk.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SigningKeys is a parsable slice of SigningKey.
+type SigningKeys []*SigningKey
@@ -0,0 +1,650 @@
+/*
+Copyright YEAR 1block.ai.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/signingkey"
+)
+
+// SigningKeyCreate is the builder for creating a SigningKey entity.
+type SigningKeyCreate struct {
+	config
+	mutation *SigningKeyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (skc *SigningKeyCreate) SetAlgorithm(s signingkey.Algorithm) *SigningKeyCreate {
+	skc.mutation.SetAlgorithm(s)
+	return skc
+}
+
+// SetPrivateKey sets the "privateKey" field.
+func (skc *SigningKeyCreate) SetPrivateKey(s string) *SigningKeyCreate {
+	skc.mutation.SetPrivateKey(s)
+	return skc
+}
+
+// SetPublicKey sets the "publicKey" field.
+func (skc *SigningKeyCreate) SetPublicKey(s string) *SigningKeyCreate {
+	skc.mutation.SetPublicKey(s)
+	return skc
+}
+
+// SetNillablePublicKey sets the "publicKey" field if the given value is not nil.
+func (skc *SigningKeyCreate) SetNillablePublicKey(s *string) *SigningKeyCreate {
+	if s != nil {
+		skc.SetPublicKey(*s)
+	}
+	return skc
+}
+
+// SetExpiresAt sets the "expiresAt" field.
+func (skc *SigningKeyCreate) SetExpiresAt(t time.Time) *SigningKeyCreate {
+	skc.mutation.SetExpiresAt(t)
+	return skc
+}
+
+// SetNillableExpiresAt sets the "expiresAt" field if the given value is not nil.
+func (skc *SigningKeyCreate) SetNillableExpiresAt(t *time.Time) *SigningKeyCreate {
+	if t != nil {
+		skc.SetExpiresAt(*t)
+	}
+	return skc
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (skc *SigningKeyCreate) SetCreatedAt(t time.Time) *SigningKeyCreate {
+	skc.mutation.SetCreatedAt(t)
+	return skc
+}
+
+// SetNillableCreatedAt sets the "createdAt" field if the given value is not nil.
+func (skc *SigningKeyCreate) SetNillableCreatedAt(t *time.Time) *SigningKeyCreate {
+	if t != nil {
+		skc.SetCreatedAt(*t)
+	}
+	return skc
+}
+
+// SetID sets the "id" field.
+func (skc *SigningKeyCreate) SetID(s string) *SigningKeyCreate {
+	skc.mutation.SetID(s)
+	return skc
+}
+
+// Mutation returns the SigningKeyMutation object of the builder.
+func (skc *SigningKeyCreate) Mutation() *SigningKeyMutation {
+	return skc.mutation
+}
+
+// Save creates the SigningKey in the database.
+func (skc *SigningKeyCreate) Save(ctx context.Context) (*SigningKey, error) {
+	skc.defaults()
+	return withHooks(ctx, skc.sqlSave, skc.mutation, skc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (skc *SigningKeyCreate) SaveX(ctx context.Context) *SigningKey {
+	v, err := skc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (skc *SigningKeyCreate) Exec(ctx context.Context) error {
+	_, err := skc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skc *SigningKeyCreate) ExecX(ctx context.Context) {
+	if err := skc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (skc *SigningKeyCreate) defaults() {
+	if _, ok := skc.mutation.PublicKey(); !ok {
+		v := signingkey.DefaultPublicKey
+		skc.mutation.SetPublicKey(v)
+	}
+	if _, ok := skc.mutation.CreatedAt(); !ok {
+		v := signingkey.DefaultCreatedAt()
+		skc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (skc *SigningKeyCreate) check() error {
+	if _, ok := skc.mutation.Algorithm(); !ok {
+		return &ValidationError{Name: "algorithm", err: errors.New(`ent: missing required field "SigningKey.algorithm"`)}
+	}
+	if v, ok := skc.mutation.Algorithm(); ok {
+		if err := signingkey.AlgorithmValidator(v); err != nil {
+			return &ValidationError{Name: "algorithm", err: fmt.Errorf(`ent: validator failed for field "SigningKey.algorithm": %w`, err)}
+		}
+	}
+	if _, ok := skc.mutation.PrivateKey(); !ok {
+		return &ValidationError{Name: "privateKey", err: errors.New(`ent: missing required field "SigningKey.privateKey"`)}
+	}
+	if v, ok := skc.mutation.PrivateKey(); ok {
+		if err := signingkey.PrivateKeyValidator(v); err != nil {
+			return &ValidationError{Name: "privateKey", err: fmt.Errorf(`ent: validator failed for field "SigningKey.privateKey": %w`, err)}
+		}
+	}
+	if _, ok := skc.mutation.PublicKey(); !ok {
+		return &ValidationError{Name: "publicKey", err: errors.New(`ent: missing required field "SigningKey.publicKey"`)}
+	}
+	if _, ok := skc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "createdAt", err: errors.New(`ent: missing required field "SigningKey.createdAt"`)}
+	}
+	if v, ok := skc.mutation.ID(); ok {
+		if err := signingkey.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "SigningKey.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (skc *SigningKeyCreate) sqlSave(ctx context.Context) (*SigningKey, error) {
+	if err := skc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := skc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, skc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SigningKey.ID type: %T", _spec.ID.Value)
+		}
+	}
+	skc.mutation.id = &_node.ID
+	skc.mutation.done = true
+	return _node, nil
+}
+
+func (skc *SigningKeyCreate) createSpec() (*SigningKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SigningKey{config: skc.config}
+		_spec = sqlgraph.NewCreateSpec(signingkey.Table, sqlgraph.NewFieldSpec(signingkey.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = skc.conflict
+	if id, ok := skc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := skc.mutation.Algorithm(); ok {
+		_spec.SetField(signingkey.FieldAlgorithm, field.TypeEnum, value)
+		_node.Algorithm = value
+	}
+	if value, ok := skc.mutation.PrivateKey(); ok {
+		_spec.SetField(signingkey.FieldPrivateKey, field.TypeString, value)
+		_node.PrivateKey = value
+	}
+	if value, ok := skc.mutation.PublicKey(); ok {
+		_spec.SetField(signingkey.FieldPublicKey, field.TypeString, value)
+		_node.PublicKey = value
+	}
+	if value, ok := skc.mutation.ExpiresAt(); ok {
+		_spec.SetField(signingkey.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := skc.mutation.CreatedAt(); ok {
+		_spec.SetField(signingkey.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SigningKey.Create().
+//		SetAlgorithm(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SigningKeyUpsert) {
+//			SetAlgorithm(v+v).
+//		}).
+//		Exec(ctx)
+func (skc *SigningKeyCreate) OnConflict(opts ...sql.ConflictOption) *SigningKeyUpsertOne {
+	skc.conflict = opts
+	return &SigningKeyUpsertOne{
+		create: skc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SigningKey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (skc *SigningKeyCreate) OnConflictColumns(columns ...string) *SigningKeyUpsertOne {
+	skc.conflict = append(skc.conflict, sql.ConflictColumns(columns...))
+	return &SigningKeyUpsertOne{
+		create: skc,
+	}
+}
+
+type (
+	// SigningKeyUpsertOne is the builder for "upsert"-ing
+	//  one SigningKey node.
+	SigningKeyUpsertOne struct {
+		create *SigningKeyCreate
+	}
+
+	// SigningKeyUpsert is the "OnConflict" setter.
+	SigningKeyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetExpiresAt sets the "expiresAt" field.
+func (u *SigningKeyUpsert) SetExpiresAt(v time.Time) *SigningKeyUpsert {
+	u.Set(signingkey.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expiresAt" field to the value that was provided on create.
+func (u *SigningKeyUpsert) UpdateExpiresAt() *SigningKeyUpsert {
+	u.SetExcluded(signingkey.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expiresAt" field.
+func (u *SigningKeyUpsert) ClearExpiresAt() *SigningKeyUpsert {
+	u.SetNull(signingkey.FieldExpiresAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.SigningKey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(signingkey.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SigningKeyUpsertOne) UpdateNewValues() *SigningKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(signingkey.FieldID)
+		}
+		if _, exists := u.create.mutation.Algorithm(); exists {
+			s.SetIgnore(signingkey.FieldAlgorithm)
+		}
+		if _, exists := u.create.mutation.PrivateKey(); exists {
+			s.SetIgnore(signingkey.FieldPrivateKey)
+		}
+		if _, exists := u.create.mutation.PublicKey(); exists {
+			s.SetIgnore(signingkey.FieldPublicKey)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(signingkey.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SigningKey.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SigningKeyUpsertOne) Ignore() *SigningKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SigningKeyUpsertOne) DoNothing() *SigningKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SigningKeyCreate.OnConflict
+// documentation for more info.
+func (u *SigningKeyUpsertOne) Update(set func(*SigningKeyUpsert)) *SigningKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SigningKeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetExpiresAt sets the "expiresAt" field.
+func (u *SigningKeyUpsertOne) SetExpiresAt(v time.Time) *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expiresAt" field to the value that was provided on create.
+func (u *SigningKeyUpsertOne) UpdateExpiresAt() *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expiresAt" field.
+func (u *SigningKeyUpsertOne) ClearExpiresAt() *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SigningKeyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SigningKeyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SigningKeyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SigningKeyUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: SigningKeyUpsertOne.ID is not supported by MySQL driver. Use SigningKeyUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SigningKeyUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SigningKeyCreateBulk is the builder for creating many SigningKey entities in bulk.
+type SigningKeyCreateBulk struct {
+	config
+	err      error
+	builders []*SigningKeyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SigningKey entities in the database.
+func (skcb *SigningKeyCreateBulk) Save(ctx context.Context) ([]*SigningKey, error) {
+	if skcb.err != nil {
+		return nil, skcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(skcb.builders))
+	nodes := make([]*SigningKey, len(skcb.builders))
+	mutators := make([]Mutator, len(skcb.builders))
+	for i := range skcb.builders {
+		func(i int, root context.Context) {
+			builder := skcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SigningKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, skcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = skcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, skcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, skcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (skcb *SigningKeyCreateBulk) SaveX(ctx context.Context) []*SigningKey {
+	v, err := skcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (skcb *SigningKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := skcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skcb *SigningKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := skcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SigningKey.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SigningKeyUpsert) {
+//			SetAlgorithm(v+v).
+//		}).
+//		Exec(ctx)
+func (skcb *SigningKeyCreateBulk) OnConflict(opts ...sql.ConflictOption) *SigningKeyUpsertBulk {
+	skcb.conflict = opts
+	return &SigningKeyUpsertBulk{
+		create: skcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SigningKey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (skcb *SigningKeyCreateBulk) OnConflictColumns(columns ...string) *SigningKeyUpsertBulk {
+	skcb.conflict = append(skcb.conflict, sql.ConflictColumns(columns...))
+	return &SigningKeyUpsertBulk{
+		create: skcb,
+	}
+}
+
+// SigningKeyUpsertBulk is the builder for "upsert"-ing
+// a bulk of SigningKey nodes.
+type SigningKeyUpsertBulk struct {
+	create *SigningKeyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SigningKey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(signingkey.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SigningKeyUpsertBulk) UpdateNewValues() *SigningKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(signingkey.FieldID)
+			}
+			if _, exists := b.mutation.Algorithm(); exists {
+				s.SetIgnore(signingkey.FieldAlgorithm)
+			}
+			if _, exists := b.mutation.PrivateKey(); exists {
+				s.SetIgnore(signingkey.FieldPrivateKey)
+			}
+			if _, exists := b.mutation.PublicKey(); exists {
+				s.SetIgnore(signingkey.FieldPublicKey)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(signingkey.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SigningKey.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SigningKeyUpsertBulk) Ignore() *SigningKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SigningKeyUpsertBulk) DoNothing() *SigningKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SigningKeyCreateBulk.OnConflict
+// documentation for more info.
+func (u *SigningKeyUpsertBulk) Update(set func(*SigningKeyUpsert)) *SigningKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SigningKeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetExpiresAt sets the "expiresAt" field.
+func (u *SigningKeyUpsertBulk) SetExpiresAt(v time.Time) *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expiresAt" field to the value that was provided on create.
+func (u *SigningKeyUpsertBulk) UpdateExpiresAt() *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expiresAt" field.
+func (u *SigningKeyUpsertBulk) ClearExpiresAt() *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SigningKeyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SigningKeyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SigningKeyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SigningKeyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,242 @@
+/*
+Copyright YEAR 1block.ai.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/predicate"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/signingkey"
+)
+
+// SigningKeyUpdate is the builder for updating SigningKey entities.
+type SigningKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SigningKeyMutation
+}
+
+// Where appends a list predicates to the SigningKeyUpdate builder.
+func (sku *SigningKeyUpdate) Where(ps ...predicate.SigningKey) *SigningKeyUpdate {
+	sku.mutation.Where(ps...)
+	return sku
+}
+
+// SetExpiresAt sets the "expiresAt" field.
+func (sku *SigningKeyUpdate) SetExpiresAt(t time.Time) *SigningKeyUpdate {
+	sku.mutation.SetExpiresAt(t)
+	return sku
+}
+
+// SetNillableExpiresAt sets the "expiresAt" field if the given value is not nil.
+func (sku *SigningKeyUpdate) SetNillableExpiresAt(t *time.Time) *SigningKeyUpdate {
+	if t != nil {
+		sku.SetExpiresAt(*t)
+	}
+	return sku
+}
+
+// ClearExpiresAt clears the value of the "expiresAt" field.
+func (sku *SigningKeyUpdate) ClearExpiresAt() *SigningKeyUpdate {
+	sku.mutation.ClearExpiresAt()
+	return sku
+}
+
+// Mutation returns the SigningKeyMutation object of the builder.
+func (sku *SigningKeyUpdate) Mutation() *SigningKeyMutation {
+	return sku.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sku *SigningKeyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, sku.sqlSave, sku.mutation, sku.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sku *SigningKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := sku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sku *SigningKeyUpdate) Exec(ctx context.Context) error {
+	_, err := sku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sku *SigningKeyUpdate) ExecX(ctx context.Context) {
+	if err := sku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (sku *SigningKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(signingkey.Table, signingkey.Columns, sqlgraph.NewFieldSpec(signingkey.FieldID, field.TypeString))
+	if ps := sku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sku.mutation.ExpiresAt(); ok {
+		_spec.SetField(signingkey.FieldExpiresAt, field.TypeTime, value)
+	}
+	if sku.mutation.ExpiresAtCleared() {
+		_spec.ClearField(signingkey.FieldExpiresAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{signingkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sku.mutation.done = true
+	return n, nil
+}
+
+// SigningKeyUpdateOne is the builder for updating a single SigningKey entity.
+type SigningKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SigningKeyMutation
+}
+
+// SetExpiresAt sets the "expiresAt" field.
+func (skuo *SigningKeyUpdateOne) SetExpiresAt(t time.Time) *SigningKeyUpdateOne {
+	skuo.mutation.SetExpiresAt(t)
+	return skuo
+}
+
+// SetNillableExpiresAt sets the "expiresAt" field if the given value is not nil.
+func (skuo *SigningKeyUpdateOne) SetNillableExpiresAt(t *time.Time) *SigningKeyUpdateOne {
+	if t != nil {
+		skuo.SetExpiresAt(*t)
+	}
+	return skuo
+}
+
+// ClearExpiresAt clears the value of the "expiresAt" field.
+func (skuo *SigningKeyUpdateOne) ClearExpiresAt() *SigningKeyUpdateOne {
+	skuo.mutation.ClearExpiresAt()
+	return skuo
+}
+
+// Mutation returns the SigningKeyMutation object of the builder.
+func (skuo *SigningKeyUpdateOne) Mutation() *SigningKeyMutation {
+	return skuo.mutation
+}
+
+// Where appends a list predicates to the SigningKeyUpdate builder.
+func (skuo *SigningKeyUpdateOne) Where(ps ...predicate.SigningKey) *SigningKeyUpdateOne {
+	skuo.mutation.Where(ps...)
+	return skuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (skuo *SigningKeyUpdateOne) Select(field string, fields ...string) *SigningKeyUpdateOne {
+	skuo.fields = append([]string{field}, fields...)
+	return skuo
+}
+
+// Save executes the query and returns the updated SigningKey entity.
+func (skuo *SigningKeyUpdateOne) Save(ctx context.Context) (*SigningKey, error) {
+	return withHooks(ctx, skuo.sqlSave, skuo.mutation, skuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (skuo *SigningKeyUpdateOne) SaveX(ctx context.Context) *SigningKey {
+	node, err := skuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (skuo *SigningKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := skuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skuo *SigningKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := skuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (skuo *SigningKeyUpdateOne) sqlSave(ctx context.Context) (_node *SigningKey, err error) {
+	_spec := sqlgraph.NewUpdateSpec(signingkey.Table, signingkey.Columns, sqlgraph.NewFieldSpec(signingkey.FieldID, field.TypeString))
+	id, ok := skuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SigningKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := skuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, signingkey.FieldID)
+		for _, f := range fields {
+			if !signingkey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != signingkey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := skuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := skuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(signingkey.FieldExpiresAt, field.TypeTime, value)
+	}
+	if skuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(signingkey.FieldExpiresAt, field.TypeTime)
+	}
+	_node = &SigningKey{config: skuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, skuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{signingkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	skuo.mutation.done = true
+	return _node, nil
+}
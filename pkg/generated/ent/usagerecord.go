@@ -0,0 +1,266 @@
+/*
+Copyright YEAR 1block.ai.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/usagerecord"
+)
+
+// UsageRecord is the model entity for the UsageRecord schema.
+type UsageRecord struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserId holds the value of the "userId" field.
+	UserId uuid.UUID `json:"userId,omitempty"`
+	// UserEmail holds the value of the "userEmail" field.
+	UserEmail string `json:"userEmail,omitempty"`
+	// Model holds the value of the "model" field.
+	Model string `json:"model,omitempty"`
+	// Endpoint holds the value of the "endpoint" field.
+	Endpoint string `json:"endpoint,omitempty"`
+	// PromptTokens holds the value of the "promptTokens" field.
+	PromptTokens int `json:"promptTokens,omitempty"`
+	// CompletionTokens holds the value of the "completionTokens" field.
+	CompletionTokens int `json:"completionTokens,omitempty"`
+	// TotalDuration holds the value of the "totalDuration" field.
+	TotalDuration int64 `json:"totalDuration,omitempty"`
+	// LoadDuration holds the value of the "loadDuration" field.
+	LoadDuration int64 `json:"loadDuration,omitempty"`
+	// PromptEvalDuration holds the value of the "promptEvalDuration" field.
+	PromptEvalDuration int64 `json:"promptEvalDuration,omitempty"`
+	// EvalDuration holds the value of the "evalDuration" field.
+	EvalDuration int64 `json:"evalDuration,omitempty"`
+	// Latency holds the value of the "latency" field.
+	Latency int64 `json:"latency,omitempty"`
+	// Status holds the value of the "status" field.
+	Status int `json:"status,omitempty"`
+	// Day holds the value of the "day" field.
+	Day string `json:"day,omitempty"`
+	// CreatedAt holds the value of the "createdAt" field.
+	CreatedAt    time.Time `json:"createdAt,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UsageRecord) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case usagerecord.FieldPromptTokens, usagerecord.FieldCompletionTokens, usagerecord.FieldTotalDuration, usagerecord.FieldLoadDuration, usagerecord.FieldPromptEvalDuration, usagerecord.FieldEvalDuration, usagerecord.FieldLatency, usagerecord.FieldStatus:
+			values[i] = new(sql.NullInt64)
+		case usagerecord.FieldUserEmail, usagerecord.FieldModel, usagerecord.FieldEndpoint, usagerecord.FieldDay:
+			values[i] = new(sql.NullString)
+		case usagerecord.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case usagerecord.FieldID, usagerecord.FieldUserId:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UsageRecord fields.
+func (ur *UsageRecord) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case usagerecord.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ur.ID = *value
+			}
+		case usagerecord.FieldUserId:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field userId", values[i])
+			} else if value != nil {
+				ur.UserId = *value
+			}
+		case usagerecord.FieldUserEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field userEmail", values[i])
+			} else if value.Valid {
+				ur.UserEmail = value.String
+			}
+		case usagerecord.FieldModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model", values[i])
+			} else if value.Valid {
+				ur.Model = value.String
+			}
+		case usagerecord.FieldEndpoint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field endpoint", values[i])
+			} else if value.Valid {
+				ur.Endpoint = value.String
+			}
+		case usagerecord.FieldPromptTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field promptTokens", values[i])
+			} else if value.Valid {
+				ur.PromptTokens = int(value.Int64)
+			}
+		case usagerecord.FieldCompletionTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field completionTokens", values[i])
+			} else if value.Valid {
+				ur.CompletionTokens = int(value.Int64)
+			}
+		case usagerecord.FieldTotalDuration:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field totalDuration", values[i])
+			} else if value.Valid {
+				ur.TotalDuration = value.Int64
+			}
+		case usagerecord.FieldLoadDuration:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field loadDuration", values[i])
+			} else if value.Valid {
+				ur.LoadDuration = value.Int64
+			}
+		case usagerecord.FieldPromptEvalDuration:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field promptEvalDuration", values[i])
+			} else if value.Valid {
+				ur.PromptEvalDuration = value.Int64
+			}
+		case usagerecord.FieldEvalDuration:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field evalDuration", values[i])
+			} else if value.Valid {
+				ur.EvalDuration = value.Int64
+			}
+		case usagerecord.FieldLatency:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field latency", values[i])
+			} else if value.Valid {
+				ur.Latency = value.Int64
+			}
+		case usagerecord.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ur.Status = int(value.Int64)
+			}
+		case usagerecord.FieldDay:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field day", values[i])
+			} else if value.Valid {
+				ur.Day = value.String
+			}
+		case usagerecord.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field createdAt", values[i])
+			} else if value.Valid {
+				ur.CreatedAt = value.Time
+			}
+		default:
+			ur.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UsageRecord.
+// This includes values selected through modifiers, order, etc.
+func (ur *UsageRecord) Value(name string) (ent.Value, error) {
+	return ur.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this UsageRecord.
+// Note that you need to call UsageRecord.Unwrap() before calling this method if this UsageRecord
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ur *UsageRecord) Update() *UsageRecordUpdateOne {
+	return NewUsageRecordClient(ur.config).UpdateOne(ur)
+}
+
+// Unwrap unwraps the UsageRecord entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ur *UsageRecord) Unwrap() *UsageRecord {
+	_tx, ok := ur.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UsageRecord is not a transactional entity")
+	}
+	ur.config.driver = _tx.drv
+	return ur
+}
+
+// String implements the fmt.Stringer.
+func (ur *UsageRecord) String() string {
+	var builder strings.Builder
+	builder.WriteString("UsageRecord(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ur.ID))
+	builder.WriteString("userId=")
+	builder.WriteString(fmt.Sprintf("%v", ur.UserId))
+	builder.WriteString(", ")
+	builder.WriteString("userEmail=")
+	builder.WriteString(ur.UserEmail)
+	builder.WriteString(", ")
+	builder.WriteString("model=")
+	builder.WriteString(ur.Model)
+	builder.WriteString(", ")
+	builder.WriteString("endpoint=")
+	builder.WriteString(ur.Endpoint)
+	builder.WriteString(", ")
+	builder.WriteString("promptTokens=")
+	builder.WriteString(fmt.Sprintf("%v", ur.PromptTokens))
+	builder.WriteString(", ")
+	builder.WriteString("completionTokens=")
+	builder.WriteString(fmt.Sprintf("%v", ur.CompletionTokens))
+	builder.WriteString(", ")
+	builder.WriteString("totalDuration=")
+	builder.WriteString(fmt.Sprintf("%v", ur.TotalDuration))
+	builder.WriteString(", ")
+	builder.WriteString("loadDuration=")
+	builder.WriteString(fmt.Sprintf("%v", ur.LoadDuration))
+	builder.WriteString(", ")
+	builder.WriteString("promptEvalDuration=")
+	builder.WriteString(fmt.Sprintf("%v", ur.PromptEvalDuration))
+	builder.WriteString(", ")
+	builder.WriteString("evalDuration=")
+	builder.WriteString(fmt.Sprintf("%v", ur.EvalDuration))
+	builder.WriteString(", ")
+	builder.WriteString("latency=")
+	builder.WriteString(fmt.Sprintf("%v", ur.Latency))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ur.Status))
+	builder.WriteString(", ")
+	builder.WriteString("day=")
+	builder.WriteString(ur.Day)
+	builder.WriteString(", ")
+	builder.WriteString("createdAt=")
+	builder.WriteString(ur.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UsageRecords is a parsable slice of UsageRecord.
+type UsageRecords []*UsageRecord
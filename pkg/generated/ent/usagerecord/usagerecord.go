@@ -0,0 +1,195 @@
+/*
+Copyright YEAR 1block.ai.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usagerecord
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the usagerecord type in the database.
+	Label = "usage_record"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserId holds the string denoting the userid field in the database.
+	FieldUserId = "user_id"
+	// FieldUserEmail holds the string denoting the useremail field in the database.
+	FieldUserEmail = "user_email"
+	// FieldModel holds the string denoting the model field in the database.
+	FieldModel = "model"
+	// FieldEndpoint holds the string denoting the endpoint field in the database.
+	FieldEndpoint = "endpoint"
+	// FieldPromptTokens holds the string denoting the prompttokens field in the database.
+	FieldPromptTokens = "prompt_tokens"
+	// FieldCompletionTokens holds the string denoting the completiontokens field in the database.
+	FieldCompletionTokens = "completion_tokens"
+	// FieldTotalDuration holds the string denoting the totalduration field in the database.
+	FieldTotalDuration = "total_duration"
+	// FieldLoadDuration holds the string denoting the loadduration field in the database.
+	FieldLoadDuration = "load_duration"
+	// FieldPromptEvalDuration holds the string denoting the promptevalduration field in the database.
+	FieldPromptEvalDuration = "prompt_eval_duration"
+	// FieldEvalDuration holds the string denoting the evalduration field in the database.
+	FieldEvalDuration = "eval_duration"
+	// FieldLatency holds the string denoting the latency field in the database.
+	FieldLatency = "latency"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldDay holds the string denoting the day field in the database.
+	FieldDay = "day"
+	// FieldCreatedAt holds the string denoting the createdat field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the usagerecord in the database.
+	Table = "usage_records"
+)
+
+// Columns holds all SQL columns for usagerecord fields.
+var Columns = []string{
+	FieldID,
+	FieldUserId,
+	FieldUserEmail,
+	FieldModel,
+	FieldEndpoint,
+	FieldPromptTokens,
+	FieldCompletionTokens,
+	FieldTotalDuration,
+	FieldLoadDuration,
+	FieldPromptEvalDuration,
+	FieldEvalDuration,
+	FieldLatency,
+	FieldStatus,
+	FieldDay,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultUserEmail holds the default value on creation for the "userEmail" field.
+	DefaultUserEmail string
+	// DefaultModel holds the default value on creation for the "model" field.
+	DefaultModel string
+	// DefaultEndpoint holds the default value on creation for the "endpoint" field.
+	DefaultEndpoint string
+	// DefaultPromptTokens holds the default value on creation for the "promptTokens" field.
+	DefaultPromptTokens int
+	// DefaultCompletionTokens holds the default value on creation for the "completionTokens" field.
+	DefaultCompletionTokens int
+	// DefaultTotalDuration holds the default value on creation for the "totalDuration" field.
+	DefaultTotalDuration int64
+	// DefaultLoadDuration holds the default value on creation for the "loadDuration" field.
+	DefaultLoadDuration int64
+	// DefaultPromptEvalDuration holds the default value on creation for the "promptEvalDuration" field.
+	DefaultPromptEvalDuration int64
+	// DefaultEvalDuration holds the default value on creation for the "evalDuration" field.
+	DefaultEvalDuration int64
+	// DefaultLatency holds the default value on creation for the "latency" field.
+	DefaultLatency int64
+	// DefaultCreatedAt holds the default value on creation for the "createdAt" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the UsageRecord queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserId orders the results by the userId field.
+func ByUserId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserId, opts...).ToFunc()
+}
+
+// ByUserEmail orders the results by the userEmail field.
+func ByUserEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserEmail, opts...).ToFunc()
+}
+
+// ByModel orders the results by the model field.
+func ByModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModel, opts...).ToFunc()
+}
+
+// ByEndpoint orders the results by the endpoint field.
+func ByEndpoint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndpoint, opts...).ToFunc()
+}
+
+// ByPromptTokens orders the results by the promptTokens field.
+func ByPromptTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPromptTokens, opts...).ToFunc()
+}
+
+// ByCompletionTokens orders the results by the completionTokens field.
+func ByCompletionTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletionTokens, opts...).ToFunc()
+}
+
+// ByTotalDuration orders the results by the totalDuration field.
+func ByTotalDuration(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalDuration, opts...).ToFunc()
+}
+
+// ByLoadDuration orders the results by the loadDuration field.
+func ByLoadDuration(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLoadDuration, opts...).ToFunc()
+}
+
+// ByPromptEvalDuration orders the results by the promptEvalDuration field.
+func ByPromptEvalDuration(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPromptEvalDuration, opts...).ToFunc()
+}
+
+// ByEvalDuration orders the results by the evalDuration field.
+func ByEvalDuration(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEvalDuration, opts...).ToFunc()
+}
+
+// ByLatency orders the results by the latency field.
+func ByLatency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatency, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByDay orders the results by the day field.
+func ByDay(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDay, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the createdAt field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
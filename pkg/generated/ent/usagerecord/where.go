@@ -0,0 +1,815 @@
+/*
+Copyright YEAR 1block.ai.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usagerecord
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLTE(FieldID, id))
+}
+
+// UserId applies equality check predicate on the "userId" field. It's identical to UserIdEQ.
+func UserId(v uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldUserId, v))
+}
+
+// UserEmail applies equality check predicate on the "userEmail" field. It's identical to UserEmailEQ.
+func UserEmail(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldUserEmail, v))
+}
+
+// Model applies equality check predicate on the "model" field. It's identical to ModelEQ.
+func Model(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldModel, v))
+}
+
+// Endpoint applies equality check predicate on the "endpoint" field. It's identical to EndpointEQ.
+func Endpoint(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldEndpoint, v))
+}
+
+// PromptTokens applies equality check predicate on the "promptTokens" field. It's identical to PromptTokensEQ.
+func PromptTokens(v int) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldPromptTokens, v))
+}
+
+// CompletionTokens applies equality check predicate on the "completionTokens" field. It's identical to CompletionTokensEQ.
+func CompletionTokens(v int) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldCompletionTokens, v))
+}
+
+// TotalDuration applies equality check predicate on the "totalDuration" field. It's identical to TotalDurationEQ.
+func TotalDuration(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldTotalDuration, v))
+}
+
+// LoadDuration applies equality check predicate on the "loadDuration" field. It's identical to LoadDurationEQ.
+func LoadDuration(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldLoadDuration, v))
+}
+
+// PromptEvalDuration applies equality check predicate on the "promptEvalDuration" field. It's identical to PromptEvalDurationEQ.
+func PromptEvalDuration(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldPromptEvalDuration, v))
+}
+
+// EvalDuration applies equality check predicate on the "evalDuration" field. It's identical to EvalDurationEQ.
+func EvalDuration(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldEvalDuration, v))
+}
+
+// Latency applies equality check predicate on the "latency" field. It's identical to LatencyEQ.
+func Latency(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldLatency, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v int) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldStatus, v))
+}
+
+// Day applies equality check predicate on the "day" field. It's identical to DayEQ.
+func Day(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldDay, v))
+}
+
+// CreatedAt applies equality check predicate on the "createdAt" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIdEQ applies the EQ predicate on the "userId" field.
+func UserIdEQ(v uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldUserId, v))
+}
+
+// UserIdNEQ applies the NEQ predicate on the "userId" field.
+func UserIdNEQ(v uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNEQ(FieldUserId, v))
+}
+
+// UserIdIn applies the In predicate on the "userId" field.
+func UserIdIn(vs ...uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldIn(FieldUserId, vs...))
+}
+
+// UserIdNotIn applies the NotIn predicate on the "userId" field.
+func UserIdNotIn(vs ...uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNotIn(FieldUserId, vs...))
+}
+
+// UserIdGT applies the GT predicate on the "userId" field.
+func UserIdGT(v uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGT(FieldUserId, v))
+}
+
+// UserIdGTE applies the GTE predicate on the "userId" field.
+func UserIdGTE(v uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGTE(FieldUserId, v))
+}
+
+// UserIdLT applies the LT predicate on the "userId" field.
+func UserIdLT(v uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLT(FieldUserId, v))
+}
+
+// UserIdLTE applies the LTE predicate on the "userId" field.
+func UserIdLTE(v uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLTE(FieldUserId, v))
+}
+
+// UserEmailEQ applies the EQ predicate on the "userEmail" field.
+func UserEmailEQ(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldUserEmail, v))
+}
+
+// UserEmailNEQ applies the NEQ predicate on the "userEmail" field.
+func UserEmailNEQ(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNEQ(FieldUserEmail, v))
+}
+
+// UserEmailIn applies the In predicate on the "userEmail" field.
+func UserEmailIn(vs ...string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldIn(FieldUserEmail, vs...))
+}
+
+// UserEmailNotIn applies the NotIn predicate on the "userEmail" field.
+func UserEmailNotIn(vs ...string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNotIn(FieldUserEmail, vs...))
+}
+
+// UserEmailGT applies the GT predicate on the "userEmail" field.
+func UserEmailGT(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGT(FieldUserEmail, v))
+}
+
+// UserEmailGTE applies the GTE predicate on the "userEmail" field.
+func UserEmailGTE(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGTE(FieldUserEmail, v))
+}
+
+// UserEmailLT applies the LT predicate on the "userEmail" field.
+func UserEmailLT(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLT(FieldUserEmail, v))
+}
+
+// UserEmailLTE applies the LTE predicate on the "userEmail" field.
+func UserEmailLTE(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLTE(FieldUserEmail, v))
+}
+
+// UserEmailContains applies the Contains predicate on the "userEmail" field.
+func UserEmailContains(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldContains(FieldUserEmail, v))
+}
+
+// UserEmailHasPrefix applies the HasPrefix predicate on the "userEmail" field.
+func UserEmailHasPrefix(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldHasPrefix(FieldUserEmail, v))
+}
+
+// UserEmailHasSuffix applies the HasSuffix predicate on the "userEmail" field.
+func UserEmailHasSuffix(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldHasSuffix(FieldUserEmail, v))
+}
+
+// UserEmailEqualFold applies the EqualFold predicate on the "userEmail" field.
+func UserEmailEqualFold(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEqualFold(FieldUserEmail, v))
+}
+
+// UserEmailContainsFold applies the ContainsFold predicate on the "userEmail" field.
+func UserEmailContainsFold(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldContainsFold(FieldUserEmail, v))
+}
+
+// ModelEQ applies the EQ predicate on the "model" field.
+func ModelEQ(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldModel, v))
+}
+
+// ModelNEQ applies the NEQ predicate on the "model" field.
+func ModelNEQ(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNEQ(FieldModel, v))
+}
+
+// ModelIn applies the In predicate on the "model" field.
+func ModelIn(vs ...string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldIn(FieldModel, vs...))
+}
+
+// ModelNotIn applies the NotIn predicate on the "model" field.
+func ModelNotIn(vs ...string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNotIn(FieldModel, vs...))
+}
+
+// ModelGT applies the GT predicate on the "model" field.
+func ModelGT(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGT(FieldModel, v))
+}
+
+// ModelGTE applies the GTE predicate on the "model" field.
+func ModelGTE(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGTE(FieldModel, v))
+}
+
+// ModelLT applies the LT predicate on the "model" field.
+func ModelLT(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLT(FieldModel, v))
+}
+
+// ModelLTE applies the LTE predicate on the "model" field.
+func ModelLTE(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLTE(FieldModel, v))
+}
+
+// ModelContains applies the Contains predicate on the "model" field.
+func ModelContains(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldContains(FieldModel, v))
+}
+
+// ModelHasPrefix applies the HasPrefix predicate on the "model" field.
+func ModelHasPrefix(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldHasPrefix(FieldModel, v))
+}
+
+// ModelHasSuffix applies the HasSuffix predicate on the "model" field.
+func ModelHasSuffix(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldHasSuffix(FieldModel, v))
+}
+
+// ModelEqualFold applies the EqualFold predicate on the "model" field.
+func ModelEqualFold(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEqualFold(FieldModel, v))
+}
+
+// ModelContainsFold applies the ContainsFold predicate on the "model" field.
+func ModelContainsFold(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldContainsFold(FieldModel, v))
+}
+
+// EndpointEQ applies the EQ predicate on the "endpoint" field.
+func EndpointEQ(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldEndpoint, v))
+}
+
+// EndpointNEQ applies the NEQ predicate on the "endpoint" field.
+func EndpointNEQ(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNEQ(FieldEndpoint, v))
+}
+
+// EndpointIn applies the In predicate on the "endpoint" field.
+func EndpointIn(vs ...string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldIn(FieldEndpoint, vs...))
+}
+
+// EndpointNotIn applies the NotIn predicate on the "endpoint" field.
+func EndpointNotIn(vs ...string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNotIn(FieldEndpoint, vs...))
+}
+
+// EndpointGT applies the GT predicate on the "endpoint" field.
+func EndpointGT(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGT(FieldEndpoint, v))
+}
+
+// EndpointGTE applies the GTE predicate on the "endpoint" field.
+func EndpointGTE(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGTE(FieldEndpoint, v))
+}
+
+// EndpointLT applies the LT predicate on the "endpoint" field.
+func EndpointLT(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLT(FieldEndpoint, v))
+}
+
+// EndpointLTE applies the LTE predicate on the "endpoint" field.
+func EndpointLTE(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLTE(FieldEndpoint, v))
+}
+
+// EndpointContains applies the Contains predicate on the "endpoint" field.
+func EndpointContains(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldContains(FieldEndpoint, v))
+}
+
+// EndpointHasPrefix applies the HasPrefix predicate on the "endpoint" field.
+func EndpointHasPrefix(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldHasPrefix(FieldEndpoint, v))
+}
+
+// EndpointHasSuffix applies the HasSuffix predicate on the "endpoint" field.
+func EndpointHasSuffix(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldHasSuffix(FieldEndpoint, v))
+}
+
+// EndpointEqualFold applies the EqualFold predicate on the "endpoint" field.
+func EndpointEqualFold(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEqualFold(FieldEndpoint, v))
+}
+
+// EndpointContainsFold applies the ContainsFold predicate on the "endpoint" field.
+func EndpointContainsFold(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldContainsFold(FieldEndpoint, v))
+}
+
+// PromptTokensEQ applies the EQ predicate on the "promptTokens" field.
+func PromptTokensEQ(v int) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldPromptTokens, v))
+}
+
+// PromptTokensNEQ applies the NEQ predicate on the "promptTokens" field.
+func PromptTokensNEQ(v int) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNEQ(FieldPromptTokens, v))
+}
+
+// PromptTokensIn applies the In predicate on the "promptTokens" field.
+func PromptTokensIn(vs ...int) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldIn(FieldPromptTokens, vs...))
+}
+
+// PromptTokensNotIn applies the NotIn predicate on the "promptTokens" field.
+func PromptTokensNotIn(vs ...int) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNotIn(FieldPromptTokens, vs...))
+}
+
+// PromptTokensGT applies the GT predicate on the "promptTokens" field.
+func PromptTokensGT(v int) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGT(FieldPromptTokens, v))
+}
+
+// PromptTokensGTE applies the GTE predicate on the "promptTokens" field.
+func PromptTokensGTE(v int) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGTE(FieldPromptTokens, v))
+}
+
+// PromptTokensLT applies the LT predicate on the "promptTokens" field.
+func PromptTokensLT(v int) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLT(FieldPromptTokens, v))
+}
+
+// PromptTokensLTE applies the LTE predicate on the "promptTokens" field.
+func PromptTokensLTE(v int) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLTE(FieldPromptTokens, v))
+}
+
+// CompletionTokensEQ applies the EQ predicate on the "completionTokens" field.
+func CompletionTokensEQ(v int) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldCompletionTokens, v))
+}
+
+// CompletionTokensNEQ applies the NEQ predicate on the "completionTokens" field.
+func CompletionTokensNEQ(v int) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNEQ(FieldCompletionTokens, v))
+}
+
+// CompletionTokensIn applies the In predicate on the "completionTokens" field.
+func CompletionTokensIn(vs ...int) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldIn(FieldCompletionTokens, vs...))
+}
+
+// CompletionTokensNotIn applies the NotIn predicate on the "completionTokens" field.
+func CompletionTokensNotIn(vs ...int) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNotIn(FieldCompletionTokens, vs...))
+}
+
+// CompletionTokensGT applies the GT predicate on the "completionTokens" field.
+func CompletionTokensGT(v int) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGT(FieldCompletionTokens, v))
+}
+
+// CompletionTokensGTE applies the GTE predicate on the "completionTokens" field.
+func CompletionTokensGTE(v int) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGTE(FieldCompletionTokens, v))
+}
+
+// CompletionTokensLT applies the LT predicate on the "completionTokens" field.
+func CompletionTokensLT(v int) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLT(FieldCompletionTokens, v))
+}
+
+// CompletionTokensLTE applies the LTE predicate on the "completionTokens" field.
+func CompletionTokensLTE(v int) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLTE(FieldCompletionTokens, v))
+}
+
+// TotalDurationEQ applies the EQ predicate on the "totalDuration" field.
+func TotalDurationEQ(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldTotalDuration, v))
+}
+
+// TotalDurationNEQ applies the NEQ predicate on the "totalDuration" field.
+func TotalDurationNEQ(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNEQ(FieldTotalDuration, v))
+}
+
+// TotalDurationIn applies the In predicate on the "totalDuration" field.
+func TotalDurationIn(vs ...int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldIn(FieldTotalDuration, vs...))
+}
+
+// TotalDurationNotIn applies the NotIn predicate on the "totalDuration" field.
+func TotalDurationNotIn(vs ...int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNotIn(FieldTotalDuration, vs...))
+}
+
+// TotalDurationGT applies the GT predicate on the "totalDuration" field.
+func TotalDurationGT(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGT(FieldTotalDuration, v))
+}
+
+// TotalDurationGTE applies the GTE predicate on the "totalDuration" field.
+func TotalDurationGTE(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGTE(FieldTotalDuration, v))
+}
+
+// TotalDurationLT applies the LT predicate on the "totalDuration" field.
+func TotalDurationLT(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLT(FieldTotalDuration, v))
+}
+
+// TotalDurationLTE applies the LTE predicate on the "totalDuration" field.
+func TotalDurationLTE(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLTE(FieldTotalDuration, v))
+}
+
+// LoadDurationEQ applies the EQ predicate on the "loadDuration" field.
+func LoadDurationEQ(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldLoadDuration, v))
+}
+
+// LoadDurationNEQ applies the NEQ predicate on the "loadDuration" field.
+func LoadDurationNEQ(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNEQ(FieldLoadDuration, v))
+}
+
+// LoadDurationIn applies the In predicate on the "loadDuration" field.
+func LoadDurationIn(vs ...int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldIn(FieldLoadDuration, vs...))
+}
+
+// LoadDurationNotIn applies the NotIn predicate on the "loadDuration" field.
+func LoadDurationNotIn(vs ...int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNotIn(FieldLoadDuration, vs...))
+}
+
+// LoadDurationGT applies the GT predicate on the "loadDuration" field.
+func LoadDurationGT(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGT(FieldLoadDuration, v))
+}
+
+// LoadDurationGTE applies the GTE predicate on the "loadDuration" field.
+func LoadDurationGTE(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGTE(FieldLoadDuration, v))
+}
+
+// LoadDurationLT applies the LT predicate on the "loadDuration" field.
+func LoadDurationLT(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLT(FieldLoadDuration, v))
+}
+
+// LoadDurationLTE applies the LTE predicate on the "loadDuration" field.
+func LoadDurationLTE(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLTE(FieldLoadDuration, v))
+}
+
+// PromptEvalDurationEQ applies the EQ predicate on the "promptEvalDuration" field.
+func PromptEvalDurationEQ(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldPromptEvalDuration, v))
+}
+
+// PromptEvalDurationNEQ applies the NEQ predicate on the "promptEvalDuration" field.
+func PromptEvalDurationNEQ(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNEQ(FieldPromptEvalDuration, v))
+}
+
+// PromptEvalDurationIn applies the In predicate on the "promptEvalDuration" field.
+func PromptEvalDurationIn(vs ...int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldIn(FieldPromptEvalDuration, vs...))
+}
+
+// PromptEvalDurationNotIn applies the NotIn predicate on the "promptEvalDuration" field.
+func PromptEvalDurationNotIn(vs ...int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNotIn(FieldPromptEvalDuration, vs...))
+}
+
+// PromptEvalDurationGT applies the GT predicate on the "promptEvalDuration" field.
+func PromptEvalDurationGT(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGT(FieldPromptEvalDuration, v))
+}
+
+// PromptEvalDurationGTE applies the GTE predicate on the "promptEvalDuration" field.
+func PromptEvalDurationGTE(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGTE(FieldPromptEvalDuration, v))
+}
+
+// PromptEvalDurationLT applies the LT predicate on the "promptEvalDuration" field.
+func PromptEvalDurationLT(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLT(FieldPromptEvalDuration, v))
+}
+
+// PromptEvalDurationLTE applies the LTE predicate on the "promptEvalDuration" field.
+func PromptEvalDurationLTE(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLTE(FieldPromptEvalDuration, v))
+}
+
+// EvalDurationEQ applies the EQ predicate on the "evalDuration" field.
+func EvalDurationEQ(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldEvalDuration, v))
+}
+
+// EvalDurationNEQ applies the NEQ predicate on the "evalDuration" field.
+func EvalDurationNEQ(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNEQ(FieldEvalDuration, v))
+}
+
+// EvalDurationIn applies the In predicate on the "evalDuration" field.
+func EvalDurationIn(vs ...int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldIn(FieldEvalDuration, vs...))
+}
+
+// EvalDurationNotIn applies the NotIn predicate on the "evalDuration" field.
+func EvalDurationNotIn(vs ...int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNotIn(FieldEvalDuration, vs...))
+}
+
+// EvalDurationGT applies the GT predicate on the "evalDuration" field.
+func EvalDurationGT(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGT(FieldEvalDuration, v))
+}
+
+// EvalDurationGTE applies the GTE predicate on the "evalDuration" field.
+func EvalDurationGTE(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGTE(FieldEvalDuration, v))
+}
+
+// EvalDurationLT applies the LT predicate on the "evalDuration" field.
+func EvalDurationLT(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLT(FieldEvalDuration, v))
+}
+
+// EvalDurationLTE applies the LTE predicate on the "evalDuration" field.
+func EvalDurationLTE(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLTE(FieldEvalDuration, v))
+}
+
+// LatencyEQ applies the EQ predicate on the "latency" field.
+func LatencyEQ(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldLatency, v))
+}
+
+// LatencyNEQ applies the NEQ predicate on the "latency" field.
+func LatencyNEQ(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNEQ(FieldLatency, v))
+}
+
+// LatencyIn applies the In predicate on the "latency" field.
+func LatencyIn(vs ...int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldIn(FieldLatency, vs...))
+}
+
+// LatencyNotIn applies the NotIn predicate on the "latency" field.
+func LatencyNotIn(vs ...int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNotIn(FieldLatency, vs...))
+}
+
+// LatencyGT applies the GT predicate on the "latency" field.
+func LatencyGT(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGT(FieldLatency, v))
+}
+
+// LatencyGTE applies the GTE predicate on the "latency" field.
+func LatencyGTE(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGTE(FieldLatency, v))
+}
+
+// LatencyLT applies the LT predicate on the "latency" field.
+func LatencyLT(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLT(FieldLatency, v))
+}
+
+// LatencyLTE applies the LTE predicate on the "latency" field.
+func LatencyLTE(v int64) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLTE(FieldLatency, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v int) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v int) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...int) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...int) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v int) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v int) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v int) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v int) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLTE(FieldStatus, v))
+}
+
+// DayEQ applies the EQ predicate on the "day" field.
+func DayEQ(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldDay, v))
+}
+
+// DayNEQ applies the NEQ predicate on the "day" field.
+func DayNEQ(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNEQ(FieldDay, v))
+}
+
+// DayIn applies the In predicate on the "day" field.
+func DayIn(vs ...string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldIn(FieldDay, vs...))
+}
+
+// DayNotIn applies the NotIn predicate on the "day" field.
+func DayNotIn(vs ...string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNotIn(FieldDay, vs...))
+}
+
+// DayGT applies the GT predicate on the "day" field.
+func DayGT(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGT(FieldDay, v))
+}
+
+// DayGTE applies the GTE predicate on the "day" field.
+func DayGTE(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGTE(FieldDay, v))
+}
+
+// DayLT applies the LT predicate on the "day" field.
+func DayLT(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLT(FieldDay, v))
+}
+
+// DayLTE applies the LTE predicate on the "day" field.
+func DayLTE(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLTE(FieldDay, v))
+}
+
+// DayContains applies the Contains predicate on the "day" field.
+func DayContains(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldContains(FieldDay, v))
+}
+
+// DayHasPrefix applies the HasPrefix predicate on the "day" field.
+func DayHasPrefix(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldHasPrefix(FieldDay, v))
+}
+
+// DayHasSuffix applies the HasSuffix predicate on the "day" field.
+func DayHasSuffix(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldHasSuffix(FieldDay, v))
+}
+
+// DayEqualFold applies the EqualFold predicate on the "day" field.
+func DayEqualFold(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEqualFold(FieldDay, v))
+}
+
+// DayContainsFold applies the ContainsFold predicate on the "day" field.
+func DayContainsFold(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldContainsFold(FieldDay, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "createdAt" field.
+func CreatedAtEQ(v time.Time) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "createdAt" field.
+func CreatedAtNEQ(v time.Time) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "createdAt" field.
+func CreatedAtIn(vs ...time.Time) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "createdAt" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "createdAt" field.
+func CreatedAtGT(v time.Time) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "createdAt" field.
+func CreatedAtGTE(v time.Time) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "createdAt" field.
+func CreatedAtLT(v time.Time) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "createdAt" field.
+func CreatedAtLTE(v time.Time) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UsageRecord) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UsageRecord) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UsageRecord) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.NotPredicates(p))
+}
@@ -0,0 +1,868 @@
+/*
+Copyright YEAR 1block.ai.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/usagerecord"
+)
+
+// UsageRecordCreate is the builder for creating a UsageRecord entity.
+type UsageRecordCreate struct {
+	config
+	mutation *UsageRecordMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserId sets the "userId" field.
+func (urc *UsageRecordCreate) SetUserId(u uuid.UUID) *UsageRecordCreate {
+	urc.mutation.SetUserId(u)
+	return urc
+}
+
+// SetUserEmail sets the "userEmail" field.
+func (urc *UsageRecordCreate) SetUserEmail(s string) *UsageRecordCreate {
+	urc.mutation.SetUserEmail(s)
+	return urc
+}
+
+// SetNillableUserEmail sets the "userEmail" field if the given value is not nil.
+func (urc *UsageRecordCreate) SetNillableUserEmail(s *string) *UsageRecordCreate {
+	if s != nil {
+		urc.SetUserEmail(*s)
+	}
+	return urc
+}
+
+// SetModel sets the "model" field.
+func (urc *UsageRecordCreate) SetModel(s string) *UsageRecordCreate {
+	urc.mutation.SetModel(s)
+	return urc
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (urc *UsageRecordCreate) SetNillableModel(s *string) *UsageRecordCreate {
+	if s != nil {
+		urc.SetModel(*s)
+	}
+	return urc
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (urc *UsageRecordCreate) SetEndpoint(s string) *UsageRecordCreate {
+	urc.mutation.SetEndpoint(s)
+	return urc
+}
+
+// SetNillableEndpoint sets the "endpoint" field if the given value is not nil.
+func (urc *UsageRecordCreate) SetNillableEndpoint(s *string) *UsageRecordCreate {
+	if s != nil {
+		urc.SetEndpoint(*s)
+	}
+	return urc
+}
+
+// SetPromptTokens sets the "promptTokens" field.
+func (urc *UsageRecordCreate) SetPromptTokens(i int) *UsageRecordCreate {
+	urc.mutation.SetPromptTokens(i)
+	return urc
+}
+
+// SetNillablePromptTokens sets the "promptTokens" field if the given value is not nil.
+func (urc *UsageRecordCreate) SetNillablePromptTokens(i *int) *UsageRecordCreate {
+	if i != nil {
+		urc.SetPromptTokens(*i)
+	}
+	return urc
+}
+
+// SetCompletionTokens sets the "completionTokens" field.
+func (urc *UsageRecordCreate) SetCompletionTokens(i int) *UsageRecordCreate {
+	urc.mutation.SetCompletionTokens(i)
+	return urc
+}
+
+// SetNillableCompletionTokens sets the "completionTokens" field if the given value is not nil.
+func (urc *UsageRecordCreate) SetNillableCompletionTokens(i *int) *UsageRecordCreate {
+	if i != nil {
+		urc.SetCompletionTokens(*i)
+	}
+	return urc
+}
+
+// SetTotalDuration sets the "totalDuration" field.
+func (urc *UsageRecordCreate) SetTotalDuration(i int64) *UsageRecordCreate {
+	urc.mutation.SetTotalDuration(i)
+	return urc
+}
+
+// SetNillableTotalDuration sets the "totalDuration" field if the given value is not nil.
+func (urc *UsageRecordCreate) SetNillableTotalDuration(i *int64) *UsageRecordCreate {
+	if i != nil {
+		urc.SetTotalDuration(*i)
+	}
+	return urc
+}
+
+// SetLoadDuration sets the "loadDuration" field.
+func (urc *UsageRecordCreate) SetLoadDuration(i int64) *UsageRecordCreate {
+	urc.mutation.SetLoadDuration(i)
+	return urc
+}
+
+// SetNillableLoadDuration sets the "loadDuration" field if the given value is not nil.
+func (urc *UsageRecordCreate) SetNillableLoadDuration(i *int64) *UsageRecordCreate {
+	if i != nil {
+		urc.SetLoadDuration(*i)
+	}
+	return urc
+}
+
+// SetPromptEvalDuration sets the "promptEvalDuration" field.
+func (urc *UsageRecordCreate) SetPromptEvalDuration(i int64) *UsageRecordCreate {
+	urc.mutation.SetPromptEvalDuration(i)
+	return urc
+}
+
+// SetNillablePromptEvalDuration sets the "promptEvalDuration" field if the given value is not nil.
+func (urc *UsageRecordCreate) SetNillablePromptEvalDuration(i *int64) *UsageRecordCreate {
+	if i != nil {
+		urc.SetPromptEvalDuration(*i)
+	}
+	return urc
+}
+
+// SetEvalDuration sets the "evalDuration" field.
+func (urc *UsageRecordCreate) SetEvalDuration(i int64) *UsageRecordCreate {
+	urc.mutation.SetEvalDuration(i)
+	return urc
+}
+
+// SetNillableEvalDuration sets the "evalDuration" field if the given value is not nil.
+func (urc *UsageRecordCreate) SetNillableEvalDuration(i *int64) *UsageRecordCreate {
+	if i != nil {
+		urc.SetEvalDuration(*i)
+	}
+	return urc
+}
+
+// SetLatency sets the "latency" field.
+func (urc *UsageRecordCreate) SetLatency(i int64) *UsageRecordCreate {
+	urc.mutation.SetLatency(i)
+	return urc
+}
+
+// SetNillableLatency sets the "latency" field if the given value is not nil.
+func (urc *UsageRecordCreate) SetNillableLatency(i *int64) *UsageRecordCreate {
+	if i != nil {
+		urc.SetLatency(*i)
+	}
+	return urc
+}
+
+// SetStatus sets the "status" field.
+func (urc *UsageRecordCreate) SetStatus(i int) *UsageRecordCreate {
+	urc.mutation.SetStatus(i)
+	return urc
+}
+
+// SetDay sets the "day" field.
+func (urc *UsageRecordCreate) SetDay(s string) *UsageRecordCreate {
+	urc.mutation.SetDay(s)
+	return urc
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (urc *UsageRecordCreate) SetCreatedAt(t time.Time) *UsageRecordCreate {
+	urc.mutation.SetCreatedAt(t)
+	return urc
+}
+
+// SetNillableCreatedAt sets the "createdAt" field if the given value is not nil.
+func (urc *UsageRecordCreate) SetNillableCreatedAt(t *time.Time) *UsageRecordCreate {
+	if t != nil {
+		urc.SetCreatedAt(*t)
+	}
+	return urc
+}
+
+// SetID sets the "id" field.
+func (urc *UsageRecordCreate) SetID(u uuid.UUID) *UsageRecordCreate {
+	urc.mutation.SetID(u)
+	return urc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (urc *UsageRecordCreate) SetNillableID(u *uuid.UUID) *UsageRecordCreate {
+	if u != nil {
+		urc.SetID(*u)
+	}
+	return urc
+}
+
+// Mutation returns the UsageRecordMutation object of the builder.
+func (urc *UsageRecordCreate) Mutation() *UsageRecordMutation {
+	return urc.mutation
+}
+
+// Save creates the UsageRecord in the database.
+func (urc *UsageRecordCreate) Save(ctx context.Context) (*UsageRecord, error) {
+	urc.defaults()
+	return withHooks(ctx, urc.sqlSave, urc.mutation, urc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (urc *UsageRecordCreate) SaveX(ctx context.Context) *UsageRecord {
+	v, err := urc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (urc *UsageRecordCreate) Exec(ctx context.Context) error {
+	_, err := urc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (urc *UsageRecordCreate) ExecX(ctx context.Context) {
+	if err := urc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (urc *UsageRecordCreate) defaults() {
+	if _, ok := urc.mutation.UserEmail(); !ok {
+		v := usagerecord.DefaultUserEmail
+		urc.mutation.SetUserEmail(v)
+	}
+	if _, ok := urc.mutation.Model(); !ok {
+		v := usagerecord.DefaultModel
+		urc.mutation.SetModel(v)
+	}
+	if _, ok := urc.mutation.Endpoint(); !ok {
+		v := usagerecord.DefaultEndpoint
+		urc.mutation.SetEndpoint(v)
+	}
+	if _, ok := urc.mutation.PromptTokens(); !ok {
+		v := usagerecord.DefaultPromptTokens
+		urc.mutation.SetPromptTokens(v)
+	}
+	if _, ok := urc.mutation.CompletionTokens(); !ok {
+		v := usagerecord.DefaultCompletionTokens
+		urc.mutation.SetCompletionTokens(v)
+	}
+	if _, ok := urc.mutation.TotalDuration(); !ok {
+		v := usagerecord.DefaultTotalDuration
+		urc.mutation.SetTotalDuration(v)
+	}
+	if _, ok := urc.mutation.LoadDuration(); !ok {
+		v := usagerecord.DefaultLoadDuration
+		urc.mutation.SetLoadDuration(v)
+	}
+	if _, ok := urc.mutation.PromptEvalDuration(); !ok {
+		v := usagerecord.DefaultPromptEvalDuration
+		urc.mutation.SetPromptEvalDuration(v)
+	}
+	if _, ok := urc.mutation.EvalDuration(); !ok {
+		v := usagerecord.DefaultEvalDuration
+		urc.mutation.SetEvalDuration(v)
+	}
+	if _, ok := urc.mutation.Latency(); !ok {
+		v := usagerecord.DefaultLatency
+		urc.mutation.SetLatency(v)
+	}
+	if _, ok := urc.mutation.CreatedAt(); !ok {
+		v := usagerecord.DefaultCreatedAt()
+		urc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := urc.mutation.ID(); !ok {
+		v := usagerecord.DefaultID()
+		urc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (urc *UsageRecordCreate) check() error {
+	if _, ok := urc.mutation.UserId(); !ok {
+		return &ValidationError{Name: "userId", err: errors.New(`ent: missing required field "UsageRecord.userId"`)}
+	}
+	if _, ok := urc.mutation.UserEmail(); !ok {
+		return &ValidationError{Name: "userEmail", err: errors.New(`ent: missing required field "UsageRecord.userEmail"`)}
+	}
+	if _, ok := urc.mutation.Model(); !ok {
+		return &ValidationError{Name: "model", err: errors.New(`ent: missing required field "UsageRecord.model"`)}
+	}
+	if _, ok := urc.mutation.Endpoint(); !ok {
+		return &ValidationError{Name: "endpoint", err: errors.New(`ent: missing required field "UsageRecord.endpoint"`)}
+	}
+	if _, ok := urc.mutation.PromptTokens(); !ok {
+		return &ValidationError{Name: "promptTokens", err: errors.New(`ent: missing required field "UsageRecord.promptTokens"`)}
+	}
+	if _, ok := urc.mutation.CompletionTokens(); !ok {
+		return &ValidationError{Name: "completionTokens", err: errors.New(`ent: missing required field "UsageRecord.completionTokens"`)}
+	}
+	if _, ok := urc.mutation.TotalDuration(); !ok {
+		return &ValidationError{Name: "totalDuration", err: errors.New(`ent: missing required field "UsageRecord.totalDuration"`)}
+	}
+	if _, ok := urc.mutation.LoadDuration(); !ok {
+		return &ValidationError{Name: "loadDuration", err: errors.New(`ent: missing required field "UsageRecord.loadDuration"`)}
+	}
+	if _, ok := urc.mutation.PromptEvalDuration(); !ok {
+		return &ValidationError{Name: "promptEvalDuration", err: errors.New(`ent: missing required field "UsageRecord.promptEvalDuration"`)}
+	}
+	if _, ok := urc.mutation.EvalDuration(); !ok {
+		return &ValidationError{Name: "evalDuration", err: errors.New(`ent: missing required field "UsageRecord.evalDuration"`)}
+	}
+	if _, ok := urc.mutation.Latency(); !ok {
+		return &ValidationError{Name: "latency", err: errors.New(`ent: missing required field "UsageRecord.latency"`)}
+	}
+	if _, ok := urc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "UsageRecord.status"`)}
+	}
+	if _, ok := urc.mutation.Day(); !ok {
+		return &ValidationError{Name: "day", err: errors.New(`ent: missing required field "UsageRecord.day"`)}
+	}
+	if _, ok := urc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "createdAt", err: errors.New(`ent: missing required field "UsageRecord.createdAt"`)}
+	}
+	return nil
+}
+
+func (urc *UsageRecordCreate) sqlSave(ctx context.Context) (*UsageRecord, error) {
+	if err := urc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := urc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, urc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	urc.mutation.id = &_node.ID
+	urc.mutation.done = true
+	return _node, nil
+}
+
+func (urc *UsageRecordCreate) createSpec() (*UsageRecord, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UsageRecord{config: urc.config}
+		_spec = sqlgraph.NewCreateSpec(usagerecord.Table, sqlgraph.NewFieldSpec(usagerecord.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = urc.conflict
+	if id, ok := urc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := urc.mutation.UserId(); ok {
+		_spec.SetField(usagerecord.FieldUserId, field.TypeUUID, value)
+		_node.UserId = value
+	}
+	if value, ok := urc.mutation.UserEmail(); ok {
+		_spec.SetField(usagerecord.FieldUserEmail, field.TypeString, value)
+		_node.UserEmail = value
+	}
+	if value, ok := urc.mutation.Model(); ok {
+		_spec.SetField(usagerecord.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := urc.mutation.Endpoint(); ok {
+		_spec.SetField(usagerecord.FieldEndpoint, field.TypeString, value)
+		_node.Endpoint = value
+	}
+	if value, ok := urc.mutation.PromptTokens(); ok {
+		_spec.SetField(usagerecord.FieldPromptTokens, field.TypeInt, value)
+		_node.PromptTokens = value
+	}
+	if value, ok := urc.mutation.CompletionTokens(); ok {
+		_spec.SetField(usagerecord.FieldCompletionTokens, field.TypeInt, value)
+		_node.CompletionTokens = value
+	}
+	if value, ok := urc.mutation.TotalDuration(); ok {
+		_spec.SetField(usagerecord.FieldTotalDuration, field.TypeInt64, value)
+		_node.TotalDuration = value
+	}
+	if value, ok := urc.mutation.LoadDuration(); ok {
+		_spec.SetField(usagerecord.FieldLoadDuration, field.TypeInt64, value)
+		_node.LoadDuration = value
+	}
+	if value, ok := urc.mutation.PromptEvalDuration(); ok {
+		_spec.SetField(usagerecord.FieldPromptEvalDuration, field.TypeInt64, value)
+		_node.PromptEvalDuration = value
+	}
+	if value, ok := urc.mutation.EvalDuration(); ok {
+		_spec.SetField(usagerecord.FieldEvalDuration, field.TypeInt64, value)
+		_node.EvalDuration = value
+	}
+	if value, ok := urc.mutation.Latency(); ok {
+		_spec.SetField(usagerecord.FieldLatency, field.TypeInt64, value)
+		_node.Latency = value
+	}
+	if value, ok := urc.mutation.Status(); ok {
+		_spec.SetField(usagerecord.FieldStatus, field.TypeInt, value)
+		_node.Status = value
+	}
+	if value, ok := urc.mutation.Day(); ok {
+		_spec.SetField(usagerecord.FieldDay, field.TypeString, value)
+		_node.Day = value
+	}
+	if value, ok := urc.mutation.CreatedAt(); ok {
+		_spec.SetField(usagerecord.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UsageRecord.Create().
+//		SetUserId(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UsageRecordUpsert) {
+//			SetUserId(v+v).
+//		}).
+//		Exec(ctx)
+func (urc *UsageRecordCreate) OnConflict(opts ...sql.ConflictOption) *UsageRecordUpsertOne {
+	urc.conflict = opts
+	return &UsageRecordUpsertOne{
+		create: urc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UsageRecord.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (urc *UsageRecordCreate) OnConflictColumns(columns ...string) *UsageRecordUpsertOne {
+	urc.conflict = append(urc.conflict, sql.ConflictColumns(columns...))
+	return &UsageRecordUpsertOne{
+		create: urc,
+	}
+}
+
+type (
+	// UsageRecordUpsertOne is the builder for "upsert"-ing
+	//  one UsageRecord node.
+	UsageRecordUpsertOne struct {
+		create *UsageRecordCreate
+	}
+
+	// UsageRecordUpsert is the "OnConflict" setter.
+	UsageRecordUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.UsageRecord.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(usagerecord.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UsageRecordUpsertOne) UpdateNewValues() *UsageRecordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(usagerecord.FieldID)
+		}
+		if _, exists := u.create.mutation.UserId(); exists {
+			s.SetIgnore(usagerecord.FieldUserId)
+		}
+		if _, exists := u.create.mutation.UserEmail(); exists {
+			s.SetIgnore(usagerecord.FieldUserEmail)
+		}
+		if _, exists := u.create.mutation.Model(); exists {
+			s.SetIgnore(usagerecord.FieldModel)
+		}
+		if _, exists := u.create.mutation.Endpoint(); exists {
+			s.SetIgnore(usagerecord.FieldEndpoint)
+		}
+		if _, exists := u.create.mutation.PromptTokens(); exists {
+			s.SetIgnore(usagerecord.FieldPromptTokens)
+		}
+		if _, exists := u.create.mutation.CompletionTokens(); exists {
+			s.SetIgnore(usagerecord.FieldCompletionTokens)
+		}
+		if _, exists := u.create.mutation.TotalDuration(); exists {
+			s.SetIgnore(usagerecord.FieldTotalDuration)
+		}
+		if _, exists := u.create.mutation.LoadDuration(); exists {
+			s.SetIgnore(usagerecord.FieldLoadDuration)
+		}
+		if _, exists := u.create.mutation.PromptEvalDuration(); exists {
+			s.SetIgnore(usagerecord.FieldPromptEvalDuration)
+		}
+		if _, exists := u.create.mutation.EvalDuration(); exists {
+			s.SetIgnore(usagerecord.FieldEvalDuration)
+		}
+		if _, exists := u.create.mutation.Latency(); exists {
+			s.SetIgnore(usagerecord.FieldLatency)
+		}
+		if _, exists := u.create.mutation.Status(); exists {
+			s.SetIgnore(usagerecord.FieldStatus)
+		}
+		if _, exists := u.create.mutation.Day(); exists {
+			s.SetIgnore(usagerecord.FieldDay)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(usagerecord.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UsageRecord.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UsageRecordUpsertOne) Ignore() *UsageRecordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UsageRecordUpsertOne) DoNothing() *UsageRecordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UsageRecordCreate.OnConflict
+// documentation for more info.
+func (u *UsageRecordUpsertOne) Update(set func(*UsageRecordUpsert)) *UsageRecordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UsageRecordUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *UsageRecordUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UsageRecordCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UsageRecordUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UsageRecordUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: UsageRecordUpsertOne.ID is not supported by MySQL driver. Use UsageRecordUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UsageRecordUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// UsageRecordCreateBulk is the builder for creating many UsageRecord entities in bulk.
+type UsageRecordCreateBulk struct {
+	config
+	err      error
+	builders []*UsageRecordCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the UsageRecord entities in the database.
+func (urcb *UsageRecordCreateBulk) Save(ctx context.Context) ([]*UsageRecord, error) {
+	if urcb.err != nil {
+		return nil, urcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(urcb.builders))
+	nodes := make([]*UsageRecord, len(urcb.builders))
+	mutators := make([]Mutator, len(urcb.builders))
+	for i := range urcb.builders {
+		func(i int, root context.Context) {
+			builder := urcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UsageRecordMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, urcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = urcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, urcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, urcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (urcb *UsageRecordCreateBulk) SaveX(ctx context.Context) []*UsageRecord {
+	v, err := urcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (urcb *UsageRecordCreateBulk) Exec(ctx context.Context) error {
+	_, err := urcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (urcb *UsageRecordCreateBulk) ExecX(ctx context.Context) {
+	if err := urcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UsageRecord.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UsageRecordUpsert) {
+//			SetUserId(v+v).
+//		}).
+//		Exec(ctx)
+func (urcb *UsageRecordCreateBulk) OnConflict(opts ...sql.ConflictOption) *UsageRecordUpsertBulk {
+	urcb.conflict = opts
+	return &UsageRecordUpsertBulk{
+		create: urcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UsageRecord.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (urcb *UsageRecordCreateBulk) OnConflictColumns(columns ...string) *UsageRecordUpsertBulk {
+	urcb.conflict = append(urcb.conflict, sql.ConflictColumns(columns...))
+	return &UsageRecordUpsertBulk{
+		create: urcb,
+	}
+}
+
+// UsageRecordUpsertBulk is the builder for "upsert"-ing
+// a bulk of UsageRecord nodes.
+type UsageRecordUpsertBulk struct {
+	create *UsageRecordCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UsageRecord.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(usagerecord.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UsageRecordUpsertBulk) UpdateNewValues() *UsageRecordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(usagerecord.FieldID)
+			}
+			if _, exists := b.mutation.UserId(); exists {
+				s.SetIgnore(usagerecord.FieldUserId)
+			}
+			if _, exists := b.mutation.UserEmail(); exists {
+				s.SetIgnore(usagerecord.FieldUserEmail)
+			}
+			if _, exists := b.mutation.Model(); exists {
+				s.SetIgnore(usagerecord.FieldModel)
+			}
+			if _, exists := b.mutation.Endpoint(); exists {
+				s.SetIgnore(usagerecord.FieldEndpoint)
+			}
+			if _, exists := b.mutation.PromptTokens(); exists {
+				s.SetIgnore(usagerecord.FieldPromptTokens)
+			}
+			if _, exists := b.mutation.CompletionTokens(); exists {
+				s.SetIgnore(usagerecord.FieldCompletionTokens)
+			}
+			if _, exists := b.mutation.TotalDuration(); exists {
+				s.SetIgnore(usagerecord.FieldTotalDuration)
+			}
+			if _, exists := b.mutation.LoadDuration(); exists {
+				s.SetIgnore(usagerecord.FieldLoadDuration)
+			}
+			if _, exists := b.mutation.PromptEvalDuration(); exists {
+				s.SetIgnore(usagerecord.FieldPromptEvalDuration)
+			}
+			if _, exists := b.mutation.EvalDuration(); exists {
+				s.SetIgnore(usagerecord.FieldEvalDuration)
+			}
+			if _, exists := b.mutation.Latency(); exists {
+				s.SetIgnore(usagerecord.FieldLatency)
+			}
+			if _, exists := b.mutation.Status(); exists {
+				s.SetIgnore(usagerecord.FieldStatus)
+			}
+			if _, exists := b.mutation.Day(); exists {
+				s.SetIgnore(usagerecord.FieldDay)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(usagerecord.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UsageRecord.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UsageRecordUpsertBulk) Ignore() *UsageRecordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UsageRecordUpsertBulk) DoNothing() *UsageRecordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UsageRecordCreateBulk.OnConflict
+// documentation for more info.
+func (u *UsageRecordUpsertBulk) Update(set func(*UsageRecordUpsert)) *UsageRecordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UsageRecordUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *UsageRecordUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UsageRecordCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UsageRecordCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UsageRecordUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright YEAR 1block.ai.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/predicate"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/usagerecord"
+)
+
+// UsageRecordDelete is the builder for deleting a UsageRecord entity.
+type UsageRecordDelete struct {
+	config
+	hooks    []Hook
+	mutation *UsageRecordMutation
+}
+
+// Where appends a list predicates to the UsageRecordDelete builder.
+func (urd *UsageRecordDelete) Where(ps ...predicate.UsageRecord) *UsageRecordDelete {
+	urd.mutation.Where(ps...)
+	return urd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (urd *UsageRecordDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, urd.sqlExec, urd.mutation, urd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (urd *UsageRecordDelete) ExecX(ctx context.Context) int {
+	n, err := urd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (urd *UsageRecordDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(usagerecord.Table, sqlgraph.NewFieldSpec(usagerecord.FieldID, field.TypeUUID))
+	if ps := urd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, urd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	urd.mutation.done = true
+	return affected, err
+}
+
+// UsageRecordDeleteOne is the builder for deleting a single UsageRecord entity.
+type UsageRecordDeleteOne struct {
+	urd *UsageRecordDelete
+}
+
+// Where appends a list predicates to the UsageRecordDelete builder.
+func (urdo *UsageRecordDeleteOne) Where(ps ...predicate.UsageRecord) *UsageRecordDeleteOne {
+	urdo.urd.mutation.Where(ps...)
+	return urdo
+}
+
+// Exec executes the deletion query.
+func (urdo *UsageRecordDeleteOne) Exec(ctx context.Context) error {
+	n, err := urdo.urd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{usagerecord.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (urdo *UsageRecordDeleteOne) ExecX(ctx context.Context) {
+	if err := urdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
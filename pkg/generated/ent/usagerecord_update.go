@@ -0,0 +1,189 @@
+/*
+Copyright YEAR 1block.ai.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/predicate"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/usagerecord"
+)
+
+// UsageRecordUpdate is the builder for updating UsageRecord entities.
+type UsageRecordUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UsageRecordMutation
+}
+
+// Where appends a list predicates to the UsageRecordUpdate builder.
+func (uru *UsageRecordUpdate) Where(ps ...predicate.UsageRecord) *UsageRecordUpdate {
+	uru.mutation.Where(ps...)
+	return uru
+}
+
+// Mutation returns the UsageRecordMutation object of the builder.
+func (uru *UsageRecordUpdate) Mutation() *UsageRecordMutation {
+	return uru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (uru *UsageRecordUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, uru.sqlSave, uru.mutation, uru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uru *UsageRecordUpdate) SaveX(ctx context.Context) int {
+	affected, err := uru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (uru *UsageRecordUpdate) Exec(ctx context.Context) error {
+	_, err := uru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uru *UsageRecordUpdate) ExecX(ctx context.Context) {
+	if err := uru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (uru *UsageRecordUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(usagerecord.Table, usagerecord.Columns, sqlgraph.NewFieldSpec(usagerecord.FieldID, field.TypeUUID))
+	if ps := uru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, uru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usagerecord.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uru.mutation.done = true
+	return n, nil
+}
+
+// UsageRecordUpdateOne is the builder for updating a single UsageRecord entity.
+type UsageRecordUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UsageRecordMutation
+}
+
+// Mutation returns the UsageRecordMutation object of the builder.
+func (uruo *UsageRecordUpdateOne) Mutation() *UsageRecordMutation {
+	return uruo.mutation
+}
+
+// Where appends a list predicates to the UsageRecordUpdate builder.
+func (uruo *UsageRecordUpdateOne) Where(ps ...predicate.UsageRecord) *UsageRecordUpdateOne {
+	uruo.mutation.Where(ps...)
+	return uruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (uruo *UsageRecordUpdateOne) Select(field string, fields ...string) *UsageRecordUpdateOne {
+	uruo.fields = append([]string{field}, fields...)
+	return uruo
+}
+
+// Save executes the query and returns the updated UsageRecord entity.
+func (uruo *UsageRecordUpdateOne) Save(ctx context.Context) (*UsageRecord, error) {
+	return withHooks(ctx, uruo.sqlSave, uruo.mutation, uruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uruo *UsageRecordUpdateOne) SaveX(ctx context.Context) *UsageRecord {
+	node, err := uruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (uruo *UsageRecordUpdateOne) Exec(ctx context.Context) error {
+	_, err := uruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uruo *UsageRecordUpdateOne) ExecX(ctx context.Context) {
+	if err := uruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (uruo *UsageRecordUpdateOne) sqlSave(ctx context.Context) (_node *UsageRecord, err error) {
+	_spec := sqlgraph.NewUpdateSpec(usagerecord.Table, usagerecord.Columns, sqlgraph.NewFieldSpec(usagerecord.FieldID, field.TypeUUID))
+	id, ok := uruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UsageRecord.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := uruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usagerecord.FieldID)
+		for _, f := range fields {
+			if !usagerecord.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != usagerecord.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := uruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &UsageRecord{config: uruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, uruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usagerecord.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	uruo.mutation.done = true
+	return _node, nil
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -24,6 +25,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/quota"
 	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
 )
 
@@ -42,6 +44,26 @@ type User struct {
 	Role user.Role `json:"role,omitempty"`
 	// ProfileImageUrl holds the value of the "profileImageUrl" field.
 	ProfileImageUrl string `json:"profileImageUrl,omitempty"`
+	// TotpSecret holds the value of the "totpSecret" field.
+	TotpSecret string `json:"-"`
+	// TotpEnabled holds the value of the "totpEnabled" field.
+	TotpEnabled bool `json:"totpEnabled,omitempty"`
+	// TotpLastStep holds the value of the "totpLastStep" field.
+	TotpLastStep int64 `json:"totpLastStep,omitempty"`
+	// RecoveryCodes holds the value of the "recoveryCodes" field.
+	RecoveryCodes []string `json:"-"`
+	// MfaChallenge holds the value of the "mfaChallenge" field.
+	MfaChallenge string `json:"-"`
+	// MfaChallengeAttempts holds the value of the "mfaChallengeAttempts" field.
+	MfaChallengeAttempts int `json:"mfaChallengeAttempts,omitempty"`
+	// FailedLoginCount holds the value of the "failedLoginCount" field.
+	FailedLoginCount int `json:"failedLoginCount,omitempty"`
+	// LastFailedLoginAt holds the value of the "lastFailedLoginAt" field.
+	LastFailedLoginAt *time.Time `json:"lastFailedLoginAt,omitempty"`
+	// LockedUntil holds the value of the "lockedUntil" field.
+	LockedUntil *time.Time `json:"lockedUntil,omitempty"`
+	// RejectedAt holds the value of the "rejectedAt" field.
+	RejectedAt *time.Time `json:"rejectedAt,omitempty"`
 	// CreatedAt holds the value of the "createdAt" field.
 	CreatedAt time.Time `json:"createdAt,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -56,9 +78,25 @@ type UserEdges struct {
 	Chats []*Chat `json:"chats,omitempty"`
 	// Modelfiles holds the value of the modelfiles edge.
 	Modelfiles []*Modelfile `json:"modelfiles,omitempty"`
+	// Sessions holds the value of the sessions edge.
+	Sessions []*Session `json:"sessions,omitempty"`
+	// ApiKeys holds the value of the apiKeys edge.
+	ApiKeys []*ApiKey `json:"apiKeys,omitempty"`
+	// PasswordResets holds the value of the passwordResets edge.
+	PasswordResets []*PasswordReset `json:"passwordResets,omitempty"`
+	// Invitations holds the value of the invitations edge.
+	Invitations []*Invitation `json:"invitations,omitempty"`
+	// Roles holds the value of the roles edge.
+	Roles []*Role `json:"roles,omitempty"`
+	// Groups holds the value of the groups edge.
+	Groups []*Group `json:"groups,omitempty"`
+	// Quota holds the value of the quota edge.
+	Quota *Quota `json:"quota,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [10]bool
 }
 
 // ChatsOrErr returns the Chats value or an error if the edge
@@ -79,14 +117,94 @@ func (e UserEdges) ModelfilesOrErr() ([]*Modelfile, error) {
 	return nil, &NotLoadedError{edge: "modelfiles"}
 }
 
+// SessionsOrErr returns the Sessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SessionsOrErr() ([]*Session, error) {
+	if e.loadedTypes[2] {
+		return e.Sessions, nil
+	}
+	return nil, &NotLoadedError{edge: "sessions"}
+}
+
+// ApiKeysOrErr returns the ApiKeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ApiKeysOrErr() ([]*ApiKey, error) {
+	if e.loadedTypes[3] {
+		return e.ApiKeys, nil
+	}
+	return nil, &NotLoadedError{edge: "apiKeys"}
+}
+
+// PasswordResetsOrErr returns the PasswordResets value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PasswordResetsOrErr() ([]*PasswordReset, error) {
+	if e.loadedTypes[4] {
+		return e.PasswordResets, nil
+	}
+	return nil, &NotLoadedError{edge: "passwordResets"}
+}
+
+// InvitationsOrErr returns the Invitations value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) InvitationsOrErr() ([]*Invitation, error) {
+	if e.loadedTypes[5] {
+		return e.Invitations, nil
+	}
+	return nil, &NotLoadedError{edge: "invitations"}
+}
+
+// RolesOrErr returns the Roles value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RolesOrErr() ([]*Role, error) {
+	if e.loadedTypes[6] {
+		return e.Roles, nil
+	}
+	return nil, &NotLoadedError{edge: "roles"}
+}
+
+// GroupsOrErr returns the Groups value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) GroupsOrErr() ([]*Group, error) {
+	if e.loadedTypes[7] {
+		return e.Groups, nil
+	}
+	return nil, &NotLoadedError{edge: "groups"}
+}
+
+// QuotaOrErr returns the Quota value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) QuotaOrErr() (*Quota, error) {
+	if e.Quota != nil {
+		return e.Quota, nil
+	} else if e.loadedTypes[8] {
+		return nil, &NotFoundError{label: quota.Label}
+	}
+	return nil, &NotLoadedError{edge: "quota"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[9] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldName, user.FieldEmail, user.FieldPassword, user.FieldRole, user.FieldProfileImageUrl:
+		case user.FieldRecoveryCodes:
+			values[i] = new([]byte)
+		case user.FieldTotpEnabled:
+			values[i] = new(sql.NullBool)
+		case user.FieldTotpLastStep, user.FieldMfaChallengeAttempts, user.FieldFailedLoginCount:
+			values[i] = new(sql.NullInt64)
+		case user.FieldName, user.FieldEmail, user.FieldPassword, user.FieldRole, user.FieldProfileImageUrl, user.FieldTotpSecret, user.FieldMfaChallenge:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt:
+		case user.FieldLastFailedLoginAt, user.FieldLockedUntil, user.FieldRejectedAt, user.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		case user.FieldID:
 			values[i] = new(uuid.UUID)
@@ -141,6 +259,71 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.ProfileImageUrl = value.String
 			}
+		case user.FieldTotpSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field totpSecret", values[i])
+			} else if value.Valid {
+				u.TotpSecret = value.String
+			}
+		case user.FieldTotpEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field totpEnabled", values[i])
+			} else if value.Valid {
+				u.TotpEnabled = value.Bool
+			}
+		case user.FieldTotpLastStep:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field totpLastStep", values[i])
+			} else if value.Valid {
+				u.TotpLastStep = value.Int64
+			}
+		case user.FieldRecoveryCodes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field recoveryCodes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.RecoveryCodes); err != nil {
+					return fmt.Errorf("unmarshal field recoveryCodes: %w", err)
+				}
+			}
+		case user.FieldMfaChallenge:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mfaChallenge", values[i])
+			} else if value.Valid {
+				u.MfaChallenge = value.String
+			}
+		case user.FieldMfaChallengeAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field mfaChallengeAttempts", values[i])
+			} else if value.Valid {
+				u.MfaChallengeAttempts = int(value.Int64)
+			}
+		case user.FieldFailedLoginCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failedLoginCount", values[i])
+			} else if value.Valid {
+				u.FailedLoginCount = int(value.Int64)
+			}
+		case user.FieldLastFailedLoginAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field lastFailedLoginAt", values[i])
+			} else if value.Valid {
+				u.LastFailedLoginAt = new(time.Time)
+				*u.LastFailedLoginAt = value.Time
+			}
+		case user.FieldLockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field lockedUntil", values[i])
+			} else if value.Valid {
+				u.LockedUntil = new(time.Time)
+				*u.LockedUntil = value.Time
+			}
+		case user.FieldRejectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field rejectedAt", values[i])
+			} else if value.Valid {
+				u.RejectedAt = new(time.Time)
+				*u.RejectedAt = value.Time
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field createdAt", values[i])
@@ -170,6 +353,46 @@ func (u *User) QueryModelfiles() *ModelfileQuery {
 	return NewUserClient(u.config).QueryModelfiles(u)
 }
 
+// QuerySessions queries the "sessions" edge of the User entity.
+func (u *User) QuerySessions() *SessionQuery {
+	return NewUserClient(u.config).QuerySessions(u)
+}
+
+// QueryApiKeys queries the "apiKeys" edge of the User entity.
+func (u *User) QueryApiKeys() *ApiKeyQuery {
+	return NewUserClient(u.config).QueryApiKeys(u)
+}
+
+// QueryPasswordResets queries the "passwordResets" edge of the User entity.
+func (u *User) QueryPasswordResets() *PasswordResetQuery {
+	return NewUserClient(u.config).QueryPasswordResets(u)
+}
+
+// QueryInvitations queries the "invitations" edge of the User entity.
+func (u *User) QueryInvitations() *InvitationQuery {
+	return NewUserClient(u.config).QueryInvitations(u)
+}
+
+// QueryRoles queries the "roles" edge of the User entity.
+func (u *User) QueryRoles() *RoleQuery {
+	return NewUserClient(u.config).QueryRoles(u)
+}
+
+// QueryGroups queries the "groups" edge of the User entity.
+func (u *User) QueryGroups() *GroupQuery {
+	return NewUserClient(u.config).QueryGroups(u)
+}
+
+// QueryQuota queries the "quota" edge of the User entity.
+func (u *User) QueryQuota() *QuotaQuery {
+	return NewUserClient(u.config).QueryQuota(u)
+}
+
+// QueryTags queries the "tags" edge of the User entity.
+func (u *User) QueryTags() *TagQuery {
+	return NewUserClient(u.config).QueryTags(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -207,6 +430,39 @@ func (u *User) String() string {
 	builder.WriteString("profileImageUrl=")
 	builder.WriteString(u.ProfileImageUrl)
 	builder.WriteString(", ")
+	builder.WriteString("totpSecret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("totpEnabled=")
+	builder.WriteString(fmt.Sprintf("%v", u.TotpEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("totpLastStep=")
+	builder.WriteString(fmt.Sprintf("%v", u.TotpLastStep))
+	builder.WriteString(", ")
+	builder.WriteString("recoveryCodes=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("mfaChallenge=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("mfaChallengeAttempts=")
+	builder.WriteString(fmt.Sprintf("%v", u.MfaChallengeAttempts))
+	builder.WriteString(", ")
+	builder.WriteString("failedLoginCount=")
+	builder.WriteString(fmt.Sprintf("%v", u.FailedLoginCount))
+	builder.WriteString(", ")
+	if v := u.LastFailedLoginAt; v != nil {
+		builder.WriteString("lastFailedLoginAt=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := u.LockedUntil; v != nil {
+		builder.WriteString("lockedUntil=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := u.RejectedAt; v != nil {
+		builder.WriteString("rejectedAt=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("createdAt=")
 	builder.WriteString(u.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -27,8 +27,16 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/apikey"
 	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/chat"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/group"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/invitation"
 	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/modelfile"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/passwordreset"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/quota"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/role"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/session"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/tag"
 	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
 )
 
@@ -86,6 +94,138 @@ func (uc *UserCreate) SetNillableProfileImageUrl(s *string) *UserCreate {
 	return uc
 }
 
+// SetTotpSecret sets the "totpSecret" field.
+func (uc *UserCreate) SetTotpSecret(s string) *UserCreate {
+	uc.mutation.SetTotpSecret(s)
+	return uc
+}
+
+// SetNillableTotpSecret sets the "totpSecret" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTotpSecret(s *string) *UserCreate {
+	if s != nil {
+		uc.SetTotpSecret(*s)
+	}
+	return uc
+}
+
+// SetTotpEnabled sets the "totpEnabled" field.
+func (uc *UserCreate) SetTotpEnabled(b bool) *UserCreate {
+	uc.mutation.SetTotpEnabled(b)
+	return uc
+}
+
+// SetNillableTotpEnabled sets the "totpEnabled" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTotpEnabled(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetTotpEnabled(*b)
+	}
+	return uc
+}
+
+// SetTotpLastStep sets the "totpLastStep" field.
+func (uc *UserCreate) SetTotpLastStep(i int64) *UserCreate {
+	uc.mutation.SetTotpLastStep(i)
+	return uc
+}
+
+// SetNillableTotpLastStep sets the "totpLastStep" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTotpLastStep(i *int64) *UserCreate {
+	if i != nil {
+		uc.SetTotpLastStep(*i)
+	}
+	return uc
+}
+
+// SetRecoveryCodes sets the "recoveryCodes" field.
+func (uc *UserCreate) SetRecoveryCodes(s []string) *UserCreate {
+	uc.mutation.SetRecoveryCodes(s)
+	return uc
+}
+
+// SetMfaChallenge sets the "mfaChallenge" field.
+func (uc *UserCreate) SetMfaChallenge(s string) *UserCreate {
+	uc.mutation.SetMfaChallenge(s)
+	return uc
+}
+
+// SetNillableMfaChallenge sets the "mfaChallenge" field if the given value is not nil.
+func (uc *UserCreate) SetNillableMfaChallenge(s *string) *UserCreate {
+	if s != nil {
+		uc.SetMfaChallenge(*s)
+	}
+	return uc
+}
+
+// SetMfaChallengeAttempts sets the "mfaChallengeAttempts" field.
+func (uc *UserCreate) SetMfaChallengeAttempts(i int) *UserCreate {
+	uc.mutation.SetMfaChallengeAttempts(i)
+	return uc
+}
+
+// SetNillableMfaChallengeAttempts sets the "mfaChallengeAttempts" field if the given value is not nil.
+func (uc *UserCreate) SetNillableMfaChallengeAttempts(i *int) *UserCreate {
+	if i != nil {
+		uc.SetMfaChallengeAttempts(*i)
+	}
+	return uc
+}
+
+// SetFailedLoginCount sets the "failedLoginCount" field.
+func (uc *UserCreate) SetFailedLoginCount(i int) *UserCreate {
+	uc.mutation.SetFailedLoginCount(i)
+	return uc
+}
+
+// SetNillableFailedLoginCount sets the "failedLoginCount" field if the given value is not nil.
+func (uc *UserCreate) SetNillableFailedLoginCount(i *int) *UserCreate {
+	if i != nil {
+		uc.SetFailedLoginCount(*i)
+	}
+	return uc
+}
+
+// SetLastFailedLoginAt sets the "lastFailedLoginAt" field.
+func (uc *UserCreate) SetLastFailedLoginAt(t time.Time) *UserCreate {
+	uc.mutation.SetLastFailedLoginAt(t)
+	return uc
+}
+
+// SetNillableLastFailedLoginAt sets the "lastFailedLoginAt" field if the given value is not nil.
+func (uc *UserCreate) SetNillableLastFailedLoginAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetLastFailedLoginAt(*t)
+	}
+	return uc
+}
+
+// SetLockedUntil sets the "lockedUntil" field.
+func (uc *UserCreate) SetLockedUntil(t time.Time) *UserCreate {
+	uc.mutation.SetLockedUntil(t)
+	return uc
+}
+
+// SetNillableLockedUntil sets the "lockedUntil" field if the given value is not nil.
+func (uc *UserCreate) SetNillableLockedUntil(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetLockedUntil(*t)
+	}
+	return uc
+}
+
+// SetRejectedAt sets the "rejectedAt" field.
+func (uc *UserCreate) SetRejectedAt(t time.Time) *UserCreate {
+	uc.mutation.SetRejectedAt(t)
+	return uc
+}
+
+// SetNillableRejectedAt sets the "rejectedAt" field if the given value is not nil.
+func (uc *UserCreate) SetNillableRejectedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetRejectedAt(*t)
+	}
+	return uc
+}
+
 // SetCreatedAt sets the "createdAt" field.
 func (uc *UserCreate) SetCreatedAt(t time.Time) *UserCreate {
 	uc.mutation.SetCreatedAt(t)
@@ -144,6 +284,130 @@ func (uc *UserCreate) AddModelfiles(m ...*Modelfile) *UserCreate {
 	return uc.AddModelfileIDs(ids...)
 }
 
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (uc *UserCreate) AddSessionIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddSessionIDs(ids...)
+	return uc
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (uc *UserCreate) AddSessions(s ...*Session) *UserCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uc.AddSessionIDs(ids...)
+}
+
+// AddApiKeyIDs adds the "apiKeys" edge to the ApiKey entity by IDs.
+func (uc *UserCreate) AddApiKeyIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddApiKeyIDs(ids...)
+	return uc
+}
+
+// AddApiKeys adds the "apiKeys" edges to the ApiKey entity.
+func (uc *UserCreate) AddApiKeys(a ...*ApiKey) *UserCreate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uc.AddApiKeyIDs(ids...)
+}
+
+// AddPasswordResetIDs adds the "passwordResets" edge to the PasswordReset entity by IDs.
+func (uc *UserCreate) AddPasswordResetIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddPasswordResetIDs(ids...)
+	return uc
+}
+
+// AddPasswordResets adds the "passwordResets" edges to the PasswordReset entity.
+func (uc *UserCreate) AddPasswordResets(p ...*PasswordReset) *UserCreate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uc.AddPasswordResetIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the Invitation entity by IDs.
+func (uc *UserCreate) AddInvitationIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddInvitationIDs(ids...)
+	return uc
+}
+
+// AddInvitations adds the "invitations" edges to the Invitation entity.
+func (uc *UserCreate) AddInvitations(i ...*Invitation) *UserCreate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uc.AddInvitationIDs(ids...)
+}
+
+// AddRoleIDs adds the "roles" edge to the Role entity by IDs.
+func (uc *UserCreate) AddRoleIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddRoleIDs(ids...)
+	return uc
+}
+
+// AddRoles adds the "roles" edges to the Role entity.
+func (uc *UserCreate) AddRoles(r ...*Role) *UserCreate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uc.AddRoleIDs(ids...)
+}
+
+// AddGroupIDs adds the "groups" edge to the Group entity by IDs.
+func (uc *UserCreate) AddGroupIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddGroupIDs(ids...)
+	return uc
+}
+
+// AddGroups adds the "groups" edges to the Group entity.
+func (uc *UserCreate) AddGroups(g ...*Group) *UserCreate {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uc.AddGroupIDs(ids...)
+}
+
+// SetQuotaID sets the "quota" edge to the Quota entity by ID.
+func (uc *UserCreate) SetQuotaID(id uuid.UUID) *UserCreate {
+	uc.mutation.SetQuotaID(id)
+	return uc
+}
+
+// SetNillableQuotaID sets the "quota" edge to the Quota entity by ID if the given value is not nil.
+func (uc *UserCreate) SetNillableQuotaID(id *uuid.UUID) *UserCreate {
+	if id != nil {
+		uc = uc.SetQuotaID(*id)
+	}
+	return uc
+}
+
+// SetQuota sets the "quota" edge to the Quota entity.
+func (uc *UserCreate) SetQuota(q *Quota) *UserCreate {
+	return uc.SetQuotaID(q.ID)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (uc *UserCreate) AddTagIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddTagIDs(ids...)
+	return uc
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (uc *UserCreate) AddTags(t ...*Tag) *UserCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uc.AddTagIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -187,8 +451,20 @@ func (uc *UserCreate) defaults() {
 		v := user.DefaultProfileImageUrl
 		uc.mutation.SetProfileImageUrl(v)
 	}
+	if _, ok := uc.mutation.TotpEnabled(); !ok {
+		v := user.DefaultTotpEnabled
+		uc.mutation.SetTotpEnabled(v)
+	}
+	if _, ok := uc.mutation.MfaChallengeAttempts(); !ok {
+		v := user.DefaultMfaChallengeAttempts
+		uc.mutation.SetMfaChallengeAttempts(v)
+	}
+	if _, ok := uc.mutation.FailedLoginCount(); !ok {
+		v := user.DefaultFailedLoginCount
+		uc.mutation.SetFailedLoginCount(v)
+	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
-		v := user.DefaultCreatedAt
+		v := user.DefaultCreatedAt()
 		uc.mutation.SetCreatedAt(v)
 	}
 	if _, ok := uc.mutation.ID(); !ok {
@@ -234,6 +510,15 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.ProfileImageUrl(); !ok {
 		return &ValidationError{Name: "profileImageUrl", err: errors.New(`ent: missing required field "User.profileImageUrl"`)}
 	}
+	if _, ok := uc.mutation.TotpEnabled(); !ok {
+		return &ValidationError{Name: "totpEnabled", err: errors.New(`ent: missing required field "User.totpEnabled"`)}
+	}
+	if _, ok := uc.mutation.MfaChallengeAttempts(); !ok {
+		return &ValidationError{Name: "mfaChallengeAttempts", err: errors.New(`ent: missing required field "User.mfaChallengeAttempts"`)}
+	}
+	if _, ok := uc.mutation.FailedLoginCount(); !ok {
+		return &ValidationError{Name: "failedLoginCount", err: errors.New(`ent: missing required field "User.failedLoginCount"`)}
+	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "createdAt", err: errors.New(`ent: missing required field "User.createdAt"`)}
 	}
@@ -293,6 +578,46 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldProfileImageUrl, field.TypeString, value)
 		_node.ProfileImageUrl = value
 	}
+	if value, ok := uc.mutation.TotpSecret(); ok {
+		_spec.SetField(user.FieldTotpSecret, field.TypeString, value)
+		_node.TotpSecret = value
+	}
+	if value, ok := uc.mutation.TotpEnabled(); ok {
+		_spec.SetField(user.FieldTotpEnabled, field.TypeBool, value)
+		_node.TotpEnabled = value
+	}
+	if value, ok := uc.mutation.TotpLastStep(); ok {
+		_spec.SetField(user.FieldTotpLastStep, field.TypeInt64, value)
+		_node.TotpLastStep = value
+	}
+	if value, ok := uc.mutation.RecoveryCodes(); ok {
+		_spec.SetField(user.FieldRecoveryCodes, field.TypeJSON, value)
+		_node.RecoveryCodes = value
+	}
+	if value, ok := uc.mutation.MfaChallenge(); ok {
+		_spec.SetField(user.FieldMfaChallenge, field.TypeString, value)
+		_node.MfaChallenge = value
+	}
+	if value, ok := uc.mutation.MfaChallengeAttempts(); ok {
+		_spec.SetField(user.FieldMfaChallengeAttempts, field.TypeInt, value)
+		_node.MfaChallengeAttempts = value
+	}
+	if value, ok := uc.mutation.FailedLoginCount(); ok {
+		_spec.SetField(user.FieldFailedLoginCount, field.TypeInt, value)
+		_node.FailedLoginCount = value
+	}
+	if value, ok := uc.mutation.LastFailedLoginAt(); ok {
+		_spec.SetField(user.FieldLastFailedLoginAt, field.TypeTime, value)
+		_node.LastFailedLoginAt = &value
+	}
+	if value, ok := uc.mutation.LockedUntil(); ok {
+		_spec.SetField(user.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = &value
+	}
+	if value, ok := uc.mutation.RejectedAt(); ok {
+		_spec.SetField(user.FieldRejectedAt, field.TypeTime, value)
+		_node.RejectedAt = &value
+	}
 	if value, ok := uc.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -329,6 +654,134 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ApiKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ApiKeysTable,
+			Columns: []string{user.ApiKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.PasswordResetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasswordResetsTable,
+			Columns: []string{user.PasswordResetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(passwordreset.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvitationsTable,
+			Columns: []string{user.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.RolesTable,
+			Columns: user.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.GroupsTable,
+			Columns: user.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.QuotaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.QuotaTable,
+			Columns: []string{user.QuotaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quota.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TagsTable,
+			Columns: []string{user.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -411,33 +864,213 @@ func (u *UserUpsert) SetPassword(v string) *UserUpsert {
 	return u
 }
 
-// UpdatePassword sets the "password" field to the value that was provided on create.
-func (u *UserUpsert) UpdatePassword() *UserUpsert {
-	u.SetExcluded(user.FieldPassword)
+// UpdatePassword sets the "password" field to the value that was provided on create.
+func (u *UserUpsert) UpdatePassword() *UserUpsert {
+	u.SetExcluded(user.FieldPassword)
+	return u
+}
+
+// SetRole sets the "role" field.
+func (u *UserUpsert) SetRole(v user.Role) *UserUpsert {
+	u.Set(user.FieldRole, v)
+	return u
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *UserUpsert) UpdateRole() *UserUpsert {
+	u.SetExcluded(user.FieldRole)
+	return u
+}
+
+// SetProfileImageUrl sets the "profileImageUrl" field.
+func (u *UserUpsert) SetProfileImageUrl(v string) *UserUpsert {
+	u.Set(user.FieldProfileImageUrl, v)
+	return u
+}
+
+// UpdateProfileImageUrl sets the "profileImageUrl" field to the value that was provided on create.
+func (u *UserUpsert) UpdateProfileImageUrl() *UserUpsert {
+	u.SetExcluded(user.FieldProfileImageUrl)
+	return u
+}
+
+// SetTotpSecret sets the "totpSecret" field.
+func (u *UserUpsert) SetTotpSecret(v string) *UserUpsert {
+	u.Set(user.FieldTotpSecret, v)
+	return u
+}
+
+// UpdateTotpSecret sets the "totpSecret" field to the value that was provided on create.
+func (u *UserUpsert) UpdateTotpSecret() *UserUpsert {
+	u.SetExcluded(user.FieldTotpSecret)
+	return u
+}
+
+// ClearTotpSecret clears the value of the "totpSecret" field.
+func (u *UserUpsert) ClearTotpSecret() *UserUpsert {
+	u.SetNull(user.FieldTotpSecret)
+	return u
+}
+
+// SetTotpEnabled sets the "totpEnabled" field.
+func (u *UserUpsert) SetTotpEnabled(v bool) *UserUpsert {
+	u.Set(user.FieldTotpEnabled, v)
+	return u
+}
+
+// UpdateTotpEnabled sets the "totpEnabled" field to the value that was provided on create.
+func (u *UserUpsert) UpdateTotpEnabled() *UserUpsert {
+	u.SetExcluded(user.FieldTotpEnabled)
+	return u
+}
+
+// SetTotpLastStep sets the "totpLastStep" field.
+func (u *UserUpsert) SetTotpLastStep(v int64) *UserUpsert {
+	u.Set(user.FieldTotpLastStep, v)
+	return u
+}
+
+// UpdateTotpLastStep sets the "totpLastStep" field to the value that was provided on create.
+func (u *UserUpsert) UpdateTotpLastStep() *UserUpsert {
+	u.SetExcluded(user.FieldTotpLastStep)
+	return u
+}
+
+// AddTotpLastStep adds v to the "totpLastStep" field.
+func (u *UserUpsert) AddTotpLastStep(v int64) *UserUpsert {
+	u.Add(user.FieldTotpLastStep, v)
+	return u
+}
+
+// ClearTotpLastStep clears the value of the "totpLastStep" field.
+func (u *UserUpsert) ClearTotpLastStep() *UserUpsert {
+	u.SetNull(user.FieldTotpLastStep)
+	return u
+}
+
+// SetRecoveryCodes sets the "recoveryCodes" field.
+func (u *UserUpsert) SetRecoveryCodes(v []string) *UserUpsert {
+	u.Set(user.FieldRecoveryCodes, v)
+	return u
+}
+
+// UpdateRecoveryCodes sets the "recoveryCodes" field to the value that was provided on create.
+func (u *UserUpsert) UpdateRecoveryCodes() *UserUpsert {
+	u.SetExcluded(user.FieldRecoveryCodes)
+	return u
+}
+
+// ClearRecoveryCodes clears the value of the "recoveryCodes" field.
+func (u *UserUpsert) ClearRecoveryCodes() *UserUpsert {
+	u.SetNull(user.FieldRecoveryCodes)
+	return u
+}
+
+// SetMfaChallenge sets the "mfaChallenge" field.
+func (u *UserUpsert) SetMfaChallenge(v string) *UserUpsert {
+	u.Set(user.FieldMfaChallenge, v)
+	return u
+}
+
+// UpdateMfaChallenge sets the "mfaChallenge" field to the value that was provided on create.
+func (u *UserUpsert) UpdateMfaChallenge() *UserUpsert {
+	u.SetExcluded(user.FieldMfaChallenge)
+	return u
+}
+
+// ClearMfaChallenge clears the value of the "mfaChallenge" field.
+func (u *UserUpsert) ClearMfaChallenge() *UserUpsert {
+	u.SetNull(user.FieldMfaChallenge)
+	return u
+}
+
+// SetMfaChallengeAttempts sets the "mfaChallengeAttempts" field.
+func (u *UserUpsert) SetMfaChallengeAttempts(v int) *UserUpsert {
+	u.Set(user.FieldMfaChallengeAttempts, v)
+	return u
+}
+
+// UpdateMfaChallengeAttempts sets the "mfaChallengeAttempts" field to the value that was provided on create.
+func (u *UserUpsert) UpdateMfaChallengeAttempts() *UserUpsert {
+	u.SetExcluded(user.FieldMfaChallengeAttempts)
+	return u
+}
+
+// AddMfaChallengeAttempts adds v to the "mfaChallengeAttempts" field.
+func (u *UserUpsert) AddMfaChallengeAttempts(v int) *UserUpsert {
+	u.Add(user.FieldMfaChallengeAttempts, v)
+	return u
+}
+
+// SetFailedLoginCount sets the "failedLoginCount" field.
+func (u *UserUpsert) SetFailedLoginCount(v int) *UserUpsert {
+	u.Set(user.FieldFailedLoginCount, v)
+	return u
+}
+
+// UpdateFailedLoginCount sets the "failedLoginCount" field to the value that was provided on create.
+func (u *UserUpsert) UpdateFailedLoginCount() *UserUpsert {
+	u.SetExcluded(user.FieldFailedLoginCount)
+	return u
+}
+
+// AddFailedLoginCount adds v to the "failedLoginCount" field.
+func (u *UserUpsert) AddFailedLoginCount(v int) *UserUpsert {
+	u.Add(user.FieldFailedLoginCount, v)
+	return u
+}
+
+// SetLastFailedLoginAt sets the "lastFailedLoginAt" field.
+func (u *UserUpsert) SetLastFailedLoginAt(v time.Time) *UserUpsert {
+	u.Set(user.FieldLastFailedLoginAt, v)
+	return u
+}
+
+// UpdateLastFailedLoginAt sets the "lastFailedLoginAt" field to the value that was provided on create.
+func (u *UserUpsert) UpdateLastFailedLoginAt() *UserUpsert {
+	u.SetExcluded(user.FieldLastFailedLoginAt)
+	return u
+}
+
+// ClearLastFailedLoginAt clears the value of the "lastFailedLoginAt" field.
+func (u *UserUpsert) ClearLastFailedLoginAt() *UserUpsert {
+	u.SetNull(user.FieldLastFailedLoginAt)
+	return u
+}
+
+// SetLockedUntil sets the "lockedUntil" field.
+func (u *UserUpsert) SetLockedUntil(v time.Time) *UserUpsert {
+	u.Set(user.FieldLockedUntil, v)
+	return u
+}
+
+// UpdateLockedUntil sets the "lockedUntil" field to the value that was provided on create.
+func (u *UserUpsert) UpdateLockedUntil() *UserUpsert {
+	u.SetExcluded(user.FieldLockedUntil)
 	return u
 }
 
-// SetRole sets the "role" field.
-func (u *UserUpsert) SetRole(v user.Role) *UserUpsert {
-	u.Set(user.FieldRole, v)
+// ClearLockedUntil clears the value of the "lockedUntil" field.
+func (u *UserUpsert) ClearLockedUntil() *UserUpsert {
+	u.SetNull(user.FieldLockedUntil)
 	return u
 }
 
-// UpdateRole sets the "role" field to the value that was provided on create.
-func (u *UserUpsert) UpdateRole() *UserUpsert {
-	u.SetExcluded(user.FieldRole)
+// SetRejectedAt sets the "rejectedAt" field.
+func (u *UserUpsert) SetRejectedAt(v time.Time) *UserUpsert {
+	u.Set(user.FieldRejectedAt, v)
 	return u
 }
 
-// SetProfileImageUrl sets the "profileImageUrl" field.
-func (u *UserUpsert) SetProfileImageUrl(v string) *UserUpsert {
-	u.Set(user.FieldProfileImageUrl, v)
+// UpdateRejectedAt sets the "rejectedAt" field to the value that was provided on create.
+func (u *UserUpsert) UpdateRejectedAt() *UserUpsert {
+	u.SetExcluded(user.FieldRejectedAt)
 	return u
 }
 
-// UpdateProfileImageUrl sets the "profileImageUrl" field to the value that was provided on create.
-func (u *UserUpsert) UpdateProfileImageUrl() *UserUpsert {
-	u.SetExcluded(user.FieldProfileImageUrl)
+// ClearRejectedAt clears the value of the "rejectedAt" field.
+func (u *UserUpsert) ClearRejectedAt() *UserUpsert {
+	u.SetNull(user.FieldRejectedAt)
 	return u
 }
 
@@ -562,6 +1195,216 @@ func (u *UserUpsertOne) UpdateProfileImageUrl() *UserUpsertOne {
 	})
 }
 
+// SetTotpSecret sets the "totpSecret" field.
+func (u *UserUpsertOne) SetTotpSecret(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetTotpSecret(v)
+	})
+}
+
+// UpdateTotpSecret sets the "totpSecret" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateTotpSecret() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateTotpSecret()
+	})
+}
+
+// ClearTotpSecret clears the value of the "totpSecret" field.
+func (u *UserUpsertOne) ClearTotpSecret() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearTotpSecret()
+	})
+}
+
+// SetTotpEnabled sets the "totpEnabled" field.
+func (u *UserUpsertOne) SetTotpEnabled(v bool) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetTotpEnabled(v)
+	})
+}
+
+// UpdateTotpEnabled sets the "totpEnabled" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateTotpEnabled() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateTotpEnabled()
+	})
+}
+
+// SetTotpLastStep sets the "totpLastStep" field.
+func (u *UserUpsertOne) SetTotpLastStep(v int64) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetTotpLastStep(v)
+	})
+}
+
+// AddTotpLastStep adds v to the "totpLastStep" field.
+func (u *UserUpsertOne) AddTotpLastStep(v int64) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddTotpLastStep(v)
+	})
+}
+
+// UpdateTotpLastStep sets the "totpLastStep" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateTotpLastStep() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateTotpLastStep()
+	})
+}
+
+// ClearTotpLastStep clears the value of the "totpLastStep" field.
+func (u *UserUpsertOne) ClearTotpLastStep() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearTotpLastStep()
+	})
+}
+
+// SetRecoveryCodes sets the "recoveryCodes" field.
+func (u *UserUpsertOne) SetRecoveryCodes(v []string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetRecoveryCodes(v)
+	})
+}
+
+// UpdateRecoveryCodes sets the "recoveryCodes" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateRecoveryCodes() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateRecoveryCodes()
+	})
+}
+
+// ClearRecoveryCodes clears the value of the "recoveryCodes" field.
+func (u *UserUpsertOne) ClearRecoveryCodes() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearRecoveryCodes()
+	})
+}
+
+// SetMfaChallenge sets the "mfaChallenge" field.
+func (u *UserUpsertOne) SetMfaChallenge(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetMfaChallenge(v)
+	})
+}
+
+// UpdateMfaChallenge sets the "mfaChallenge" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateMfaChallenge() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateMfaChallenge()
+	})
+}
+
+// ClearMfaChallenge clears the value of the "mfaChallenge" field.
+func (u *UserUpsertOne) ClearMfaChallenge() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearMfaChallenge()
+	})
+}
+
+// SetMfaChallengeAttempts sets the "mfaChallengeAttempts" field.
+func (u *UserUpsertOne) SetMfaChallengeAttempts(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetMfaChallengeAttempts(v)
+	})
+}
+
+// AddMfaChallengeAttempts adds v to the "mfaChallengeAttempts" field.
+func (u *UserUpsertOne) AddMfaChallengeAttempts(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddMfaChallengeAttempts(v)
+	})
+}
+
+// UpdateMfaChallengeAttempts sets the "mfaChallengeAttempts" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateMfaChallengeAttempts() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateMfaChallengeAttempts()
+	})
+}
+
+// SetFailedLoginCount sets the "failedLoginCount" field.
+func (u *UserUpsertOne) SetFailedLoginCount(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetFailedLoginCount(v)
+	})
+}
+
+// AddFailedLoginCount adds v to the "failedLoginCount" field.
+func (u *UserUpsertOne) AddFailedLoginCount(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddFailedLoginCount(v)
+	})
+}
+
+// UpdateFailedLoginCount sets the "failedLoginCount" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateFailedLoginCount() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateFailedLoginCount()
+	})
+}
+
+// SetLastFailedLoginAt sets the "lastFailedLoginAt" field.
+func (u *UserUpsertOne) SetLastFailedLoginAt(v time.Time) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetLastFailedLoginAt(v)
+	})
+}
+
+// UpdateLastFailedLoginAt sets the "lastFailedLoginAt" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateLastFailedLoginAt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateLastFailedLoginAt()
+	})
+}
+
+// ClearLastFailedLoginAt clears the value of the "lastFailedLoginAt" field.
+func (u *UserUpsertOne) ClearLastFailedLoginAt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearLastFailedLoginAt()
+	})
+}
+
+// SetLockedUntil sets the "lockedUntil" field.
+func (u *UserUpsertOne) SetLockedUntil(v time.Time) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetLockedUntil(v)
+	})
+}
+
+// UpdateLockedUntil sets the "lockedUntil" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateLockedUntil() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateLockedUntil()
+	})
+}
+
+// ClearLockedUntil clears the value of the "lockedUntil" field.
+func (u *UserUpsertOne) ClearLockedUntil() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearLockedUntil()
+	})
+}
+
+// SetRejectedAt sets the "rejectedAt" field.
+func (u *UserUpsertOne) SetRejectedAt(v time.Time) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetRejectedAt(v)
+	})
+}
+
+// UpdateRejectedAt sets the "rejectedAt" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateRejectedAt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateRejectedAt()
+	})
+}
+
+// ClearRejectedAt clears the value of the "rejectedAt" field.
+func (u *UserUpsertOne) ClearRejectedAt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearRejectedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *UserUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -850,6 +1693,216 @@ func (u *UserUpsertBulk) UpdateProfileImageUrl() *UserUpsertBulk {
 	})
 }
 
+// SetTotpSecret sets the "totpSecret" field.
+func (u *UserUpsertBulk) SetTotpSecret(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetTotpSecret(v)
+	})
+}
+
+// UpdateTotpSecret sets the "totpSecret" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateTotpSecret() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateTotpSecret()
+	})
+}
+
+// ClearTotpSecret clears the value of the "totpSecret" field.
+func (u *UserUpsertBulk) ClearTotpSecret() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearTotpSecret()
+	})
+}
+
+// SetTotpEnabled sets the "totpEnabled" field.
+func (u *UserUpsertBulk) SetTotpEnabled(v bool) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetTotpEnabled(v)
+	})
+}
+
+// UpdateTotpEnabled sets the "totpEnabled" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateTotpEnabled() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateTotpEnabled()
+	})
+}
+
+// SetTotpLastStep sets the "totpLastStep" field.
+func (u *UserUpsertBulk) SetTotpLastStep(v int64) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetTotpLastStep(v)
+	})
+}
+
+// AddTotpLastStep adds v to the "totpLastStep" field.
+func (u *UserUpsertBulk) AddTotpLastStep(v int64) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddTotpLastStep(v)
+	})
+}
+
+// UpdateTotpLastStep sets the "totpLastStep" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateTotpLastStep() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateTotpLastStep()
+	})
+}
+
+// ClearTotpLastStep clears the value of the "totpLastStep" field.
+func (u *UserUpsertBulk) ClearTotpLastStep() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearTotpLastStep()
+	})
+}
+
+// SetRecoveryCodes sets the "recoveryCodes" field.
+func (u *UserUpsertBulk) SetRecoveryCodes(v []string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetRecoveryCodes(v)
+	})
+}
+
+// UpdateRecoveryCodes sets the "recoveryCodes" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateRecoveryCodes() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateRecoveryCodes()
+	})
+}
+
+// ClearRecoveryCodes clears the value of the "recoveryCodes" field.
+func (u *UserUpsertBulk) ClearRecoveryCodes() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearRecoveryCodes()
+	})
+}
+
+// SetMfaChallenge sets the "mfaChallenge" field.
+func (u *UserUpsertBulk) SetMfaChallenge(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetMfaChallenge(v)
+	})
+}
+
+// UpdateMfaChallenge sets the "mfaChallenge" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateMfaChallenge() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateMfaChallenge()
+	})
+}
+
+// ClearMfaChallenge clears the value of the "mfaChallenge" field.
+func (u *UserUpsertBulk) ClearMfaChallenge() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearMfaChallenge()
+	})
+}
+
+// SetMfaChallengeAttempts sets the "mfaChallengeAttempts" field.
+func (u *UserUpsertBulk) SetMfaChallengeAttempts(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetMfaChallengeAttempts(v)
+	})
+}
+
+// AddMfaChallengeAttempts adds v to the "mfaChallengeAttempts" field.
+func (u *UserUpsertBulk) AddMfaChallengeAttempts(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddMfaChallengeAttempts(v)
+	})
+}
+
+// UpdateMfaChallengeAttempts sets the "mfaChallengeAttempts" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateMfaChallengeAttempts() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateMfaChallengeAttempts()
+	})
+}
+
+// SetFailedLoginCount sets the "failedLoginCount" field.
+func (u *UserUpsertBulk) SetFailedLoginCount(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetFailedLoginCount(v)
+	})
+}
+
+// AddFailedLoginCount adds v to the "failedLoginCount" field.
+func (u *UserUpsertBulk) AddFailedLoginCount(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddFailedLoginCount(v)
+	})
+}
+
+// UpdateFailedLoginCount sets the "failedLoginCount" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateFailedLoginCount() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateFailedLoginCount()
+	})
+}
+
+// SetLastFailedLoginAt sets the "lastFailedLoginAt" field.
+func (u *UserUpsertBulk) SetLastFailedLoginAt(v time.Time) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetLastFailedLoginAt(v)
+	})
+}
+
+// UpdateLastFailedLoginAt sets the "lastFailedLoginAt" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateLastFailedLoginAt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateLastFailedLoginAt()
+	})
+}
+
+// ClearLastFailedLoginAt clears the value of the "lastFailedLoginAt" field.
+func (u *UserUpsertBulk) ClearLastFailedLoginAt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearLastFailedLoginAt()
+	})
+}
+
+// SetLockedUntil sets the "lockedUntil" field.
+func (u *UserUpsertBulk) SetLockedUntil(v time.Time) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetLockedUntil(v)
+	})
+}
+
+// UpdateLockedUntil sets the "lockedUntil" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateLockedUntil() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateLockedUntil()
+	})
+}
+
+// ClearLockedUntil clears the value of the "lockedUntil" field.
+func (u *UserUpsertBulk) ClearLockedUntil() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearLockedUntil()
+	})
+}
+
+// SetRejectedAt sets the "rejectedAt" field.
+func (u *UserUpsertBulk) SetRejectedAt(v time.Time) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetRejectedAt(v)
+	})
+}
+
+// UpdateRejectedAt sets the "rejectedAt" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateRejectedAt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateRejectedAt()
+	})
+}
+
+// ClearRejectedAt clears the value of the "rejectedAt" field.
+func (u *UserUpsertBulk) ClearRejectedAt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearRejectedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *UserUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
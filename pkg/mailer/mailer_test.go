@@ -0,0 +1,57 @@
+package mailer_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/llmos-ai/llmos-dashboard/pkg/mailer"
+	"github.com/llmos-ai/llmos-dashboard/pkg/mailer/mailertest"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+)
+
+func TestSend(t *testing.T) {
+	srv := mailertest.NewServer(t)
+	srv.Configure(t)
+
+	if !mailer.Enabled() {
+		t.Fatal("mailer is not enabled")
+	}
+	if err := mailer.Send("alice@example.com", "Hello\r\nBcc: mallory@example.com", "line one\n.line two\n"); err != nil {
+		t.Fatalf("failed to send: %v", err)
+	}
+
+	var msg mailertest.Message
+	select {
+	case msg = <-srv.Messages:
+	case <-time.After(5 * time.Second):
+		t.Fatal("no message received")
+	}
+	if msg.From != "dashboard@example.com" {
+		t.Errorf("from = %q", msg.From)
+	}
+	if len(msg.To) != 1 || msg.To[0] != "alice@example.com" {
+		t.Errorf("to = %v", msg.To)
+	}
+	if !strings.Contains(msg.Data, "Subject: HelloBcc: mallory@example.com\r\n") {
+		t.Errorf("line breaks in the subject are not dropped:\n%s", msg.Data)
+	}
+	if !strings.HasSuffix(msg.Data, "\r\n\r\nline one\r\n.line two\r\n") {
+		t.Errorf("unexpected body:\n%s", msg.Data)
+	}
+}
+
+func TestSendDisabled(t *testing.T) {
+	prev := settings.SMTPHost.Get()
+	_ = settings.SMTPHost.Set("")
+	t.Cleanup(func() {
+		_ = settings.SMTPHost.Set(prev)
+	})
+
+	if mailer.Enabled() {
+		t.Fatal("mailer is enabled without a host")
+	}
+	if err := mailer.Send("alice@example.com", "Hello", "body"); err == nil {
+		t.Fatal("expected an error without a host")
+	}
+}
@@ -0,0 +1,153 @@
+// Package mailertest provides a local SMTP server that records the emails it receives, for tests.
+package mailertest
+
+import (
+	"bufio"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/llmos-ai/llmos-dashboard/pkg/mailer"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+)
+
+// Message is an email received by the Server.
+type Message struct {
+	From string
+	To   []string
+	Data string
+}
+
+// Server is a plain SMTP server without authentication nor TLS, listening on a local port.
+type Server struct {
+	Host     string
+	Port     string
+	Messages chan Message
+
+	listener net.Listener
+	wg       sync.WaitGroup
+}
+
+// NewServer starts a Server that is closed when the test finishes.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	host, port, _ := net.SplitHostPort(l.Addr().String())
+	s := &Server{
+		Host:     host,
+		Port:     port,
+		Messages: make(chan Message, 16),
+		listener: l,
+	}
+	s.wg.Add(1)
+	go s.serve()
+	t.Cleanup(s.Close)
+	return s
+}
+
+// Configure points the mailer settings at the server, they are restored when the test finishes.
+func (s *Server) Configure(t testing.TB) {
+	t.Helper()
+	values := map[settings.Setting]string{
+		settings.SMTPHost:     s.Host,
+		settings.SMTPPort:     s.Port,
+		settings.SMTPTLSMode:  mailer.TLSModeNone,
+		settings.SMTPUsername: "",
+		settings.SMTPFrom:     "dashboard@example.com",
+	}
+	for setting, value := range values {
+		prev := setting.Get()
+		if err := setting.Set(value); err != nil {
+			t.Fatalf("failed to set %s: %v", setting.Name, err)
+		}
+		t.Cleanup(func() {
+			_ = setting.Set(prev)
+		})
+	}
+}
+
+// Close stops the server and waits for the open connections to finish.
+func (s *Server) Close() {
+	_ = s.listener.Close()
+	s.wg.Wait()
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.handle(conn)
+		}()
+	}
+}
+
+func (s *Server) handle(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	reply := func(line string) {
+		_, _ = conn.Write([]byte(line + "\r\n"))
+	}
+
+	reply("220 localhost ESMTP mailertest")
+	var msg Message
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		verb := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch verb {
+		case "EHLO", "HELO":
+			reply("250 localhost")
+		case "MAIL":
+			msg = Message{From: addressOf(line)}
+			reply("250 OK")
+		case "RCPT":
+			msg.To = append(msg.To, addressOf(line))
+			reply("250 OK")
+		case "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				data.WriteString(strings.TrimPrefix(l, "."))
+			}
+			msg.Data = data.String()
+			s.Messages <- msg
+			reply("250 OK")
+		case "RSET", "NOOP":
+			reply("250 OK")
+		case "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("502 Command not implemented")
+		}
+	}
+}
+
+// addressOf returns the address of a MAIL FROM:<...> or RCPT TO:<...> command.
+func addressOf(line string) string {
+	start, end := strings.IndexByte(line, '<'), strings.LastIndexByte(line, '>')
+	if start < 0 || end < start {
+		return ""
+	}
+	return line[start+1 : end]
+}
@@ -0,0 +1,25 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/llmos-ai/llmos-dashboard/pkg/api/audit"
+	"github.com/llmos-ai/llmos-dashboard/pkg/api/auth"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+)
+
+func Register(r *gin.Engine, client *ent.Client, ctx context.Context) error {
+	authHandler := auth.NewAuthHandler(client, ctx)
+	api := r.Group("/api/v1/audit")
+	api.Use(authHandler.AuthMiddleware, authHandler.AdminMiddleware)
+
+	h := audit.NewHandler(client, ctx)
+	{
+		api.GET("/events", h.ListAuditEvents)
+		api.GET("/events/export", h.ExportAuditEvents)
+	}
+
+	return nil
+}
@@ -5,19 +5,52 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/llmos-ai/llmos-dashboard/pkg/api/audit"
 	"github.com/llmos-ai/llmos-dashboard/pkg/api/auth"
 	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
 )
 
 func RegisterAuthRoute(r *gin.Engine, c *ent.Client, ctx context.Context) error {
 
-	auth := auth.NewAuthHandler(c, ctx)
+	authHandler := auth.NewAuthHandler(c, ctx)
+	utils.SetKeyProvider(&authHandler)
+
+	r.GET("/.well-known/jwks.json", authHandler.GetJWKS)
+
 	apiv1 := r.Group("/api/v1/auths")
-	apiv1.Use(auth.AuthMiddleware)
+	apiv1.Use(authHandler.AuthMiddleware, audit.Context)
 	{
-		apiv1.GET("/", auth.GetAuthorizedUser)
-		apiv1.POST("/signin", auth.SignIn)
-		apiv1.POST("/signup", auth.SingUpMiddleware, auth.SignUp)
+		apiv1.GET("/", authHandler.GetAuthorizedUser)
+		apiv1.POST("/signin", authHandler.SignIn)
+		apiv1.POST("/signin/mfa", authHandler.SignInMFA)
+		apiv1.POST("/signin/proxy", authHandler.SignInProxy)
+		apiv1.POST("/signup", authHandler.SingUpMiddleware, authHandler.SignUp)
+		apiv1.POST("/signup/invite", authHandler.SignUpWithInvite)
+		apiv1.POST("/refresh", authHandler.RefreshToken)
+		apiv1.POST("/signout", authHandler.SignOut)
+		apiv1.POST("/update/password", authHandler.DenyImpersonation, authHandler.UpdatePassword)
+		apiv1.POST("/update/profile", authHandler.UpdateProfile)
+		apiv1.POST("/password/forgot", authHandler.ForgotPassword)
+		apiv1.POST("/password/reset", authHandler.ResetPassword)
+
+		// TOTP two-factor authentication
+		apiv1.POST("/2fa/setup", authHandler.DenyImpersonation, authHandler.SetupTwoFactor)
+		apiv1.POST("/2fa/enable", authHandler.DenyImpersonation, authHandler.EnableTwoFactor)
+		apiv1.POST("/2fa/disable", authHandler.DenyImpersonation, authHandler.DisableTwoFactor)
+
+		// OpenID Connect single sign-on
+		apiv1.GET("/oidc/login", authHandler.OIDCLogin)
+		apiv1.GET("/oidc/callback", authHandler.OIDCCallback)
+
+		// personal api keys
+		apiv1.GET("/api_keys", authHandler.ListUserAPIKeys)
+		apiv1.POST("/api_keys", authHandler.DenyImpersonation, authHandler.CreateUserAPIKey)
+		apiv1.DELETE("/api_keys/:id", authHandler.DenyImpersonation, authHandler.DeleteUserAPIKey)
+
+		// jwt signing keys
+		apiv1.GET("/keys", authHandler.RequirePermission(auth.PermissionSettingsRead), authHandler.ListSigningKeys)
+		apiv1.POST("/keys/rotate", authHandler.RequirePermission(auth.PermissionSettingsWrite), authHandler.RotateSigningKeys)
 	}
 	return nil
 }
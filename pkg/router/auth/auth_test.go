@@ -0,0 +1,101 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/gin-gonic/gin"
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/llmos-ai/llmos-dashboard/pkg/api/auth"
+	"github.com/llmos-ai/llmos-dashboard/pkg/constant"
+	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/enttest"
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func createTestUser(t *testing.T, client *entv1.Client, name string, role entv1User.Role) *entv1.User {
+	t.Helper()
+	hash, err := utils.HashPassword("Passw0rd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client.User.Create().
+		SetName(name).
+		SetEmail(name + "@example.com").
+		SetPassword(hash).
+		SetRole(role).
+		SaveX(context.Background())
+}
+
+func TestImpersonationDeniedRoutes(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:"+url.PathEscape(t.Name())+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	r := gin.New()
+	if err := RegisterAuthRoute(r, client, context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	admin := createTestUser(t, client, "admin", entv1User.RoleAdmin)
+	alice := createTestUser(t, client, "alice", entv1User.RoleUser)
+	h := auth.NewAuthHandler(client, context.Background())
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodPost, "/", nil)
+	own, err := h.CreateSession(c, alice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	impersonation, _, err := h.Impersonate(c, admin, alice)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serve := func(method, path, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+	denied := func(w *httptest.ResponseRecorder) bool {
+		var res struct {
+			Error string `json:"error"`
+		}
+		_ = json.Unmarshal(w.Body.Bytes(), &res)
+		return w.Code == http.StatusForbidden && res.Error == constant.MessageErrorImpersonation
+	}
+
+	// the impersonation session works for the other routes
+	if w := serve(http.MethodGet, "/api/v1/auths/", impersonation); w.Code != http.StatusOK {
+		t.Fatalf("get the impersonated user: status = %d: %s", w.Code, w.Body)
+	}
+
+	for _, route := range []struct{ method, path string }{
+		{http.MethodPost, "/api/v1/auths/update/password"},
+		{http.MethodPost, "/api/v1/auths/2fa/setup"},
+		{http.MethodPost, "/api/v1/auths/2fa/enable"},
+		{http.MethodPost, "/api/v1/auths/2fa/disable"},
+		{http.MethodPost, "/api/v1/auths/api_keys"},
+		{http.MethodDelete, "/api/v1/auths/api_keys/" + alice.ID.String()},
+	} {
+		if w := serve(route.method, route.path, impersonation); !denied(w) {
+			t.Errorf("%s %s with the impersonation token: status = %d: %s", route.method, route.path, w.Code, w.Body)
+		}
+		if w := serve(route.method, route.path, own.Token); denied(w) {
+			t.Errorf("%s %s with the user's own token is denied", route.method, route.path)
+		}
+	}
+}
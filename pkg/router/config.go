@@ -3,8 +3,11 @@ package router
 import (
 	"github.com/gin-gonic/gin"
 
+	"github.com/llmos-ai/llmos-dashboard/pkg/api/auth"
 	"github.com/llmos-ai/llmos-dashboard/pkg/config"
 	"github.com/llmos-ai/llmos-dashboard/pkg/constant"
+	"github.com/llmos-ai/llmos-dashboard/pkg/mailer"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
 	"github.com/llmos-ai/llmos-dashboard/pkg/version"
 )
 
@@ -32,6 +35,11 @@ func GetAPIConfig(c *gin.Context) {
 		"images":                     false,
 		"default_models":             nil,
 		"default_prompt_suggestions": config.GetDefaultPromptSuggestions(),
+		"passwordReset":              mailer.Enabled(),
+		"oidc": gin.H{
+			"enabled": auth.OIDCEnabled(),
+			"name":    settings.OIDCProviderName.Get(),
+		},
 	})
 }
 
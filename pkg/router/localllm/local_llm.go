@@ -1,40 +1,59 @@
 package localllm
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
+	"strconv"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/llmos-ai/llmos-dashboard/pkg/api/audit"
 	"github.com/llmos-ai/llmos-dashboard/pkg/api/auth"
 	"github.com/llmos-ai/llmos-dashboard/pkg/api/localllm"
+	"github.com/llmos-ai/llmos-dashboard/pkg/api/quota"
+	"github.com/llmos-ai/llmos-dashboard/pkg/api/usage"
 	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
 	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+	"github.com/llmos-ai/llmos-dashboard/pkg/utils"
 )
 
 const apiPrefix = "/api/localllm"
 
 func Register(r *gin.Engine, client *ent.Client, ctx context.Context) error {
-	auth := auth.NewAuthHandler(client, ctx)
+	authHandler := auth.NewAuthHandler(client, ctx)
 	api := r.Group(apiPrefix)
-	api.Use(auth.AuthMiddleware)
+	api.Use(authHandler.AuthMiddleware, audit.Context)
 	h := localllm.NewHandler(client, ctx)
+	modelAccess := modelAccessMiddleware(&authHandler)
+	auditHandler := audit.NewHandler(client, ctx)
+	quotaHandler := quota.NewHandler(client, ctx)
+	usageHandler := usage.NewHandler(client, ctx)
+	auditModel := func(action string) gin.HandlerFunc {
+		return auditHandler.Action(action, "model", auditedModel)
+	}
+	// the url is stored through the settings provider, which does not run in the request context
+	auditURLUpdate := auditHandler.Action("setting.update", "setting", func(*gin.Context) string {
+		return settings.LocalLLMServerURLSettingName
+	})
 	{
 		// reverse proxy for ollama apis
-		api.GET("/ollama/api/version", ReverseProxy)                         // Get ollama version
-		api.GET("/ollama/api/tags", ReverseProxy)                            // List Local Models
-		api.POST("/ollama/api/generate", ReverseProxy)                       // Generate a completion
-		api.POST("/ollama/api/chat", ReverseProxy)                           // Generate a chat completion
-		api.POST("/ollama/api/create", auth.AdminMiddleware, ReverseProxy)   // Create a Model
-		api.POST("/ollama/api/pull", auth.AdminMiddleware, ReverseProxy)     // Pull a Model
-		api.DELETE("/ollama/api/delete", auth.AdminMiddleware, ReverseProxy) // Delete a Model
+		api.GET("/ollama/api/version", ReverseProxy)                                                                                               // Get ollama version
+		api.GET("/ollama/api/tags", modelAccess, ReverseProxy)                                                                                     // List Local Models
+		api.POST("/ollama/api/generate", modelAccess, quotaHandler.Middleware, usageHandler.Middleware, ReverseProxy)                              // Generate a completion
+		api.POST("/ollama/api/chat", modelAccess, quotaHandler.Middleware, usageHandler.Middleware, ReverseProxy)                                  // Generate a chat completion
+		api.POST("/ollama/api/create", authHandler.RequirePermission(auth.PermissionModelCreate), auditModel("model.create"), ReverseProxy)        // Create a Model
+		api.POST("/ollama/api/pull", authHandler.RequirePermission(auth.PermissionModelPull), modelAccess, auditModel("model.pull"), ReverseProxy) // Pull a Model
+		api.DELETE("/ollama/api/delete", authHandler.RequirePermission(auth.PermissionModelDelete), auditModel("model.delete"), ReverseProxy)      // Delete a Model
 
 		// custom localllm api
 		api.GET("/url", h.GetLocalLLMUrl)
-		api.POST("/url/update", auth.AdminMiddleware, h.UpdateLocalLLMUrl)
+		api.POST("/url/update", authHandler.RequirePermission(auth.PermissionSettingsWrite), auditURLUpdate, h.UpdateLocalLLMUrl)
 		api.GET("/cancel/:id", h.CancelRequest)
 	}
 
@@ -58,9 +77,101 @@ func ReverseProxy(c *gin.Context) {
 		req.URL.Host = url.Host
 		req.URL.Path = trimOllamaPrefix(req.URL.Path)
 	}
+	if access, ok := c.Get("modelAccess"); ok && trimOllamaPrefix(c.Request.URL.Path) == "/api/tags" {
+		// the listed models are rewritten, so the response must not be compressed
+		c.Request.Header.Del("Accept-Encoding")
+		proxy.ModifyResponse = func(resp *http.Response) error {
+			return filterModels(resp, access.(*auth.ModelAccess))
+		}
+	}
 	proxy.ServeHTTP(c.Writer, c.Request)
 }
 
 func trimOllamaPrefix(path string) string {
 	return strings.TrimPrefix(path, apiPrefix+"/ollama")
 }
+
+// modelAccessMiddleware rejects requests for models that the model whitelist or the groups of the session user do not allow,
+// and passes the model access of restricted users on to filter the listed models.
+func modelAccessMiddleware(h *auth.Handler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, err := utils.GetSessionUser(c)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+
+		access, err := h.GetModelAccess(c.Request.Context(), user)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if access == nil {
+			c.Next()
+			return
+		}
+
+		if c.Request.Method == http.MethodPost {
+			model, err := localllm.RequestModel(c)
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			if err = access.Check(model); err != nil {
+				c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": err.Error()})
+				return
+			}
+		}
+		c.Set("modelAccess", access)
+		c.Next()
+	}
+}
+
+// auditedModel returns the model of a request as the target of its audit event.
+func auditedModel(c *gin.Context) string {
+	model, _ := localllm.RequestModel(c)
+	return model
+}
+
+// filterModels removes the models that are not allowed from an ollama tags response.
+func filterModels(resp *http.Response, access *auth.ModelAccess) error {
+	if resp.StatusCode != http.StatusOK {
+		return nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return err
+	}
+
+	var tags map[string]json.RawMessage
+	if err = json.Unmarshal(body, &tags); err != nil {
+		return err
+	}
+	var models []json.RawMessage
+	if err = json.Unmarshal(tags["models"], &models); err != nil {
+		return err
+	}
+
+	allowed := make([]json.RawMessage, 0, len(models))
+	for _, m := range models {
+		var model struct {
+			Name string `json:"name"`
+		}
+		if json.Unmarshal(m, &model) == nil && access.Allowed(model.Name) {
+			allowed = append(allowed, m)
+		}
+	}
+	if tags["models"], err = json.Marshal(allowed); err != nil {
+		return err
+	}
+	if body, err = json.Marshal(tags); err != nil {
+		return err
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.Header.Set("Content-Length", strconv.Itoa(len(body)))
+	return nil
+}
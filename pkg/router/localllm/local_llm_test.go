@@ -0,0 +1,224 @@
+package localllm
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/gin-gonic/gin"
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/llmos-ai/llmos-dashboard/pkg/api/auth"
+	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/enttest"
+	entv1User "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent/user"
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+// setSetting changes a setting for the duration of the test.
+func setSetting(t *testing.T, s settings.Setting, value string) {
+	t.Helper()
+	prev := s.Get()
+	if err := s.Set(value); err != nil {
+		t.Fatalf("failed to set %s: %v", s.Name, err)
+	}
+	t.Cleanup(func() {
+		_ = s.Set(prev)
+	})
+}
+
+// newOllama starts a fake ollama server and points the local LLM url at it,
+// the returned slice collects the models of the requests that reached it.
+func newOllama(t *testing.T) *[]string {
+	t.Helper()
+	var served []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/tags", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, `{"models":[{"name":"llama3:latest","size":1},{"name":"llama3:70b","size":2},`+
+			`{"name":"mistral:7b","size":3},{"name":"gemma:2b","size":4}]}`)
+	})
+	answer := func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Model string `json:"model"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		served = append(served, req.Model)
+		_, _ = io.WriteString(w, `{"model":"`+req.Model+`","done":true}`)
+	}
+	mux.HandleFunc("/api/generate", answer)
+	mux.HandleFunc("/api/chat", answer)
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	setSetting(t, settings.LocalLLMServerURL, srv.URL)
+	return &served
+}
+
+// newTestRouter returns a router that proxies the ollama apis with the model access of the user applied.
+func newTestRouter(t *testing.T, role entv1User.Role, groupModels []string) *gin.Engine {
+	t.Helper()
+	client := enttest.Open(t, dialect.SQLite, "file:"+url.PathEscape(t.Name())+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	ctx := context.Background()
+	user := client.User.Create().
+		SetName("alice").
+		SetEmail("alice@example.com").
+		SetPassword("not a hash").
+		SetRole(role).
+		SaveX(ctx)
+	if groupModels != nil {
+		client.Group.Create().SetName("staff").SetModels(groupModels).AddUsers(user).SaveX(ctx)
+	}
+
+	authHandler := auth.NewAuthHandler(client, ctx)
+	r := gin.New()
+	api := r.Group(apiPrefix, func(c *gin.Context) {
+		c.Set("user", user)
+	})
+	modelAccess := modelAccessMiddleware(&authHandler)
+	api.GET("/ollama/api/tags", modelAccess, ReverseProxy)
+	api.POST("/ollama/api/generate", modelAccess, ReverseProxy)
+	api.POST("/ollama/api/chat", modelAccess, ReverseProxy)
+	return r
+}
+
+// closeNotifyRecorder is a response recorder the reverse proxy accepts.
+type closeNotifyRecorder struct {
+	*httptest.ResponseRecorder
+}
+
+func (closeNotifyRecorder) CloseNotify() <-chan bool {
+	return nil
+}
+
+func serve(r *gin.Engine, req *http.Request) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	r.ServeHTTP(closeNotifyRecorder{w}, req)
+	return w
+}
+
+func listModels(t *testing.T, r *gin.Engine) []string {
+	t.Helper()
+	w := serve(r, httptest.NewRequest(http.MethodGet, apiPrefix+"/ollama/api/tags", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("list models: status = %d: %s", w.Code, w.Body)
+	}
+	var tags struct {
+		Models []struct {
+			Name string `json:"name"`
+			Size int    `json:"size"`
+		} `json:"models"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &tags); err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, m := range tags.Models {
+		if m.Size == 0 {
+			t.Errorf("model %s lost its fields", m.Name)
+		}
+		names = append(names, m.Name)
+	}
+	return names
+}
+
+func TestListModelsFiltered(t *testing.T) {
+	tests := []struct {
+		name        string
+		whitelist   string
+		role        entv1User.Role
+		groupModels []string
+		want        []string
+	}{
+		{
+			name: "unrestricted",
+			role: entv1User.RoleUser,
+			want: []string{"llama3:latest", "llama3:70b", "mistral:7b", "gemma:2b"},
+		},
+		{
+			name:      "whitelist",
+			whitelist: "llama3,gemma:*",
+			role:      entv1User.RoleUser,
+			want:      []string{"llama3:latest", "llama3:70b", "gemma:2b"},
+		},
+		{
+			name:        "groups",
+			role:        entv1User.RoleUser,
+			groupModels: []string{"llama3:latest", "mistral"},
+			want:        []string{"llama3:latest", "mistral:7b"},
+		},
+		{
+			name:        "whitelist and groups",
+			whitelist:   "llama3",
+			role:        entv1User.RoleUser,
+			groupModels: []string{"*:latest"},
+			want:        []string{"llama3:latest"},
+		},
+		{
+			name:        "group without models",
+			role:        entv1User.RoleUser,
+			groupModels: []string{},
+		},
+		{
+			name:        "admin",
+			role:        entv1User.RoleAdmin,
+			groupModels: []string{},
+			want:        []string{"llama3:latest", "llama3:70b", "mistral:7b", "gemma:2b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newOllama(t)
+			setSetting(t, settings.ModelWhiteList, tt.whitelist)
+			r := newTestRouter(t, tt.role, tt.groupModels)
+
+			if got := listModels(t, r); !slices.Equal(got, tt.want) {
+				t.Errorf("models = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeniedModelForbidden(t *testing.T) {
+	served := newOllama(t)
+	setSetting(t, settings.ModelWhiteList, "llama3,mistral")
+	r := newTestRouter(t, entv1User.RoleUser, []string{"llama3:*"})
+
+	tests := []struct {
+		path  string
+		model string
+		want  int
+	}{
+		{"/ollama/api/generate", "llama3:latest", http.StatusOK},
+		{"/ollama/api/chat", "llama3:70b", http.StatusOK},
+		// not allowed for the group
+		{"/ollama/api/generate", "mistral:7b", http.StatusForbidden},
+		{"/ollama/api/chat", "mistral:7b", http.StatusForbidden},
+		// not in the whitelist
+		{"/ollama/api/generate", "gemma:2b", http.StatusForbidden},
+		{"/ollama/api/chat", "gemma:2b", http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		body := strings.NewReader(`{"model":"` + tt.model + `","stream":false}`)
+		w := serve(r, httptest.NewRequest(http.MethodPost, apiPrefix+tt.path, body))
+		if w.Code != tt.want {
+			t.Errorf("%s %s: status = %d, want %d: %s", tt.path, tt.model, w.Code, tt.want, w.Body)
+		}
+	}
+
+	// the denied requests never reach ollama
+	if want := []string{"llama3:latest", "llama3:70b"}; !slices.Equal(*served, want) {
+		t.Errorf("served models = %q, want %q", *served, want)
+	}
+}
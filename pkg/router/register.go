@@ -9,7 +9,9 @@ import (
 	"github.com/gin-contrib/static"
 	"github.com/gin-gonic/gin"
 
+	apiaudit "github.com/llmos-ai/llmos-dashboard/pkg/api/audit"
 	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
+	"github.com/llmos-ai/llmos-dashboard/pkg/router/audit"
 	"github.com/llmos-ai/llmos-dashboard/pkg/router/auth"
 	"github.com/llmos-ai/llmos-dashboard/pkg/router/localllm"
 	"github.com/llmos-ai/llmos-dashboard/pkg/router/openai"
@@ -25,6 +27,7 @@ var registeredRouters = []RegisterRouter{
 	webapi.RegisterWebApi,
 	localllm.Register,
 	setting.Register,
+	audit.Register,
 }
 
 func RegisterRouters(r *gin.Engine, c *ent.Client, ctx context.Context) error {
@@ -32,6 +35,9 @@ func RegisterRouters(r *gin.Engine, c *ent.Client, ctx context.Context) error {
 	r.Use(CORSMiddleware())
 	pprof.Register(r)
 
+	// record the changes made by requests in the audit log
+	apiaudit.RegisterHooks(c)
+
 	// serve static files
 	r.Use(static.Serve("/", static.LocalFile("ui/build", true)))
 	// fallback to index.html
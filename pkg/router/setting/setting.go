@@ -5,6 +5,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/llmos-ai/llmos-dashboard/pkg/api/audit"
 	"github.com/llmos-ai/llmos-dashboard/pkg/api/auth"
 	"github.com/llmos-ai/llmos-dashboard/pkg/api/setting"
 	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
@@ -12,14 +13,14 @@ import (
 )
 
 func Register(r *gin.Engine, client *ent.Client, ctx context.Context) error {
-	auth := auth.NewAuthHandler(client, ctx)
+	authHandler := auth.NewAuthHandler(client, ctx)
 	api := r.Group("/api/v1")
-	api.Use(auth.AuthMiddleware, auth.AdminMiddleware)
+	api.Use(authHandler.AuthMiddleware, audit.Context)
 
 	handler := setting.NewHandler(client, ctx)
 	{
-		api.GET("/settings/", handler.GetAllSettings)
-		api.POST("/settings/", handler.UpdateSettingByName)
+		api.GET("/settings/", authHandler.RequirePermission(auth.PermissionSettingsRead), handler.GetAllSettings)
+		api.POST("/settings/", authHandler.RequirePermission(auth.PermissionSettingsWrite), handler.UpdateSettingByName)
 	}
 
 	return settings.SetProvider(&handler)
@@ -6,47 +6,113 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/llmos-ai/llmos-dashboard/pkg/api/audit"
 	"github.com/llmos-ai/llmos-dashboard/pkg/api/auth"
 	"github.com/llmos-ai/llmos-dashboard/pkg/api/chat"
 	"github.com/llmos-ai/llmos-dashboard/pkg/api/modelfile"
+	"github.com/llmos-ai/llmos-dashboard/pkg/api/quota"
+	"github.com/llmos-ai/llmos-dashboard/pkg/api/usage"
 	"github.com/llmos-ai/llmos-dashboard/pkg/database"
 	"github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
 )
 
 func RegisterWebApi(r *gin.Engine, client *ent.Client, ctx context.Context) error {
-	auth := auth.NewAuthHandler(client, ctx)
+	authHandler := auth.NewAuthHandler(client, ctx)
+	if err := authHandler.EnsureRoles(); err != nil {
+		return err
+	}
+
 	api := r.Group("/api/v1")
-	api.Use(auth.AuthMiddleware)
+	api.Use(authHandler.AuthMiddleware, audit.Context)
 
 	modelHandler := modelfile.NewHandler(client, ctx)
 	chatHandler := chat.NewHandler(client, ctx)
+	auditHandler := audit.NewHandler(client, ctx)
+	quotaHandler := quota.NewHandler(client, ctx)
+	usageHandler := usage.NewHandler(client, ctx)
+	if err := chatHandler.InitSearch(); err != nil {
+		return err
+	}
+	go chatHandler.RunTrashPurger()
 	{
 		api.GET("/documents/", ListDocuments)
 		api.GET("/prompts/", ListPrompts)
 
 		// Chat API
-		api.GET("/chats/tags/all", ListChatTags)
+		api.GET("/chats/tags/all", chatHandler.ListAllChatTags)
+		api.GET("/chats/tags/tag/:name", chatHandler.ListChatsByTag)
 		api.GET("/chats/", chatHandler.GetUserChats)
+		api.GET("/chats/search", chatHandler.SearchChats)
+		api.GET("/chats/trash", chatHandler.ListTrashedChats)
+		api.DELETE("/chats/trash", authHandler.RequirePermission(auth.PermissionChatDelete), chatHandler.EmptyChatTrash)
 		api.POST("/chats/new", chatHandler.CreateChat)
 		api.GET("/chats/:id", chatHandler.GetChatByID)
 		api.POST("/chats/:id", chatHandler.UpdateChatByID)
-		api.DELETE("/chats/:id", chatHandler.DeleteChatByID)
+		api.DELETE("/chats/:id", authHandler.RequirePermission(auth.PermissionChatDelete), chatHandler.DeleteChatByID)
 		api.GET("/chats/:id/tags", chatHandler.GetChatTagsByID)
+		api.POST("/chats/:id/tags", chatHandler.AddChatTagByID)
+		api.DELETE("/chats/:id/tags", chatHandler.DeleteChatTagByID)
+		api.DELETE("/chats/:id/tags/all", chatHandler.DeleteAllChatTagsByID)
+		api.POST("/chats/:id/restore", chatHandler.RestoreChatByID)
+		api.DELETE("/chats/:id/purge", authHandler.RequirePermission(auth.PermissionChatDelete), chatHandler.PurgeChatByID)
+
+		// Admin chat API, chats of other users are only accessible here and every access is audited
+		api.GET("/admin/chats/", authHandler.AdminMiddleware, auditHandler.Action("chat.admin_list", "user", userIDQuery), chatHandler.ListAllChats)
+		api.GET("/admin/chats/:id", authHandler.AdminMiddleware, auditHandler.Action("chat.admin_read", "chat", audit.Param("id")), chatHandler.GetAnyChatByID)
+		api.DELETE("/admin/chats/:id", authHandler.AdminMiddleware, auditHandler.Action("chat.admin_delete", "chat", audit.Param("id")), chatHandler.DeleteAnyChatByID)
+		api.POST("/admin/chats/search/rebuild", authHandler.AdminMiddleware, auditHandler.Action("chat.search_rebuild", "", nil), chatHandler.RebuildChatSearch)
 
 		// User API
-		api.GET("/users/", auth.ListAllUser)
-		api.POST("/users/:id/update", auth.UpdateUser)
-		api.POST("/users/update/role", auth.AdminMiddleware, auth.UpdateUserRole)
+		api.GET("/users/", authHandler.ListAllUser)
+		api.POST("/users/", authHandler.RequirePermission(auth.PermissionUserWrite), authHandler.AddUser)
+		api.DELETE("/users/:id", authHandler.RequirePermission(auth.PermissionUserWrite), authHandler.DeleteUserByID)
+		api.POST("/users/:id/impersonate", authHandler.AdminMiddleware,
+			auditHandler.Action("user.impersonate", "user", audit.Param("id")), authHandler.ImpersonateUser)
+		api.GET("/users/impersonations", authHandler.AdminMiddleware, authHandler.ListUserImpersonations)
+		api.POST("/users/:id/update", authHandler.RequirePermission(auth.PermissionUserWrite), authHandler.UpdateUser)
+		api.POST("/users/update/role", authHandler.RequirePermission(auth.PermissionUserWrite), authHandler.UpdateUserRole)
+		api.GET("/users/login-failures", authHandler.RequirePermission(auth.PermissionUserRead), authHandler.ListLoginFailures)
+		api.POST("/users/:id/unlock", authHandler.RequirePermission(auth.PermissionUserWrite), authHandler.UnlockUserByID)
+		api.GET("/users/permissions/user", authHandler.GetSessionUserPermissions)
+		api.POST("/users/permissions/user", authHandler.AdminMiddleware, authHandler.UpdateDefaultUserPermissions)
+		api.POST("/users/:id/roles", authHandler.AdminMiddleware, authHandler.UpdateUserRoles)
+		api.GET("/users/pending", authHandler.RequirePermission(auth.PermissionUserRead), authHandler.ListPendingUser)
+		api.POST("/users/:id/approve", authHandler.RequirePermission(auth.PermissionUserWrite), authHandler.ApprovePendingUser)
+		api.POST("/users/:id/reject", authHandler.RequirePermission(auth.PermissionUserWrite), authHandler.RejectPendingUser)
+		api.GET("/users/invitations", authHandler.RequirePermission(auth.PermissionUserRead), authHandler.ListUserInvitations)
+		api.POST("/users/invitations", authHandler.RequirePermission(auth.PermissionUserWrite), authHandler.CreateUserInvitation)
+		api.DELETE("/users/invitations/:id", authHandler.RequirePermission(auth.PermissionUserWrite), authHandler.RevokeUserInvitation)
+
+		// Role API, only admins manage roles as they can grant any permission
+		api.GET("/roles/", authHandler.AdminMiddleware, authHandler.ListAllRoles)
+		api.GET("/roles/permissions", authHandler.AdminMiddleware, authHandler.ListAllPermissions)
+		api.POST("/roles/", authHandler.AdminMiddleware, authHandler.CreateCustomRole)
+		api.POST("/roles/:id/update", authHandler.AdminMiddleware, authHandler.UpdateRoleByID)
+		api.DELETE("/roles/:id", authHandler.AdminMiddleware, authHandler.DeleteRoleByID)
+
+		// Group API, groups restrict the models their members can access
+		api.GET("/groups/", authHandler.AdminMiddleware, authHandler.ListUserGroups)
+		api.POST("/groups/", authHandler.AdminMiddleware, authHandler.CreateUserGroup)
+		api.POST("/groups/:id/update", authHandler.AdminMiddleware, authHandler.UpdateUserGroup)
+		api.DELETE("/groups/:id", authHandler.AdminMiddleware, authHandler.DeleteUserGroup)
+
+		// Quota API, quotas limit the LLM requests of users, groups and roles
+		api.GET("/quotas/", authHandler.AdminMiddleware, quotaHandler.ListAllQuotas)
+		api.POST("/quotas/", authHandler.AdminMiddleware, quotaHandler.SaveQuotaBySubject)
+		api.DELETE("/quotas/:id", authHandler.AdminMiddleware, quotaHandler.DeleteQuotaByID)
+		api.GET("/usage/me", quotaHandler.GetSessionUserUsage)
+		api.GET("/usage/report", authHandler.AdminMiddleware, usageHandler.GetUsageReport)
 
 		// Modefile API
 		api.GET("/modelfiles/", modelHandler.ListModelFile)
-		api.POST("/modelfiles/", auth.AdminMiddleware, modelHandler.GetModelFileByTagName)
-		api.POST("/modelfiles/create", auth.AdminMiddleware, modelHandler.CreateModelFile)
-		api.POST("/modelfiles/update", auth.AdminMiddleware, modelHandler.UpdateModelFile)
-		api.DELETE("/modelfiles/:tagName", auth.AdminMiddleware, modelHandler.DeleteModelFile)
+		api.POST("/modelfiles/", authHandler.RequirePermission(auth.PermissionModelfileWrite), modelHandler.GetModelFileByTagName)
+		api.POST("/modelfiles/create", authHandler.RequirePermission(auth.PermissionModelfileWrite), modelHandler.CreateModelFile)
+		api.POST("/modelfiles/update", authHandler.RequirePermission(auth.PermissionModelfileWrite), modelHandler.UpdateModelFile)
+		api.DELETE("/modelfiles/:tagName", authHandler.RequirePermission(auth.PermissionModelfileWrite), modelHandler.DeleteModelFile)
 
 		// DB api
-		api.GET("/db/download/", auth.AdminMiddleware, downloadDBFile)
+		api.GET("/db/download/", authHandler.RequirePermission(auth.PermissionDBDownload),
+			auditHandler.Action("db.download", "", nil), downloadDBFile)
 	}
 	return nil
 }
@@ -59,8 +125,9 @@ func ListPrompts(c *gin.Context) {
 	c.JSONP(http.StatusOK, []string{})
 }
 
-func ListChatTags(c *gin.Context) {
-	c.JSONP(http.StatusOK, []string{})
+// userIDQuery returns the user whose chats an admin lists as the target of its audit event.
+func userIDQuery(c *gin.Context) string {
+	return c.Query("userId")
 }
 
 func downloadDBFile(c *gin.Context) {
@@ -20,7 +20,7 @@ type ApiServer struct {
 func NewApiServer(ctx context.Context) ApiServer {
 	client, err := database.RegisterDBClient(ctx)
 	if err != nil {
-		slog.Error("Failed to init auth", err)
+		slog.Error("Failed to init auth", "error", err)
 		panic(0)
 	}
 
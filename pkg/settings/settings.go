@@ -5,6 +5,7 @@ import (
 	"log/slog"
 	"strconv"
 	"strings"
+	"time"
 )
 
 var (
@@ -17,11 +18,76 @@ var (
 	Signup            = NewSetting(SignupEnabledSettingName, "true")
 	DefaultUserRole   = NewSetting(DefaultUserRoleSettingName, "pending") // options are pending, user, admin
 	WebhookURL        = NewSetting(WebhookURLSettingName, "")
-	JWTSecret         = NewSetting(JWTSecretSettingName, "llmos-dashboard-secret")
-	TokenExpireTime   = NewSetting(TokenExpireTimeSettingName, "24h")    // supported units are "h", "m", "s"
+	JWTSecret         = NewSetting(JWTSecretSettingName, "")             // secret of the first HS256 signing key, a random one is generated on first boot if empty
+	TokenExpireTime   = NewSetting(TokenExpireTimeSettingName, "15m")    // access token lifetime, supported units are "h", "m", "s"
 	AllowChatDelete   = NewSetting(AllowChatDeletionSettingName, "true") // allow users to delete their own chat
-	ModelWhiteList    = NewSetting(ModelWhitelistSettingName, "")        // empty means allow all
+	ModelWhiteList    = NewSetting(ModelWhitelistSettingName, "")        // comma separated model names or glob patterns, e.g., llama3:*, empty means allow all
 	LocalLLMServerURL = NewSetting(LocalLLMServerURLSettingName, "http://localhost:11434")
+
+	RefreshTokenExpireTime = NewSetting(RefreshTokenExpireTimeSettingName, "168h")  // refresh token and session lifetime
+	AdminTwoFactorRequired = NewSetting(AdminTwoFactorRequiredSettingName, "false") // admins must enroll TOTP before using the API
+	JWTSigningAlgorithm    = NewSetting(JWTSigningAlgorithmSettingName, "HS256")    // algorithm of new signing keys, options are HS256, RS256, EdDSA
+	JWTKeyGracePeriod      = NewSetting(JWTKeyGracePeriodSettingName, "24h")        // how long a rotated signing key still verifies tokens
+
+	ModelWhitelistAdminBypass = NewSetting(ModelWhitelistAdminBypassSettingName, "false") // admins can use models that are not in the model whitelist
+
+	InvitationExpireTime    = NewSetting(InvitationExpireTimeSettingName, "72h")    // lifetime of the invitation links
+	ImpersonationExpireTime = NewSetting(ImpersonationExpireTimeSettingName, "30m") // lifetime of the tokens admins get to impersonate a user
+
+	ChatTrashRetention = NewSetting(ChatTrashRetentionSettingName, "720h") // how long deleted chats are kept in the trash before they are purged, 0 keeps them
+
+	PasswordMinLength        = NewSetting(PasswordMinLengthSettingName, "8")
+	PasswordRequireMixedCase = NewSetting(PasswordRequireMixedCaseSettingName, "false") // require both upper and lower case letters
+	PasswordRequireDigit     = NewSetting(PasswordRequireDigitSettingName, "false")
+	PasswordRequireSymbol    = NewSetting(PasswordRequireSymbolSettingName, "false")
+
+	PasswordResetExpireTime = NewSetting(PasswordResetExpireTimeSettingName, "1h") // lifetime of the emailed password reset links
+	PublicURL               = NewSetting(PublicURLSettingName, "")                 // external URL of the dashboard used in emailed links, no links are emailed while it is empty
+
+	SMTPHost     = NewSetting(SMTPHostSettingName, "") // empty means sending emails is disabled
+	SMTPPort     = NewSetting(SMTPPortSettingName, "587")
+	SMTPUsername = NewSetting(SMTPUsernameSettingName, "") // empty means no authentication
+	SMTPPassword = NewSetting(SMTPPasswordSettingName, "")
+	SMTPFrom     = NewSetting(SMTPFromSettingName, "")
+	SMTPTLSMode  = NewSetting(SMTPTLSModeSettingName, "starttls") // options are none, starttls, tls
+
+	LoginMaxFailures     = NewSetting(LoginMaxFailuresSettingName, "5")       // failed sign-ins of an account within the window before it is locked, 0 disables the lockout
+	LoginIPMaxFailures   = NewSetting(LoginIPMaxFailuresSettingName, "20")    // failed sign-ins of an IP within the window before backoff applies
+	LoginFailureWindow   = NewSetting(LoginFailureWindowSettingName, "15m")   // window in which failed sign-ins are counted
+	LoginLockoutDuration = NewSetting(LoginLockoutDurationSettingName, "15m") // how long a locked account is rejected
+	LoginBackoffBase     = NewSetting(LoginBackoffBaseSettingName, "1s")      // first delay after a failed sign-in, doubled on each further failure
+	LoginBackoffMax      = NewSetting(LoginBackoffMaxSettingName, "5m")
+
+	OIDCIssuerURL    = NewSetting(OIDCIssuerURLSettingName, "") // empty means OIDC login is disabled
+	OIDCClientID     = NewSetting(OIDCClientIDSettingName, "")
+	OIDCClientSecret = NewSetting(OIDCClientSecretSettingName, "")
+	OIDCRedirectURL  = NewSetting(OIDCRedirectURLSettingName, "") // required, e.g., https://dashboard.example.com/api/v1/auths/oidc/callback
+	OIDCScopes       = NewSetting(OIDCScopesSettingName, "openid,profile,email")
+	OIDCProviderName = NewSetting(OIDCProviderNameSettingName, "SSO") // display name of the login button
+	// accept ID tokens without the email_verified claim, only enable it for providers that verify every email
+	OIDCTrustMissingEmailVerified = NewSetting(OIDCTrustMissingEmailVerifiedSettingName, "false")
+
+	ProxyAuthEnabled      = NewSetting(ProxyAuthEnabledSettingName, "false")
+	ProxyAuthTrustedCIDRs = NewSetting(ProxyAuthTrustedCIDRsSettingName, "") // comma separated CIDRs of the proxies whose headers are trusted, e.g., 10.0.0.0/8
+	ProxyAuthEmailHeader  = NewSetting(ProxyAuthEmailHeaderSettingName, "X-Forwarded-Email")
+	ProxyAuthNameHeader   = NewSetting(ProxyAuthNameHeaderSettingName, "X-Forwarded-User")
+
+	LDAPServerURL          = NewSetting(LDAPServerURLSettingName, "") // e.g., ldaps://ldap.example.com:636, empty means LDAP login is disabled
+	LDAPStartTLS           = NewSetting(LDAPStartTLSSettingName, "false")
+	LDAPInsecureSkipVerify = NewSetting(LDAPInsecureSkipVerifySettingName, "false")
+	LDAPBindDN             = NewSetting(LDAPBindDNSettingName, "") // empty means anonymous search
+	LDAPBindPassword       = NewSetting(LDAPBindPasswordSettingName, "")
+	LDAPUserSearchBase     = NewSetting(LDAPUserSearchBaseSettingName, "")
+	LDAPUserSearchFilter   = NewSetting(LDAPUserSearchFilterSettingName, "(|(mail=%s)(uid=%s))") // %s is replaced by the escaped login
+	LDAPEmailAttribute     = NewSetting(LDAPEmailAttributeSettingName, "mail")
+	LDAPNameAttribute      = NewSetting(LDAPNameAttributeSettingName, "cn")
+	LDAPGroupAttribute     = NewSetting(LDAPGroupAttributeSettingName, "memberOf")
+	LDAPGroupRoleMapping   = NewSetting(LDAPGroupRoleMappingSettingName, "") // JSON object of group DN to role, e.g., {"cn=admins,dc=example,dc=com":"admin"}
+
+	// default quotas of the users that no user, group or role quota applies to, 0 means unlimited
+	QuotaRequestsPerMinute = NewSetting(QuotaRequestsPerMinuteSettingName, "0")
+	QuotaConcurrentStreams = NewSetting(QuotaConcurrentStreamsSettingName, "0")
+	QuotaDailyTokens       = NewSetting(QuotaDailyTokensSettingName, "0") // prompt and completion tokens per UTC day
 )
 
 const (
@@ -35,6 +101,70 @@ const (
 	AllowChatDeletionSettingName = "allow-chat-deletion"
 	ModelWhitelistSettingName    = "model-whitelist"
 	LocalLLMServerURLSettingName = "local-llm-server-url"
+
+	RefreshTokenExpireTimeSettingName = "refresh-token-expire-time"
+	AdminTwoFactorRequiredSettingName = "admin-2fa-required"
+	JWTSigningAlgorithmSettingName    = "jwt-signing-algorithm"
+	JWTKeyGracePeriodSettingName      = "jwt-key-grace-period"
+
+	ModelWhitelistAdminBypassSettingName = "model-whitelist-admin-bypass"
+
+	InvitationExpireTimeSettingName    = "invitation-expire-time"
+	ImpersonationExpireTimeSettingName = "impersonation-expire-time"
+
+	ChatTrashRetentionSettingName = "chat-trash-retention"
+
+	PasswordMinLengthSettingName        = "password-min-length"
+	PasswordRequireMixedCaseSettingName = "password-require-mixed-case"
+	PasswordRequireDigitSettingName     = "password-require-digit"
+	PasswordRequireSymbolSettingName    = "password-require-symbol"
+
+	PasswordResetExpireTimeSettingName = "password-reset-expire-time"
+	PublicURLSettingName               = "public-url"
+
+	SMTPHostSettingName     = "smtp-host"
+	SMTPPortSettingName     = "smtp-port"
+	SMTPUsernameSettingName = "smtp-username"
+	SMTPPasswordSettingName = "smtp-password"
+	SMTPFromSettingName     = "smtp-from"
+	SMTPTLSModeSettingName  = "smtp-tls-mode"
+
+	LoginMaxFailuresSettingName     = "login-max-failures"
+	LoginIPMaxFailuresSettingName   = "login-ip-max-failures"
+	LoginFailureWindowSettingName   = "login-failure-window"
+	LoginLockoutDurationSettingName = "login-lockout-duration"
+	LoginBackoffBaseSettingName     = "login-backoff-base"
+	LoginBackoffMaxSettingName      = "login-backoff-max"
+
+	OIDCIssuerURLSettingName    = "oidc-issuer-url"
+	OIDCClientIDSettingName     = "oidc-client-id"
+	OIDCClientSecretSettingName = "oidc-client-secret"
+	OIDCRedirectURLSettingName  = "oidc-redirect-url"
+	OIDCScopesSettingName       = "oidc-scopes"
+	OIDCProviderNameSettingName = "oidc-provider-name"
+
+	OIDCTrustMissingEmailVerifiedSettingName = "oidc-trust-missing-email-verified"
+
+	ProxyAuthEnabledSettingName      = "proxy-auth-enabled"
+	ProxyAuthTrustedCIDRsSettingName = "proxy-auth-trusted-cidrs"
+	ProxyAuthEmailHeaderSettingName  = "proxy-auth-email-header"
+	ProxyAuthNameHeaderSettingName   = "proxy-auth-name-header"
+
+	LDAPServerURLSettingName          = "ldap-server-url"
+	LDAPStartTLSSettingName           = "ldap-start-tls"
+	LDAPInsecureSkipVerifySettingName = "ldap-insecure-skip-verify"
+	LDAPBindDNSettingName             = "ldap-bind-dn"
+	LDAPBindPasswordSettingName       = "ldap-bind-password"
+	LDAPUserSearchBaseSettingName     = "ldap-user-search-base"
+	LDAPUserSearchFilterSettingName   = "ldap-user-search-filter"
+	LDAPEmailAttributeSettingName     = "ldap-email-attribute"
+	LDAPNameAttributeSettingName      = "ldap-name-attribute"
+	LDAPGroupAttributeSettingName     = "ldap-group-attribute"
+	LDAPGroupRoleMappingSettingName   = "ldap-group-role-mapping"
+
+	QuotaRequestsPerMinuteSettingName = "quota-requests-per-minute"
+	QuotaConcurrentStreamsSettingName = "quota-concurrent-streams"
+	QuotaDailyTokensSettingName       = "quota-daily-tokens"
 )
 
 func init() {
@@ -102,7 +232,7 @@ func (s Setting) GetInt() int {
 	if err == nil {
 		return i
 	}
-	slog.Error("failed to parse setting %s=%s as int: %v", s.Name, v, err)
+	slog.Error("failed to parse setting as int", "name", s.Name, "value", v, "error", err)
 	i, err = strconv.Atoi(s.Default)
 	if err != nil {
 		return 0
@@ -110,6 +240,20 @@ func (s Setting) GetInt() int {
 	return i
 }
 
+func (s Setting) GetDuration() time.Duration {
+	v := s.Get()
+	d, err := time.ParseDuration(v)
+	if err == nil {
+		return d
+	}
+	slog.Error("failed to parse setting as duration", "name", s.Name, "value", v, "error", err)
+	d, err = time.ParseDuration(s.Default)
+	if err != nil {
+		return 0
+	}
+	return d
+}
+
 func SetProvider(p Provider) error {
 	if err := p.SetAll(settings); err != nil {
 		return err
@@ -118,6 +262,22 @@ func SetProvider(p Provider) error {
 	return nil
 }
 
+// MaskedValue replaces the values of the secret settings in the API responses.
+const MaskedValue = "********"
+
+// secretSettings are the settings holding credentials, their values are never returned by the API nor audited.
+var secretSettings = map[string]bool{
+	JWTSecretSettingName:        true,
+	SMTPPasswordSettingName:     true,
+	OIDCClientSecretSettingName: true,
+	LDAPBindPasswordSettingName: true,
+}
+
+// IsSecret returns whether the setting holds a credential.
+func IsSecret(name string) bool {
+	return secretSettings[name]
+}
+
 func NewSetting(name, def string) Setting {
 	s := Setting{
 		Name:    name,
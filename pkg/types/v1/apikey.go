@@ -0,0 +1,51 @@
+package v1
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ApiKey holds the schema definition for the ApiKey entity,
+// a personal key used for scripted access to the API.
+type ApiKey struct {
+	ent.Schema
+}
+
+// Fields of the ApiKey.
+func (ApiKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).Unique(),
+		field.UUID("userId", uuid.UUID{}).StorageKey("user_id"),
+		field.String("name").NotEmpty(),
+		// sha256 hash of the key, the raw key is only returned once on creation
+		field.String("key").NotEmpty().Unique().Sensitive(),
+		// leading characters of the raw key to help users tell their keys apart
+		field.String("prefix").Default(""),
+		field.Time("lastUsedAt").StorageKey("last_used_at").Optional().Nillable(),
+		field.Time("expiresAt").StorageKey("expires_at").Optional().Nillable(),
+		field.Time("createdAt").StorageKey("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the ApiKey.
+func (ApiKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("apiKeys").
+			Field("userId").
+			Unique().
+			Required(),
+	}
+}
+
+func (ApiKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("userId"),
+	}
+}
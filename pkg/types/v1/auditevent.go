@@ -0,0 +1,47 @@
+package v1
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// AuditEvent holds the schema definition for the AuditEvent entity, an append-only record
+// of a security-relevant action. The actor is not an edge so that events outlive deleted users.
+type AuditEvent struct {
+	ent.Schema
+}
+
+// Fields of the AuditEvent.
+func (AuditEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).Unique().Immutable(),
+		// empty for unauthenticated requests, e.g., signup
+		field.UUID("actorId", uuid.UUID{}).StorageKey("actor_id").Optional().Nillable().Immutable(),
+		field.String("actorEmail").StorageKey("actor_email").Default("").Immutable(),
+		// the admin impersonating the actor
+		field.UUID("impersonatorId", uuid.UUID{}).StorageKey("impersonator_id").Optional().Nillable().Immutable(),
+		// e.g., user.update, setting.update, model.pull
+		field.String("action").NotEmpty().Immutable(),
+		field.String("targetType").StorageKey("target_type").Default("").Immutable(),
+		field.String("target").Default("").Immutable(),
+		field.String("ip").Default("").Immutable(),
+		field.String("userAgent").StorageKey("user_agent").Default("").Immutable(),
+		// changed fields and edges, e.g., {"role": {"old": "user", "new": "admin"}}
+		field.JSON("diff", map[string]interface{}{}).Optional().Immutable(),
+		field.Time("createdAt").StorageKey("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (AuditEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("createdAt"),
+		index.Fields("actorId"),
+		index.Fields("action"),
+		index.Fields("targetType", "target"),
+	}
+}
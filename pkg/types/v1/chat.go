@@ -43,10 +43,13 @@ func (Chat) Fields() []ent.Field {
 		//field.Text("chat").NotEmpty(),
 		field.JSON("models", []string{}),
 		//field.String("options").NotEmpty(),
-		field.JSON("tags", []string{}),
 		field.JSON("history", Histroy{}),
 		field.JSON("messages", []Message{}),
-		field.Time("createdAt").StorageKey("created_at").Default(time.Now()).Immutable(),
+		field.Time("createdAt").StorageKey("created_at").Default(time.Now).Immutable(),
+		// set when the title or the messages change, for ordering the chats by recent activity
+		field.Time("updatedAt").StorageKey("updated_at").Default(time.Now),
+		// set when the chat is moved to the trash, trashed chats are purged after the retention period
+		field.Time("deletedAt").StorageKey("deleted_at").Optional().Nillable(),
 	}
 }
 
@@ -63,11 +66,15 @@ func (Chat) Edges() []ent.Edge {
 			// that a chat can have only one owner user.
 			Unique().
 			Required(),
+		// tags of the chat, they used to be stored as a JSON list of names
+		edge.To("tags", Tag.Type),
 	}
 }
 
 func (Chat) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("userId"),
+		index.Fields("deletedAt"),
+		index.Fields("userId", "updatedAt"),
 	}
 }
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Group holds the schema definition for the Group entity, e.g., a department whose
+// members may only use the models matching one of its model patterns.
+type Group struct {
+	ent.Schema
+}
+
+// Fields of the Group.
+func (Group) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).Unique(),
+		field.String("name").NotEmpty().Unique(),
+		field.String("description").Default(""),
+		// model names or glob patterns, e.g., llama3:8b or mistral*
+		field.Strings("models").Default([]string{}),
+		field.Time("createdAt").StorageKey("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the Group.
+func (Group) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("users", User.Type).
+			Ref("groups"),
+		edge.To("quota", Quota.Type).
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Invitation holds the schema definition for the Invitation entity,
+// a single-use link to sign up while public signup is disabled.
+type Invitation struct {
+	ent.Schema
+}
+
+// Fields of the Invitation.
+func (Invitation) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).Unique(),
+		field.String("email").NotEmpty(),
+		// the role of the user signing up with the invitation, instead of the default user role
+		field.Enum("role").Values("admin", "user", "pending").Default("user"),
+		// sha256 hash of the token, the raw token is only returned once on creation
+		field.String("token").NotEmpty().Unique().Sensitive(),
+		field.UUID("createdBy", uuid.UUID{}).StorageKey("created_by"),
+		field.Time("expiresAt").StorageKey("expires_at"),
+		field.Time("usedAt").StorageKey("used_at").Optional().Nillable(),
+		field.Time("revokedAt").StorageKey("revoked_at").Optional().Nillable(),
+		field.Time("createdAt").StorageKey("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the Invitation.
+func (Invitation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("inviter", User.Type).
+			Ref("invitations").
+			Field("createdBy").
+			Unique().
+			Required(),
+	}
+}
+
+func (Invitation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("email"),
+	}
+}
@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LoginFailure holds the schema definition for the LoginFailure entity,
+// a record of a rejected sign-in attempt.
+type LoginFailure struct {
+	ent.Schema
+}
+
+// Fields of the LoginFailure.
+func (LoginFailure) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("email").Default(""),
+		field.String("ip").Default(""),
+		field.String("userAgent").StorageKey("user_agent").Default(""),
+		// invalid_credentials or invalid_mfa_code
+		field.String("reason").NotEmpty(),
+		field.Time("createdAt").StorageKey("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (LoginFailure) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("email"),
+		index.Fields("createdAt"),
+	}
+}
@@ -0,0 +1,48 @@
+package v1
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// PasswordReset holds the schema definition for the PasswordReset entity,
+// a single-use token emailed to a user who forgot the password.
+type PasswordReset struct {
+	ent.Schema
+}
+
+// Fields of the PasswordReset.
+func (PasswordReset) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).Unique(),
+		field.UUID("userId", uuid.UUID{}).StorageKey("user_id"),
+		// sha256 hash of the token, the raw token is only sent by email
+		field.String("token").NotEmpty().Unique().Sensitive(),
+		field.Time("expiresAt").StorageKey("expires_at"),
+		field.Time("usedAt").StorageKey("used_at").Optional().Nillable(),
+		field.Time("createdAt").StorageKey("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the PasswordReset.
+func (PasswordReset) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("passwordResets").
+			Field("userId").
+			Unique().
+			Required(),
+	}
+}
+
+func (PasswordReset) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("userId"),
+	}
+}
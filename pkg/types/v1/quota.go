@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Quota holds the schema definition for the Quota entity, the limits on the LLM requests of
+// a user, of the members of a group or of the users with a role. Exactly one of them is set.
+type Quota struct {
+	ent.Schema
+}
+
+// Fields of the Quota.
+func (Quota) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).Unique(),
+		field.UUID("userId", uuid.UUID{}).StorageKey("user_id").Optional().Nillable(),
+		field.UUID("groupId", uuid.UUID{}).StorageKey("group_id").Optional().Nillable(),
+		field.UUID("roleId", uuid.UUID{}).StorageKey("role_id").Optional().Nillable(),
+		// a nil limit is inherited from the group, role or default quota, 0 means unlimited
+		field.Int("requestsPerMinute").StorageKey("requests_per_minute").Optional().Nillable().NonNegative(),
+		field.Int("concurrentStreams").StorageKey("concurrent_streams").Optional().Nillable().NonNegative(),
+		field.Int("dailyTokens").StorageKey("daily_tokens").Optional().Nillable().NonNegative(),
+		field.Time("createdAt").StorageKey("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the Quota.
+func (Quota) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("quota").
+			Field("userId").
+			Unique(),
+		edge.From("group", Group.Type).
+			Ref("quota").
+			Field("groupId").
+			Unique(),
+		edge.From("role", Role.Type).
+			Ref("quota").
+			Field("roleId").
+			Unique(),
+	}
+}
@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Role holds the schema definition for the Role entity, a named set of permissions.
+// The built-in roles mirror the admin, user and pending user roles, custom roles are
+// assigned to users in addition to their built-in role.
+type Role struct {
+	ent.Schema
+}
+
+// Fields of the Role.
+func (Role) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).Unique(),
+		field.String("name").NotEmpty().Unique(),
+		field.String("description").Default(""),
+		field.Bool("builtin").Default(false).Immutable(),
+		field.Time("createdAt").StorageKey("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the Role.
+func (Role) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("permissions", Permission.Type),
+		edge.From("users", User.Type).
+			Ref("roles"),
+		edge.To("quota", Quota.Type).
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
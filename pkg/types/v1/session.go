@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Session holds the schema definition for the Session entity,
+// a login session backing a rotating refresh token.
+type Session struct {
+	ent.Schema
+}
+
+// Fields of the Session.
+func (Session) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).Unique(),
+		field.UUID("userId", uuid.UUID{}).StorageKey("user_id"),
+		// sha256 hash of the current refresh token, the raw token is never stored
+		field.String("refreshToken").StorageKey("refresh_token").NotEmpty().Unique().Sensitive(),
+		field.String("userAgent").StorageKey("user_agent").Default(""),
+		field.String("ip").Default(""),
+		field.Time("expiresAt").StorageKey("expires_at"),
+		field.Time("revokedAt").StorageKey("revoked_at").Optional().Nillable(),
+		// the admin that impersonates the user, the session is the audit record of the impersonation
+		field.UUID("impersonatorId", uuid.UUID{}).StorageKey("impersonator_id").Optional().Nillable().Immutable(),
+		field.Time("createdAt").StorageKey("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the Session.
+func (Session) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("sessions").
+			Field("userId").
+			Unique().
+			Required(),
+	}
+}
+
+func (Session) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("userId"),
+	}
+}
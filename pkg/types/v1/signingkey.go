@@ -0,0 +1,30 @@
+package v1
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// SigningKey holds the schema definition for the SigningKey entity,
+// a key of the keyset that signs and verifies the issued tokens.
+type SigningKey struct {
+	ent.Schema
+}
+
+// Fields of the SigningKey.
+func (SigningKey) Fields() []ent.Field {
+	return []ent.Field{
+		// the key id, set as kid in the header of the tokens
+		field.String("id").NotEmpty().Unique().Immutable(),
+		field.Enum("algorithm").Values("HS256", "RS256", "EdDSA").Immutable(),
+		// PEM encoded private key, or the secret of HS256
+		field.String("privateKey").StorageKey("private_key").NotEmpty().Sensitive().Immutable(),
+		// PEM encoded public key, empty for HS256
+		field.String("publicKey").StorageKey("public_key").Default("").Immutable(),
+		// set once the key is rotated, it only verifies tokens until then
+		field.Time("expiresAt").StorageKey("expires_at").Optional().Nillable(),
+		field.Time("createdAt").StorageKey("created_at").Default(time.Now).Immutable(),
+	}
+}
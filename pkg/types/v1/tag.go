@@ -0,0 +1,47 @@
+package v1
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Tag holds the schema definition for the Tag entity, a label the user attaches to their chats.
+// Tags are scoped per user, the same name of different users are different tags.
+type Tag struct {
+	ent.Schema
+}
+
+// Fields of the Tag.
+func (Tag) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).Unique(),
+		field.String("name").NotEmpty().MaxLen(64),
+		field.UUID("userId", uuid.UUID{}).StorageKey("user_id"),
+		field.Time("createdAt").StorageKey("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the Tag.
+func (Tag) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("tags").
+			Field("userId").
+			Unique().
+			Required(),
+		edge.From("chats", Chat.Type).
+			Ref("tags"),
+	}
+}
+
+func (Tag) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("userId", "name").Unique(),
+	}
+}
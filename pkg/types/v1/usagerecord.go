@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// UsageRecord holds the schema definition for the UsageRecord entity, the token usage and latency
+// of an LLM request. The user is not an edge so that the records outlive deleted users for chargeback.
+type UsageRecord struct {
+	ent.Schema
+}
+
+// Fields of the UsageRecord.
+func (UsageRecord) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).Unique().Immutable(),
+		field.UUID("userId", uuid.UUID{}).StorageKey("user_id").Immutable(),
+		field.String("userEmail").StorageKey("user_email").Default("").Immutable(),
+		field.String("model").Default("").Immutable(),
+		// the ollama api, e.g., chat or generate
+		field.String("endpoint").Default("").Immutable(),
+		field.Int("promptTokens").StorageKey("prompt_tokens").Default(0).Immutable(),
+		field.Int("completionTokens").StorageKey("completion_tokens").Default(0).Immutable(),
+		// durations reported by ollama, in nanoseconds
+		field.Int64("totalDuration").StorageKey("total_duration").Default(0).Immutable(),
+		field.Int64("loadDuration").StorageKey("load_duration").Default(0).Immutable(),
+		field.Int64("promptEvalDuration").StorageKey("prompt_eval_duration").Default(0).Immutable(),
+		field.Int64("evalDuration").StorageKey("eval_duration").Default(0).Immutable(),
+		// time until the response was proxied completely, in milliseconds
+		field.Int64("latency").Default(0).Immutable(),
+		// http status of the response
+		field.Int("status").Immutable(),
+		// UTC date of the request, e.g., 2024-05-01, to aggregate usage by day
+		field.String("day").Immutable(),
+		field.Time("createdAt").StorageKey("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (UsageRecord) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("day", "userId"),
+		index.Fields("userId"),
+		index.Fields("model"),
+		index.Fields("createdAt"),
+	}
+}
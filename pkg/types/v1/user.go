@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -26,7 +27,22 @@ func (User) Fields() []ent.Field {
 		field.Enum("role").Default("pending").
 			Values("admin", "user", "pending").Default("pending"),
 		field.String("profileImageUrl").Default("").StorageKey("profile_image_url"),
-		field.Time("createdAt").StorageKey("created_at").Default(time.Now()).Immutable(),
+		// base32 TOTP secret, set on enrollment and only effective once totpEnabled is true
+		field.String("totpSecret").StorageKey("totp_secret").Optional().Sensitive(),
+		field.Bool("totpEnabled").StorageKey("totp_enabled").Default(false),
+		// time step of the last accepted TOTP code, a code is never accepted twice
+		field.Int64("totpLastStep").StorageKey("totp_last_step").Optional(),
+		// sha256 hashes of the unused two-factor recovery codes
+		field.JSON("recoveryCodes", []string{}).StorageKey("recovery_codes").Optional().Sensitive(),
+		// sha256 hash of the nonce of the pending MFA sign-in challenge, cleared once it is used
+		field.String("mfaChallenge").StorageKey("mfa_challenge").Optional().Sensitive(),
+		field.Int("mfaChallengeAttempts").StorageKey("mfa_challenge_attempts").Default(0),
+		field.Int("failedLoginCount").StorageKey("failed_login_count").Default(0),
+		field.Time("lastFailedLoginAt").StorageKey("last_failed_login_at").Optional().Nillable(),
+		field.Time("lockedUntil").StorageKey("locked_until").Optional().Nillable(),
+		// set when an admin rejects a pending user without deleting the account
+		field.Time("rejectedAt").StorageKey("rejected_at").Optional().Nillable(),
+		field.Time("createdAt").StorageKey("created_at").Default(time.Now).Immutable(),
 	}
 }
 
@@ -35,6 +51,23 @@ func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("chats", Chat.Type),
 		edge.To("modelfiles", Modelfile.Type),
+		edge.To("sessions", Session.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("apiKeys", ApiKey.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("passwordResets", PasswordReset.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("invitations", Invitation.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// custom roles granted in addition to the built-in role
+		edge.To("roles", Role.Type),
+		// groups restricting the models the user can access
+		edge.To("groups", Group.Type),
+		edge.To("quota", Quota.Type).
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("tags", Tag.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
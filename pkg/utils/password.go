@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"fmt"
+	"unicode"
+
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+)
+
+// ValidatePasswordPolicy checks a new password against the configured password policy.
+func ValidatePasswordPolicy(password string) error {
+	if minLength := settings.PasswordMinLength.GetInt(); len([]rune(password)) < minLength {
+		return fmt.Errorf("password must be at least %d characters long", minLength)
+	}
+
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			symbol = true
+		}
+	}
+
+	if settings.PasswordRequireMixedCase.Get() == "true" && !(upper && lower) {
+		return fmt.Errorf("password must contain both upper and lower case letters")
+	}
+	if settings.PasswordRequireDigit.Get() == "true" && !digit {
+		return fmt.Errorf("password must contain a digit")
+	}
+	if settings.PasswordRequireSymbol.Get() == "true" && !symbol {
+		return fmt.Errorf("password must contain a symbol")
+	}
+	return nil
+}
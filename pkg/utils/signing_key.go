@@ -0,0 +1,188 @@
+package utils
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	SigningAlgorithmHS256 = "HS256"
+	SigningAlgorithmRS256 = "RS256"
+	SigningAlgorithmEdDSA = "EdDSA"
+
+	hmacSecretBytes = 32
+	rsaKeyBits      = 2048
+	keyIDBytes      = 12
+)
+
+var keyProvider KeyProvider
+
+// SigningKey is a parsed key of the keyset.
+type SigningKey struct {
+	ID        string
+	Method    jwt.SigningMethod
+	SignKey   interface{}
+	VerifyKey interface{}
+}
+
+// KeyProvider looks up the keys that sign and verify the issued tokens.
+type KeyProvider interface {
+	// ActiveSigningKey returns the key new tokens are signed with.
+	ActiveSigningKey() (*SigningKey, error)
+	// GetSigningKey returns an unexpired key by its id.
+	GetSigningKey(kid string) (*SigningKey, error)
+}
+
+func SetKeyProvider(p KeyProvider) {
+	keyProvider = p
+}
+
+// GenerateSigningKey returns the id and the encoded private and public key of a new random key,
+// the public key is empty for HS256.
+func GenerateSigningKey(algorithm string) (string, string, string, error) {
+	kid, err := randomString(keyIDBytes)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	switch algorithm {
+	case SigningAlgorithmHS256:
+		secret, err := randomString(hmacSecretBytes)
+		if err != nil {
+			return "", "", "", err
+		}
+		return kid, secret, "", nil
+	case SigningAlgorithmRS256:
+		key, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+		if err != nil {
+			return "", "", "", err
+		}
+		private, public, err := encodeKeyPair(key, &key.PublicKey)
+		return kid, private, public, err
+	case SigningAlgorithmEdDSA:
+		pub, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return "", "", "", err
+		}
+		private, public, err := encodeKeyPair(key, pub)
+		return kid, private, public, err
+	default:
+		return "", "", "", fmt.Errorf("unsupported signing algorithm %s", algorithm)
+	}
+}
+
+// ParseSigningKey decodes a key that has been generated by GenerateSigningKey.
+func ParseSigningKey(kid, algorithm, privateKey string) (*SigningKey, error) {
+	if algorithm == SigningAlgorithmHS256 {
+		return &SigningKey{
+			ID:        kid,
+			Method:    jwt.SigningMethodHS256,
+			SignKey:   []byte(privateKey),
+			VerifyKey: []byte(privateKey),
+		}, nil
+	}
+
+	block, _ := pem.Decode([]byte(privateKey))
+	if block == nil {
+		return nil, fmt.Errorf("invalid private key of signing key %s", kid)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key of signing key %s: %w", kid, err)
+	}
+
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		if algorithm != SigningAlgorithmRS256 {
+			break
+		}
+		return &SigningKey{ID: kid, Method: jwt.SigningMethodRS256, SignKey: k, VerifyKey: &k.PublicKey}, nil
+	case ed25519.PrivateKey:
+		if algorithm != SigningAlgorithmEdDSA {
+			break
+		}
+		return &SigningKey{ID: kid, Method: jwt.SigningMethodEdDSA, SignKey: k, VerifyKey: k.Public()}, nil
+	}
+	return nil, fmt.Errorf("private key of signing key %s does not match algorithm %s", kid, algorithm)
+}
+
+// JWK returns the public key in the JSON Web Key format, or nil for symmetric keys that must not be published.
+func (k *SigningKey) JWK() map[string]string {
+	switch pub := k.VerifyKey.(type) {
+	case *rsa.PublicKey:
+		return map[string]string{
+			"kty": "RSA",
+			"use": "sig",
+			"alg": k.Method.Alg(),
+			"kid": k.ID,
+			"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}
+	case ed25519.PublicKey:
+		return map[string]string{
+			"kty": "OKP",
+			"crv": "Ed25519",
+			"use": "sig",
+			"alg": k.Method.Alg(),
+			"kid": k.ID,
+			"x":   base64.RawURLEncoding.EncodeToString(pub),
+		}
+	}
+	return nil
+}
+
+func encodeKeyPair(private, public interface{}) (string, string, error) {
+	privateDER, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		return "", "", err
+	}
+	publicDER, err := x509.MarshalPKIXPublicKey(public)
+	if err != nil {
+		return "", "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})), nil
+}
+
+// signToken signs the claims with the active key and sets its id as kid.
+func signToken(claims jwt.Claims) (string, error) {
+	if keyProvider == nil {
+		return "", fmt.Errorf("no signing key provider is registered")
+	}
+	key, err := keyProvider.ActiveSigningKey()
+	if err != nil {
+		return "", err
+	}
+
+	token := jwt.NewWithClaims(key.Method, claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.SignKey)
+}
+
+// verificationKey returns the key of the kid in the token header, a token can not pick another algorithm than its key.
+func verificationKey(token *jwt.Token) (interface{}, error) {
+	if keyProvider == nil {
+		return nil, fmt.Errorf("no signing key provider is registered")
+	}
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		return nil, fmt.Errorf("token has no key id")
+	}
+
+	key, err := keyProvider.GetSigningKey(kid)
+	if err != nil {
+		return nil, err
+	}
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method %s of key %s", token.Method.Alg(), kid)
+	}
+	return key.VerifyKey, nil
+}
@@ -0,0 +1,163 @@
+package utils
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// testKeyProvider serves parsed keys from memory, retired keys stay until they are removed.
+type testKeyProvider struct {
+	active string
+	keys   map[string]*SigningKey
+}
+
+func (p *testKeyProvider) ActiveSigningKey() (*SigningKey, error) {
+	return p.GetSigningKey(p.active)
+}
+
+func (p *testKeyProvider) GetSigningKey(kid string) (*SigningKey, error) {
+	if k, ok := p.keys[kid]; ok {
+		return k, nil
+	}
+	return nil, fmt.Errorf("unknown signing key %s", kid)
+}
+
+// add generates a key of the algorithm, makes it the active one and returns it with its encoded public key.
+func (p *testKeyProvider) add(t *testing.T, algorithm string) (*SigningKey, string) {
+	t.Helper()
+	kid, private, public, err := GenerateSigningKey(algorithm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := ParseSigningKey(kid, algorithm, private)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.keys[kid] = key
+	p.active = kid
+	return key, public
+}
+
+func setTestKeyProvider(t *testing.T) *testKeyProvider {
+	t.Helper()
+	p := &testKeyProvider{keys: map[string]*SigningKey{}}
+	prev := keyProvider
+	SetKeyProvider(p)
+	t.Cleanup(func() {
+		keyProvider = prev
+	})
+	return p
+}
+
+func testClaims() Claims {
+	return Claims{
+		UUID:      uuid.New(),
+		SessionID: uuid.New(),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			Issuer:    issuer,
+		},
+	}
+}
+
+// forge signs the claims with the method and key and names the kid in the header.
+func forge(t *testing.T, method jwt.SigningMethod, key interface{}, kid string) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, testClaims())
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	s, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestVerifyTokenSigningKeys(t *testing.T) {
+	for _, algorithm := range []string{SigningAlgorithmHS256, SigningAlgorithmRS256, SigningAlgorithmEdDSA} {
+		t.Run(algorithm, func(t *testing.T) {
+			setTestKeyProvider(t).add(t, algorithm)
+			claims := testClaims()
+			token, err := signToken(claims)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := VerifyToken(token)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.UUID != claims.UUID || got.SessionID != claims.SessionID {
+				t.Errorf("claims = %+v, want %+v", got, claims)
+			}
+		})
+	}
+}
+
+func TestVerifyTokenRejectsAlgorithmMismatch(t *testing.T) {
+	p := setTestKeyProvider(t)
+	hmacKey, _ := p.add(t, SigningAlgorithmHS256)
+	edKey, _ := p.add(t, SigningAlgorithmEdDSA)
+	rsaKey, rsaPublic := p.add(t, SigningAlgorithmRS256)
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		// the public key is known to everyone, it must not be accepted as an HMAC secret
+		{"HS256 signed with the RS256 public key", forge(t, jwt.SigningMethodHS256, []byte(rsaPublic), rsaKey.ID)},
+		{"HS512 signed with the HS256 secret", forge(t, jwt.SigningMethodHS512, hmacKey.SignKey, hmacKey.ID)},
+		{"RS512 signed with the RS256 key", forge(t, jwt.SigningMethodRS512, rsaKey.SignKey, rsaKey.ID)},
+		{"RS256 signed with the key of an EdDSA kid", forge(t, jwt.SigningMethodRS256, rsaKey.SignKey, edKey.ID)},
+		{"unsigned", forge(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, rsaKey.ID)},
+		{"no kid", forge(t, jwt.SigningMethodRS256, rsaKey.SignKey, "")},
+		{"unknown kid", forge(t, jwt.SigningMethodRS256, rsaKey.SignKey, "unknown")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if claims, err := VerifyToken(tt.token); err == nil {
+				t.Errorf("token verified: %+v", claims)
+			}
+		})
+	}
+
+	// the same keys verify tokens of their own algorithm
+	if _, err := VerifyToken(forge(t, jwt.SigningMethodHS256, hmacKey.SignKey, hmacKey.ID)); err != nil {
+		t.Errorf("HS256 token: %v", err)
+	}
+}
+
+func TestVerifyTokenRetiredKey(t *testing.T) {
+	p := setTestKeyProvider(t)
+	old, _ := p.add(t, SigningAlgorithmHS256)
+	token, err := signToken(testClaims())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the tokens of the retired key verify as long as the provider still has the key
+	p.add(t, SigningAlgorithmRS256)
+	if _, err = VerifyToken(token); err != nil {
+		t.Errorf("token of the retired key: %v", err)
+	}
+	next, err := signToken(testClaims())
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, _, err := jwt.NewParser().ParseUnverified(next, &Claims{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kid := parsed.Header["kid"]; kid == old.ID {
+		t.Error("new token signed with the retired key")
+	}
+
+	delete(p.keys, old.ID)
+	if _, err = VerifyToken(token); err == nil {
+		t.Error("token of a removed key verified")
+	}
+}
@@ -1,7 +1,12 @@
 package utils
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -12,16 +17,26 @@ import (
 )
 
 type Claims struct {
-	UUID uuid.UUID `json:"uuid"`
+	UUID      uuid.UUID `json:"uuid"`
+	SessionID uuid.UUID `json:"sid"`
+	// Impersonator is the admin acting as the user, only set in impersonation tokens
+	Impersonator *uuid.UUID `json:"imp,omitempty"`
 	jwt.RegisteredClaims
 }
 
 const (
-	issuer   = "llmos-issuer"
-	hashCost = 10
+	issuer            = "llmos-issuer"
+	hashCost          = 10
+	refreshTokenBytes = 32
+	apiKeyBytes       = 24
+	mfaAudience       = "llmos-mfa"
+	mfaTokenDuration  = 5 * time.Minute
+
+	APIKeyPrefix = "sk-"
 )
 
-func GenerateToken(uuid uuid.UUID) (string, error) {
+// GenerateToken issues a short-lived access token bound to the given login session.
+func GenerateToken(uuid uuid.UUID, sessionID uuid.UUID) (string, error) {
 	duration, err := time.ParseDuration(settings.TokenExpireTime.Get())
 	if err != nil {
 		return "", err
@@ -29,27 +44,36 @@ func GenerateToken(uuid uuid.UUID) (string, error) {
 	time := time.Now().Add(duration)
 
 	claims := Claims{
-		UUID: uuid,
+		UUID:      uuid,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time),
 			Issuer:    issuer,
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	ss, err := token.SignedString([]byte(settings.JWTSecret.Get()))
-	if err != nil {
-		return "", err
+	return signToken(claims)
+}
+
+// GenerateImpersonationToken issues an access token of the user that is marked with the impersonating admin,
+// it expires together with its session and can not be refreshed.
+func GenerateImpersonationToken(uuid, sessionID, impersonator uuid.UUID, expiresAt time.Time) (string, error) {
+	claims := Claims{
+		UUID:         uuid,
+		SessionID:    sessionID,
+		Impersonator: &impersonator,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			Issuer:    issuer,
+		},
 	}
 
-	return ss, nil
+	return signToken(claims)
 }
 
 func VerifyToken(tokenStr string) (*Claims, error) {
 	claims := &Claims{}
-	token, err := jwt.ParseWithClaims(tokenStr, claims, func(token *jwt.Token) (interface{}, error) {
-		return []byte(settings.JWTSecret.Get()), nil
-	})
+	token, err := jwt.ParseWithClaims(tokenStr, claims, verificationKey)
 
 	if err != nil {
 		return nil, err
@@ -59,6 +83,41 @@ func VerifyToken(tokenStr string) (*Claims, error) {
 		return nil, fmt.Errorf("invalid token")
 	}
 
+	// a sign-in challenge is not an access token
+	if slices.Contains(claims.Audience, mfaAudience) {
+		return nil, fmt.Errorf("invalid token audience")
+	}
+
+	return claims, nil
+}
+
+// GenerateMFAToken issues the short-lived challenge token of a sign-in that still requires the second factor,
+// the nonce identifies the challenge so that the token can only be used once.
+func GenerateMFAToken(uuid uuid.UUID, nonce string) (string, error) {
+	claims := Claims{
+		UUID: uuid,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        nonce,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(mfaTokenDuration)),
+			Issuer:    issuer,
+			Audience:  jwt.ClaimStrings{mfaAudience},
+		},
+	}
+
+	return signToken(claims)
+}
+
+func VerifyMFAToken(tokenStr string) (*Claims, error) {
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(tokenStr, claims, verificationKey, jwt.WithAudience(mfaAudience))
+	if err != nil {
+		return nil, err
+	}
+
+	if !token.Valid {
+		return nil, fmt.Errorf("invalid token")
+	}
+
 	return claims, nil
 }
 
@@ -71,3 +130,41 @@ func CheckPasswordHash(password, hash string) bool {
 	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
 	return err == nil
 }
+
+// GenerateRefreshToken returns a random opaque refresh token.
+func GenerateRefreshToken() (string, error) {
+	return randomString(refreshTokenBytes)
+}
+
+// GenerateMFANonce returns a random nonce of an MFA challenge.
+func GenerateMFANonce() (string, error) {
+	return randomString(refreshTokenBytes)
+}
+
+// GenerateRandomPassword returns a random password for users that sign in through an external provider.
+func GenerateRandomPassword() (string, error) {
+	return randomString(refreshTokenBytes)
+}
+
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// GenerateAPIKey returns a random personal api key, e.g., sk-3f9a...
+func GenerateAPIKey() (string, error) {
+	b := make([]byte, apiKeyBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return APIKeyPrefix + hex.EncodeToString(b), nil
+}
+
+// HashToken returns the hex encoded sha256 of an opaque token (refresh token or api key) that is persisted in the database.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
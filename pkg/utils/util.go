@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 
 	entv1 "github.com/llmos-ai/llmos-dashboard/pkg/generated/ent"
 )
@@ -21,3 +22,27 @@ func GetSessionUser(c *gin.Context) (*entv1.User, error) {
 
 	return user, nil
 }
+
+// GetImpersonator returns the admin that impersonates the session user, or nil.
+func GetImpersonator(c *gin.Context) *uuid.UUID {
+	obj, exist := c.Get("impersonator")
+	if !exist {
+		return nil
+	}
+	id, _ := obj.(*uuid.UUID)
+	return id
+}
+
+func GetSessionID(c *gin.Context) (uuid.UUID, error) {
+	obj, exist := c.Get("sessionId")
+	if !exist || obj == nil {
+		return uuid.Nil, fmt.Errorf("empty session")
+	}
+
+	id, ok := obj.(uuid.UUID)
+	if !ok {
+		return uuid.Nil, fmt.Errorf("failed to parse session id: %v", obj)
+	}
+
+	return id, nil
+}
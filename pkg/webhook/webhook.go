@@ -0,0 +1,69 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/llmos-ai/llmos-dashboard/pkg/settings"
+)
+
+const (
+	ActionSignup = "signup"
+
+	requestTimeout = 10 * time.Second
+)
+
+var httpClient = &http.Client{Timeout: requestTimeout}
+
+// Event is posted as JSON to the configured webhook url, the message is repeated as text
+// and content so that Slack and Discord incoming webhooks render it as is.
+type Event struct {
+	Action  string      `json:"action"`
+	Message string      `json:"message"`
+	Text    string      `json:"text"`
+	Content string      `json:"content"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+// Notify posts the event to the configured webhook url in the background, it does nothing if no url is configured.
+func Notify(action, message string, data interface{}) {
+	url := settings.WebhookURL.Get()
+	if url == "" {
+		return
+	}
+
+	event := Event{
+		Action:  action,
+		Message: message,
+		Text:    message,
+		Content: message,
+		Data:    data,
+	}
+	go func() {
+		if err := post(url, event); err != nil {
+			slog.Error("failed to send webhook", "action", action, "error", err)
+		}
+	}()
+}
+
+func post(url string, event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	resp, err := httpClient.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("webhook %s responded with status %d", url, resp.StatusCode)
+	}
+	return nil
+}
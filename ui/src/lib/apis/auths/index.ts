@@ -58,6 +58,62 @@ export const userSignIn = async (email: string, password: string) => {
   return res;
 };
 
+export const userSignInMFA = async (mfaToken: string, code: string) => {
+  let error = null;
+
+  const res = await fetch(`${WEBUI_API_BASE_URL}/auths/signin/mfa`, {
+    method: "POST",
+    headers: {
+      "Content-Type": "application/json",
+    },
+    body: JSON.stringify({
+      mfaToken: mfaToken,
+      code: code,
+    }),
+  })
+    .then(async (res) => {
+      if (!res.ok) throw await res.json();
+      return res.json();
+    })
+    .catch((err) => {
+      console.log(err);
+      error = err.error;
+      return null;
+    });
+
+  if (error) {
+    throw error;
+  }
+
+  return res;
+};
+
+export const userSignInProxy = async () => {
+  let error = null;
+
+  const res = await fetch(`${WEBUI_API_BASE_URL}/auths/signin/proxy`, {
+    method: "POST",
+    headers: {
+      "Content-Type": "application/json",
+    },
+  })
+    .then(async (res) => {
+      if (!res.ok) throw await res.json();
+      return res.json();
+    })
+    .catch((err) => {
+      console.log(err);
+      error = err.error;
+      return null;
+    });
+
+  if (error) {
+    throw error;
+  }
+
+  return res;
+};
+
 export const userSignUp = async (
   name: string,
   email: string,
@@ -93,6 +149,156 @@ export const userSignUp = async (
   return res;
 };
 
+export const refreshToken = async (refreshToken: string) => {
+  let error = null;
+
+  const res = await fetch(`${WEBUI_API_BASE_URL}/auths/refresh`, {
+    method: "POST",
+    headers: {
+      "Content-Type": "application/json",
+    },
+    body: JSON.stringify({
+      refreshToken: refreshToken,
+    }),
+  })
+    .then(async (res) => {
+      if (!res.ok) throw await res.json();
+      return res.json();
+    })
+    .catch((err) => {
+      console.log(err);
+      error = err.error;
+      return null;
+    });
+
+  if (error) {
+    throw error;
+  }
+
+  return res;
+};
+
+export const userSignUpWithInvite = async (
+  name: string,
+  password: string,
+  inviteToken: string
+) => {
+  let error = null;
+
+  const res = await fetch(`${WEBUI_API_BASE_URL}/auths/signup/invite`, {
+    method: "POST",
+    headers: {
+      "Content-Type": "application/json",
+    },
+    body: JSON.stringify({
+      name: name,
+      password: password,
+      inviteToken: inviteToken,
+    }),
+  })
+    .then(async (res) => {
+      if (!res.ok) throw await res.json();
+      return res.json();
+    })
+    .catch((err) => {
+      console.log(err);
+      error = err.error;
+      return null;
+    });
+
+  if (error) {
+    throw error;
+  }
+
+  return res;
+};
+
+export const userSignOut = async (token: string) => {
+  let error = null;
+
+  const res = await fetch(`${WEBUI_API_BASE_URL}/auths/signout`, {
+    method: "POST",
+    headers: {
+      "Content-Type": "application/json",
+      Authorization: `Bearer ${token}`,
+    },
+  })
+    .then(async (res) => {
+      if (!res.ok) throw await res.json();
+      return res.json();
+    })
+    .catch((err) => {
+      console.log(err);
+      error = err.error;
+      return null;
+    });
+
+  if (error) {
+    throw error;
+  }
+
+  return res;
+};
+
+export const forgotPassword = async (email: string) => {
+  let error = null;
+
+  const res = await fetch(`${WEBUI_API_BASE_URL}/auths/password/forgot`, {
+    method: "POST",
+    headers: {
+      "Content-Type": "application/json",
+    },
+    body: JSON.stringify({
+      email: email,
+    }),
+  })
+    .then(async (res) => {
+      if (!res.ok) throw await res.json();
+      return res.json();
+    })
+    .catch((err) => {
+      console.log(err);
+      error = err.error;
+      return null;
+    });
+
+  if (error) {
+    throw error;
+  }
+
+  return res;
+};
+
+export const resetPassword = async (token: string, password: string) => {
+  let error = null;
+
+  const res = await fetch(`${WEBUI_API_BASE_URL}/auths/password/reset`, {
+    method: "POST",
+    headers: {
+      "Content-Type": "application/json",
+    },
+    body: JSON.stringify({
+      token: token,
+      password: password,
+    }),
+  })
+    .then(async (res) => {
+      if (!res.ok) throw await res.json();
+      return res.json();
+    })
+    .catch((err) => {
+      console.log(err);
+      error = err.error;
+      return null;
+    });
+
+  if (error) {
+    throw error;
+  }
+
+  return res;
+};
+
 export const updateUserProfile = async (
   token: string,
   name: string,
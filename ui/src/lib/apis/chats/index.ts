@@ -29,10 +29,19 @@ export const createNewChat = async (token: string, chat: object) => {
   return res;
 };
 
-export const getChatList = async (token: string = "") => {
+export const getChatListPage = async (
+  token: string = "",
+  cursor: string = "",
+  limit: number = 200
+) => {
   let error = null;
 
-  const res = await fetch(`${WEBUI_API_BASE_URL}/chats/`, {
+  const searchParams = new URLSearchParams({ limit: `${limit}` });
+  if (cursor) {
+    searchParams.append("cursor", cursor);
+  }
+
+  const res = await fetch(`${WEBUI_API_BASE_URL}/chats/?${searchParams}`, {
     method: "GET",
     headers: {
       Accept: "application/json",
@@ -95,10 +95,25 @@ export const updateUserRole = async (
   return res;
 };
 
-export const getUsers = async (token: string) => {
+type UserListQuery = {
+  page?: number;
+  limit?: number;
+  q?: string;
+  role?: string;
+  sort?: string;
+};
+
+export const getUsers = async (token: string, query: UserListQuery = {}) => {
   let error = null;
 
-  const res = await fetch(`${WEBUI_API_BASE_URL}/users/`, {
+  const searchParams = new URLSearchParams();
+  for (const [key, value] of Object.entries(query)) {
+    if (value !== undefined && value !== "") {
+      searchParams.append(key, `${value}`);
+    }
+  }
+
+  const res = await fetch(`${WEBUI_API_BASE_URL}/users/?${searchParams}`, {
     method: "GET",
     headers: {
       "Content-Type": "application/json",
@@ -119,7 +134,7 @@ export const getUsers = async (token: string) => {
     throw error;
   }
 
-  return res ? res : [];
+  return res ? res : { users: [], total: 0 };
 };
 
 export const deleteUserById = async (token: string, userId: string) => {
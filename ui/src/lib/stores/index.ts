@@ -12,6 +12,8 @@ export const theme = writable("system");
 export const chatId = writable("");
 
 export const chats = writable([]);
+// cursor of the next page of chats, empty once all chats are loaded
+export const chatsCursor = writable("");
 export const tags = writable([]);
 export const models = writable([]);
 
@@ -0,0 +1,39 @@
+import { get } from "svelte/store";
+
+import { getChatListPage } from "$lib/apis/chats";
+import { chats, chatsCursor } from "$lib/stores";
+
+// number of chats loaded at once into the sidebar
+const PAGE_SIZE = 50;
+
+let loading: Promise<void> | null = null;
+
+// loadChats replaces the chat list with the first page of the user's chats.
+export const loadChats = async (token: string) => {
+  const res = await getChatListPage(token, "", PAGE_SIZE);
+  chats.set(res?.chats ?? []);
+  chatsCursor.set(res?.nextCursor ?? "");
+};
+
+// loadMoreChats appends the next page to the chat list, concurrent calls share one request.
+export const loadMoreChats = (token: string): Promise<void> => {
+  const cursor = get(chatsCursor);
+  if (!cursor) {
+    return Promise.resolve();
+  }
+  if (!loading) {
+    loading = getChatListPage(token, cursor, PAGE_SIZE)
+      .then((res) => {
+        // the list was reloaded or filtered meanwhile
+        if (get(chatsCursor) !== cursor) {
+          return;
+        }
+        chats.update((list) => [...list, ...(res?.chats ?? [])]);
+        chatsCursor.set(res?.nextCursor ?? "");
+      })
+      .finally(() => {
+        loading = null;
+      });
+  }
+  return loading;
+};
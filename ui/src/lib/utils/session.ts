@@ -0,0 +1,93 @@
+import { refreshToken } from "$lib/apis/auths";
+
+// refresh the access token this long before it expires
+const REFRESH_MARGIN_MS = 60 * 1000;
+// wait before trying again when the refresh request did not reach the server
+const RETRY_DELAY_MS = 30 * 1000;
+// setTimeout overflows beyond ~24.8 days
+const MAX_TIMEOUT_MS = 2 ** 31 - 1;
+
+let timer: ReturnType<typeof setTimeout> | null = null;
+let refreshing: Promise<boolean> | null = null;
+
+// tokenExpiresAt returns the expiry of the JWT in milliseconds, or null if it can not be read.
+const tokenExpiresAt = (token: string): number | null => {
+  try {
+    const payload = token.split(".")[1].replace(/-/g, "+").replace(/_/g, "/");
+    const { exp } = JSON.parse(atob(payload));
+    return typeof exp === "number" ? exp * 1000 : null;
+  } catch {
+    return null;
+  }
+};
+
+// refreshSession exchanges the stored refresh token for a new token pair, concurrent calls share
+// one request. It resolves to false once the session can not be refreshed anymore.
+export const refreshSession = (): Promise<boolean> => {
+  if (!refreshing) {
+    refreshing = rotate().finally(() => {
+      refreshing = null;
+    });
+  }
+  return refreshing;
+};
+
+const rotate = async (): Promise<boolean> => {
+  const used = localStorage.refreshToken;
+  if (!used) {
+    return false;
+  }
+
+  let rejected = false;
+  const tokens = await refreshToken(used).catch(() => {
+    rejected = true;
+    return null;
+  });
+
+  if (tokens) {
+    localStorage.token = tokens.token;
+    localStorage.refreshToken = tokens.refreshToken;
+    return true;
+  }
+  // another tab rotated the refresh token first
+  if (localStorage.refreshToken && localStorage.refreshToken !== used) {
+    return true;
+  }
+  return !rejected;
+};
+
+// scheduleTokenRefresh refreshes the access token shortly before it expires and keeps doing so
+// for every new token, onExpired is called when the session has ended.
+export const scheduleTokenRefresh = (onExpired: () => void) => {
+  cancelTokenRefresh();
+
+  const expiresAt = localStorage.token ? tokenExpiresAt(localStorage.token) : null;
+  if (expiresAt === null) {
+    return;
+  }
+
+  const delay = Math.min(
+    Math.max(expiresAt - Date.now() - REFRESH_MARGIN_MS, 0),
+    MAX_TIMEOUT_MS
+  );
+  timer = setTimeout(async () => {
+    const before = localStorage.token;
+    if (!(await refreshSession())) {
+      onExpired();
+      return;
+    }
+    if (localStorage.token === before) {
+      // the server was not reachable, try again with the current token
+      timer = setTimeout(() => scheduleTokenRefresh(onExpired), RETRY_DELAY_MS);
+      return;
+    }
+    scheduleTokenRefresh(onExpired);
+  }, delay);
+};
+
+export const cancelTokenRefresh = () => {
+  if (timer) {
+    clearTimeout(timer);
+    timer = null;
+  }
+};